@@ -1,17 +1,30 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"github.com/gin-gonic/gin"
 	"github.com/in-rich/lib-go/deploy"
 	"github.com/in-rich/lib-go/monitor"
 	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
 	"github.com/in-rich/uservice-subscription/config"
 	"github.com/in-rich/uservice-subscription/migrations"
 	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/events"
 	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/jobs"
+	"github.com/in-rich/uservice-subscription/pkg/metrics"
 	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/in-rich/uservice-subscription/pkg/tracing"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"net/http"
 	"os"
+	"time"
 )
 
 func getLogger() monitor.GRPCLogger {
@@ -22,9 +35,76 @@ func getLogger() monitor.GRPCLogger {
 	return monitor.NewConsoleGRPCLogger()
 }
 
+func getGinLogger() monitor.GinLogger {
+	if deploy.IsReleaseEnv() {
+		return monitor.NewGCPGinLogger(zerolog.New(os.Stdout), "uservice-subscription")
+	}
+
+	return monitor.NewConsoleGinLogger()
+}
+
+// startWebhookServer serves the HTTP webhooks of billing providers, next to the GRPC server.
+func startWebhookServer(logger monitor.GinLogger, port int, stripeWebhookHandler *handlers.StripeWebhookHandler) *http.Server {
+	if deploy.IsReleaseEnv() {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	router := gin.New()
+	router.Use(logger.Middleware(), gin.Recovery())
+	router.POST("/webhooks/stripe", stripeWebhookHandler.HandleStripeWebhook)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           router,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Fatal(err, "failed to serve webhooks")
+		}
+	}()
+
+	return server
+}
+
+// startMetricsServer exposes Prometheus metrics, on a separate port so they are not reachable from the webhooks.
+func startMetricsServer(logger monitor.Logger, port int, appMetrics *metrics.Metrics) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", appMetrics.Handler())
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Fatal(err, "failed to serve metrics")
+		}
+	}()
+
+	return server
+}
+
 func main() {
 	logger := getLogger()
 
+	exporter, err := tracing.NewExporter(context.Background(), config.App.Tracing)
+	if err != nil {
+		logger.Fatal(err, "failed to create tracing exporter")
+	}
+
+	if exporter == nil {
+		logger.Warn("No tracing exporter configured, traces are not exported")
+	} else {
+		tracerProvider := tracing.NewTracerProvider(exporter)
+		defer func() { _ = tracerProvider.Shutdown(context.Background()) }()
+		otel.SetTracerProvider(tracerProvider)
+	}
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
 	logger.Info("Starting server")
 	db, closeDB, err := deploy.OpenDB(config.App.Postgres.DSN)
 	if err != nil {
@@ -37,6 +117,12 @@ func main() {
 		logger.Fatal(err, "failed to migrate")
 	}
 
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	appMetrics := metrics.NewMetrics(registry)
+	dao.SetMetrics(appMetrics)
+	db.AddQueryHook(tracing.NewQueryHook(otel.GetTracerProvider()))
+
 	depCheck := deploy.DepsCheck{
 		Dependencies: func() map[string]error {
 			return map[string]error{
@@ -44,24 +130,300 @@ func main() {
 			}
 		},
 		Services: deploy.DepCheckServices{
-			"CanUpdateNote": {"Postgres"},
+			"CanUpdateNote":             {"Postgres"},
+			"CanUpdateNotes":            {"Postgres"},
+			"ReleaseNoteEdit":           {"Postgres"},
+			"CommitNoteEdit":            {"Postgres"},
+			"CreateSubscription":        {"Postgres"},
+			"ChangeSubscriptionTier":    {"Postgres"},
+			"CancelSubscription":        {"Postgres"},
+			"GetSubscription":           {"Postgres"},
+			"GetUsage":                  {"Postgres"},
+			"SetQuotaOverride":          {"Postgres"},
+			"DeleteQuotaOverride":       {"Postgres"},
+			"StartTrial":                {"Postgres"},
+			"ConsumeQuota":              {"Postgres"},
+			"GetEntitlements":           {"Postgres"},
+			"SetEntitlementOverride":    {"Postgres"},
+			"DeleteEntitlementOverride": {"Postgres"},
+			"CreateOrganization":        {"Postgres"},
+			"SetOrganizationMember":     {"Postgres"},
+			"RemoveOrganizationMember":  {"Postgres"},
+			"ListOrganizationMembers":   {"Postgres"},
 		},
 	}
 
 	countNoteEditsByAuthorDAO := dao.NewCountNoteEditsByAuthorRepository(db)
 	createNoteEditDAO := dao.NewCreateNoteEditRepository(db)
 	getLatestNoteEditByAuthorDAO := dao.NewGetLatestNoteEditByAuthorRepository(db)
+	getOldestNoteEditByAuthorDAO := dao.NewGetOldestNoteEditByAuthorRepository(db)
+	lockNoteEditsByAuthorDAO := dao.NewLockNoteEditsByAuthorRepository(db)
+	voidNoteEditDAO := dao.NewVoidNoteEditRepository(db)
+	commitNoteEditDAO := dao.NewCommitNoteEditRepository(db)
+	expireNoteEditsDAO := dao.NewExpireNoteEditsRepository(db)
+	getNoteEditByIdempotencyKeyDAO := dao.NewGetNoteEditByIdempotencyKeyRepository(db)
+	clearNoteEditIdempotencyKeyDAO := dao.NewClearNoteEditIdempotencyKeyRepository(db)
+	updateNoteEditActivityDAO := dao.NewUpdateNoteEditActivityRepository(db)
+	countNotesByAuthorDAO := dao.NewCountNotesByAuthorRepository(db)
+	listLatestNoteEditsByAuthorDAO := dao.NewListLatestNoteEditsByAuthorRepository(db)
+	runInTransactionDAO := dao.NewRunInTransactionRepository(db)
+	getSubscriptionByUserDAO := dao.NewGetSubscriptionByUserRepository(db)
+	createSubscriptionDAO := dao.NewCreateSubscriptionRepository(db)
+	updateSubscriptionDAO := dao.NewUpdateSubscriptionRepository(db)
+	lockSubscriptionsByUserDAO := dao.NewLockSubscriptionsByUserRepository(db)
+	getQuotaOverrideByAuthorDAO := dao.NewGetQuotaOverrideByAuthorRepository(db)
+	upsertQuotaOverrideDAO := dao.NewUpsertQuotaOverrideRepository(db)
+	deleteQuotaOverrideDAO := dao.NewDeleteQuotaOverrideRepository(db)
+	getSubscriptionByStripeIDDAO := dao.NewGetSubscriptionByStripeIDRepository(db)
+	createStripeEventDAO := dao.NewCreateStripeEventRepository(db)
+	hasUsedTrialDAO := dao.NewHasUsedTrialRepository(db)
+	expireTrialsDAO := dao.NewExpireTrialsRepository(db)
+	countUsageEventsBySubjectDAO := dao.NewCountUsageEventsBySubjectRepository(db)
+	createUsageEventDAO := dao.NewCreateUsageEventRepository(db)
+	getLatestUsageEventBySubjectDAO := dao.NewGetLatestUsageEventBySubjectRepository(db)
+	lockUsageEventsBySubjectDAO := dao.NewLockUsageEventsBySubjectRepository(db)
+	listEntitlementOverridesByUserDAO := dao.NewListEntitlementOverridesByUserRepository(db)
+	upsertEntitlementOverrideDAO := dao.NewUpsertEntitlementOverrideRepository(db)
+	deleteEntitlementOverrideDAO := dao.NewDeleteEntitlementOverrideRepository(db)
+	createOrganizationDAO := dao.NewCreateOrganizationRepository(db)
+	getOrganizationDAO := dao.NewGetOrganizationRepository(db)
+	getOrganizationMemberByUserDAO := dao.NewGetOrganizationMemberByUserRepository(db)
+	listOrganizationMembersDAO := dao.NewListOrganizationMembersRepository(db)
+	upsertOrganizationMemberDAO := dao.NewUpsertOrganizationMemberRepository(db)
+	deleteOrganizationMemberDAO := dao.NewDeleteOrganizationMemberRepository(db)
+	countNoteEditsByOrganizationDAO := dao.NewCountNoteEditsByOrganizationRepository(db)
+	getOldestNoteEditByOrganizationDAO := dao.NewGetOldestNoteEditByOrganizationRepository(db)
+	lockNoteEditsByOrganizationDAO := dao.NewLockNoteEditsByOrganizationRepository(db)
+	countOrganizationMembersDAO := dao.NewCountOrganizationMembersRepository(db)
+	lockOrganizationMembersDAO := dao.NewLockOrganizationMembersRepository(db)
+	createSeatChangeDAO := dao.NewCreateSeatChangeRepository(db)
+	createOutboxEventDAO := dao.NewCreateOutboxEventRepository(db)
+	lockOutboxEventsDAO := dao.NewLockOutboxEventsRepository(db)
+	listPendingOutboxEventsDAO := dao.NewListPendingOutboxEventsRepository(db)
+	markOutboxEventsPublishedDAO := dao.NewMarkOutboxEventsPublishedRepository(db)
+
+	// Events are written to the outbox in the transaction that produced them, then published by the relay job.
+	eventEmitter := events.NewOutboxEmitter(createOutboxEventDAO)
+	eventPublisher := events.NewLogPublisher(logger)
+
+	canUpdateNoteService := services.NewCanUpdateNoteService(
+		countNoteEditsByAuthorDAO,
+		createNoteEditDAO,
+		getLatestNoteEditByAuthorDAO,
+		lockNoteEditsByAuthorDAO,
+		getQuotaOverrideByAuthorDAO,
+		countNoteEditsByOrganizationDAO,
+		lockNoteEditsByOrganizationDAO,
+		getNoteEditByIdempotencyKeyDAO,
+		clearNoteEditIdempotencyKeyDAO,
+		updateNoteEditActivityDAO,
+		countNotesByAuthorDAO,
+		runInTransactionDAO,
+		eventEmitter,
+		config.App.Reservation.TTL,
+	)
 
-	canUpdateNoteService := services.NewCanUpdateNoteService(countNoteEditsByAuthorDAO, createNoteEditDAO, getLatestNoteEditByAuthorDAO)
+	canUpdateNotesService := services.NewCanUpdateNotesService(
+		countNoteEditsByAuthorDAO,
+		listLatestNoteEditsByAuthorDAO,
+		getQuotaOverrideByAuthorDAO,
+		countNoteEditsByOrganizationDAO,
+	)
 
-	canUpdateNoteHandler := handlers.NewCanUpdateNoteHandler(canUpdateNoteService, logger)
+	releaseNoteEditService := services.NewReleaseNoteEditService(voidNoteEditDAO, runInTransactionDAO, eventEmitter)
+	commitNoteEditService := services.NewCommitNoteEditService(commitNoteEditDAO)
+	expireNoteEditsService := services.NewExpireNoteEditsService(expireNoteEditsDAO, runInTransactionDAO, eventEmitter, 100)
+
+	consumeQuotaService := services.NewConsumeQuotaService(
+		countUsageEventsBySubjectDAO,
+		createUsageEventDAO,
+		getLatestUsageEventBySubjectDAO,
+		lockUsageEventsBySubjectDAO,
+		runInTransactionDAO,
+	)
+
+	resolveTierService := services.NewResolveTierService(
+		getSubscriptionByUserDAO,
+		getOrganizationMemberByUserDAO,
+		config.App.Tiers,
+		config.App.DefaultTier,
+	)
+	createSubscriptionService := services.NewCreateSubscriptionService(
+		getSubscriptionByUserDAO,
+		createSubscriptionDAO,
+		lockSubscriptionsByUserDAO,
+		runInTransactionDAO,
+		eventEmitter,
+		config.App.Tiers,
+	)
+	changeSubscriptionTierService := services.NewChangeSubscriptionTierService(
+		getSubscriptionByUserDAO,
+		updateSubscriptionDAO,
+		lockSubscriptionsByUserDAO,
+		runInTransactionDAO,
+		eventEmitter,
+		config.App.Tiers,
+	)
+	cancelSubscriptionService := services.NewCancelSubscriptionService(
+		getSubscriptionByUserDAO,
+		updateSubscriptionDAO,
+		lockSubscriptionsByUserDAO,
+		runInTransactionDAO,
+		eventEmitter,
+	)
+	getSubscriptionService := services.NewGetSubscriptionService(getSubscriptionByUserDAO)
+	getUsageService := services.NewGetUsageService(
+		countNoteEditsByAuthorDAO,
+		getOldestNoteEditByAuthorDAO,
+		getQuotaOverrideByAuthorDAO,
+		countNoteEditsByOrganizationDAO,
+		getOldestNoteEditByOrganizationDAO,
+	)
+	setQuotaOverrideService := services.NewSetQuotaOverrideService(upsertQuotaOverrideDAO)
+	deleteQuotaOverrideService := services.NewDeleteQuotaOverrideService(deleteQuotaOverrideDAO)
+	startTrialService := services.NewStartTrialService(
+		getSubscriptionByUserDAO,
+		hasUsedTrialDAO,
+		createSubscriptionDAO,
+		lockSubscriptionsByUserDAO,
+		runInTransactionDAO,
+		eventEmitter,
+		config.App.Trial,
+	)
+	getEntitlementsService := services.NewGetEntitlementsService(listEntitlementOverridesByUserDAO)
+	setEntitlementOverrideService := services.NewSetEntitlementOverrideService(
+		upsertEntitlementOverrideDAO,
+		config.App.Tiers[config.App.DefaultTier].Entitlements,
+	)
+	deleteEntitlementOverrideService := services.NewDeleteEntitlementOverrideService(deleteEntitlementOverrideDAO)
+	createOrganizationService := services.NewCreateOrganizationService(createOrganizationDAO)
+	setOrganizationMemberService := services.NewSetOrganizationMemberService(
+		getOrganizationDAO,
+		getOrganizationMemberByUserDAO,
+		upsertOrganizationMemberDAO,
+		countOrganizationMembersDAO,
+		lockOrganizationMembersDAO,
+		createSeatChangeDAO,
+		runInTransactionDAO,
+	)
+	removeOrganizationMemberService := services.NewRemoveOrganizationMemberService(
+		deleteOrganizationMemberDAO,
+		countOrganizationMembersDAO,
+		lockOrganizationMembersDAO,
+		createSeatChangeDAO,
+		runInTransactionDAO,
+	)
+	listOrganizationMembersService := services.NewListOrganizationMembersService(getOrganizationDAO, listOrganizationMembersDAO)
+	expireTrialsService := services.NewExpireTrialsService(expireTrialsDAO, runInTransactionDAO, eventEmitter, 100)
+	handleStripeEventService := services.NewHandleStripeEventService(
+		createStripeEventDAO,
+		getSubscriptionByStripeIDDAO,
+		createSubscriptionDAO,
+		updateSubscriptionDAO,
+		lockSubscriptionsByUserDAO,
+		runInTransactionDAO,
+		eventEmitter,
+		config.App.Stripe,
+	)
+	relayOutboxEventsService := services.NewRelayOutboxEventsService(
+		lockOutboxEventsDAO,
+		listPendingOutboxEventsDAO,
+		markOutboxEventsPublishedDAO,
+		runInTransactionDAO,
+		eventPublisher,
+		config.App.Outbox.BatchSize,
+	)
+
+	canUpdateNoteHandler := handlers.NewCanUpdateNoteHandler(canUpdateNoteService, resolveTierService, appMetrics, logger)
+	canUpdateNotesHandler := handlers.NewCanUpdateNotesHandler(canUpdateNotesService, resolveTierService, logger)
+	releaseNoteEditHandler := handlers.NewReleaseNoteEditHandler(releaseNoteEditService, logger)
+	commitNoteEditHandler := handlers.NewCommitNoteEditHandler(commitNoteEditService, logger)
+	createSubscriptionHandler := handlers.NewCreateSubscriptionHandler(createSubscriptionService, logger)
+	changeSubscriptionTierHandler := handlers.NewChangeSubscriptionTierHandler(changeSubscriptionTierService, logger)
+	cancelSubscriptionHandler := handlers.NewCancelSubscriptionHandler(cancelSubscriptionService, logger)
+	getSubscriptionHandler := handlers.NewGetSubscriptionHandler(getSubscriptionService, logger)
+	getUsageHandler := handlers.NewGetUsageHandler(getUsageService, resolveTierService, logger)
+	setQuotaOverrideHandler := handlers.NewSetQuotaOverrideHandler(setQuotaOverrideService, logger)
+	deleteQuotaOverrideHandler := handlers.NewDeleteQuotaOverrideHandler(deleteQuotaOverrideService, logger)
+	startTrialHandler := handlers.NewStartTrialHandler(startTrialService, logger)
+	consumeQuotaHandler := handlers.NewConsumeQuotaHandler(consumeQuotaService, resolveTierService, logger)
+	getEntitlementsHandler := handlers.NewGetEntitlementsHandler(getEntitlementsService, resolveTierService, logger)
+	setEntitlementOverrideHandler := handlers.NewSetEntitlementOverrideHandler(setEntitlementOverrideService, logger)
+	deleteEntitlementOverrideHandler := handlers.NewDeleteEntitlementOverrideHandler(deleteEntitlementOverrideService, logger)
+	createOrganizationHandler := handlers.NewCreateOrganizationHandler(createOrganizationService, logger)
+	setOrganizationMemberHandler := handlers.NewSetOrganizationMemberHandler(
+		setOrganizationMemberService, resolveTierService, logger,
+	)
+	removeOrganizationMemberHandler := handlers.NewRemoveOrganizationMemberHandler(removeOrganizationMemberService, logger)
+	listOrganizationMembersHandler := handlers.NewListOrganizationMembersHandler(listOrganizationMembersService, logger)
+	stripeWebhookHandler := handlers.NewStripeWebhookHandler(handleStripeEventService)
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	if config.App.Trial.ExpireInterval == 0 {
+		logger.Warn("No trial expiration interval configured, expired trials will not be marked as expired")
+	} else {
+		go jobs.NewExpireTrialsJob(expireTrialsService, logger, config.App.Trial.ExpireInterval).Run(jobsCtx)
+	}
+
+	if config.App.Reservation.ExpireInterval == 0 {
+		logger.Warn("No reservation expiration interval configured, expired note edits will not be marked as expired")
+	} else {
+		go jobs.NewExpireNoteEditsJob(expireNoteEditsService, logger, config.App.Reservation.ExpireInterval).Run(jobsCtx)
+	}
+
+	if config.App.Outbox.RelayInterval == 0 {
+		logger.Warn("No outbox relay interval configured, domain events will not be published")
+	} else {
+		go jobs.NewRelayOutboxEventsJob(relayOutboxEventsService, logger, config.App.Outbox.RelayInterval).Run(jobsCtx)
+	}
+
+	if config.App.Webhook.Port == 0 {
+		logger.Warn("No webhook port configured, billing webhooks are disabled")
+	} else {
+		logger.Info(fmt.Sprintf("Starting to listen for webhooks on port %v", config.App.Webhook.Port))
+		webhookServer := startWebhookServer(getGinLogger(), config.App.Webhook.Port, stripeWebhookHandler)
+		defer func() { _ = webhookServer.Shutdown(context.Background()) }()
+	}
 
 	logger.Info(fmt.Sprintf("Starting to listen on port %v", config.App.Server.Port))
 	listener, server, health := deploy.StartGRPCServer(logger, config.App.Server.Port, depCheck)
 	defer deploy.CloseGRPCServer(listener, server)
 	go health()
 
-	subscription_pb.RegisterCanUpdateNoteServer(server, canUpdateNoteHandler)
+	registrar := tracing.WithUnaryInterceptor(
+		server, tracing.NewUnaryServerInterceptor(otel.GetTracerProvider(), otel.GetTextMapPropagator()),
+	)
+
+	if config.App.Metrics.Port == 0 {
+		logger.Warn("No metrics port configured, metrics are disabled")
+	} else {
+		logger.Info(fmt.Sprintf("Starting to serve metrics on port %v", config.App.Metrics.Port))
+		metricsServer := startMetricsServer(logger, config.App.Metrics.Port, appMetrics)
+		defer func() { _ = metricsServer.Shutdown(context.Background()) }()
+	}
+
+	subscription_pb.RegisterCanUpdateNoteServer(registrar, canUpdateNoteHandler)
+	subscription_pb.RegisterCanUpdateNotesServer(registrar, canUpdateNotesHandler)
+	subscription_pb.RegisterReleaseNoteEditServer(registrar, releaseNoteEditHandler)
+	subscription_pb.RegisterCommitNoteEditServer(registrar, commitNoteEditHandler)
+	subscription_pb.RegisterCreateSubscriptionServer(registrar, createSubscriptionHandler)
+	subscription_pb.RegisterChangeSubscriptionTierServer(registrar, changeSubscriptionTierHandler)
+	subscription_pb.RegisterCancelSubscriptionServer(registrar, cancelSubscriptionHandler)
+	subscription_pb.RegisterGetSubscriptionServer(registrar, getSubscriptionHandler)
+	subscription_pb.RegisterGetUsageServer(registrar, getUsageHandler)
+	subscription_pb.RegisterSetQuotaOverrideServer(registrar, setQuotaOverrideHandler)
+	subscription_pb.RegisterDeleteQuotaOverrideServer(registrar, deleteQuotaOverrideHandler)
+	subscription_pb.RegisterStartTrialServer(registrar, startTrialHandler)
+	subscription_pb.RegisterConsumeQuotaServer(registrar, consumeQuotaHandler)
+	subscription_pb.RegisterGetEntitlementsServer(registrar, getEntitlementsHandler)
+	subscription_pb.RegisterSetEntitlementOverrideServer(registrar, setEntitlementOverrideHandler)
+	subscription_pb.RegisterDeleteEntitlementOverrideServer(registrar, deleteEntitlementOverrideHandler)
+	subscription_pb.RegisterCreateOrganizationServer(registrar, createOrganizationHandler)
+	subscription_pb.RegisterSetOrganizationMemberServer(registrar, setOrganizationMemberHandler)
+	subscription_pb.RegisterRemoveOrganizationMemberServer(registrar, removeOrganizationMemberHandler)
+	subscription_pb.RegisterListOrganizationMembersServer(registrar, listOrganizationMembersHandler)
 
 	logger.Info("Server started")
 	if err := server.Serve(listener); err != nil {
@@ -2,7 +2,10 @@ package config
 
 import (
 	_ "embed"
+	"errors"
+	"fmt"
 	"github.com/in-rich/lib-go/deploy"
+	"github.com/samber/lo"
 	"time"
 )
 
@@ -18,28 +21,350 @@ var appProdFile []byte
 //go:embed app.yaml
 var appFile []byte
 
+var (
+	ErrNoTiers             = errors.New("no tiers configured")
+	ErrUnknownDefaultTier  = errors.New("default tier is not configured")
+	ErrInvalidTierWindow   = errors.New("unknown window")
+	ErrInvalidTierTimezone = errors.New("unknown timezone")
+	ErrInvalidTierMaxNotes = errors.New("max-notes must not be negative")
+	ErrInvalidTierTarget   = errors.New("unknown note target")
+	ErrInvalidGracePeriod  = errors.New("grace-period must not be negative")
+	ErrInvalidTierSeats    = errors.New("seats must not be negative")
+
+	ErrInvalidFeatureCountOver  = errors.New("count-uses-over must be a positive duration")
+	ErrInvalidFeatureMaxUses    = errors.New("max-uses must not be negative")
+	ErrInvalidFeatureBufferTime = errors.New("buffer-time must not be negative")
+	ErrMissingFeature           = errors.New("feature is not configured on every tier")
+	ErrMissingNoteEditsFeature  = errors.New("note-edits feature is not configured")
+
+	ErrInvalidEntitlement      = errors.New("entitlement must be a boolean or a number")
+	ErrInvalidEntitlementLimit = errors.New("entitlement limit must not be negative")
+	ErrMissingEntitlement      = errors.New("entitlement is not configured on every tier")
+	ErrMismatchedEntitlement   = errors.New("entitlement is a boolean on some tiers and a number on others")
+	ErrSeatsEntitlement        = errors.New("max-team-seats is derived from seats and must not be configured")
+
+	ErrUnknownPriceTier     = errors.New("price is mapped to an unknown tier")
+	ErrUnknownTrialTier     = errors.New("trial tier is not configured")
+	ErrInvalidTrialDuration = errors.New("trial duration must be a positive duration")
+
+	ErrInvalidReservationTTL = errors.New("reservation ttl must not be negative")
+
+	ErrUnknownTracingExporter = errors.New("unknown tracing exporter")
+
+	ErrInvalidOutboxBatchSize = errors.New("outbox batch-size must be positive")
+)
+
+// noteTargets lists the targets notes can be written for.
+var noteTargets = []string{"user", "company"}
+
+// NoteEditsFeature is the metered feature note edits are counted under. Its buffer time is the edit session window,
+// in which a note can be edited again without it counting as a new edit.
+const NoteEditsFeature = "note-edits"
+
+// NoteTierInformation configures the limits that only apply to notes. The quota of note edits is the NoteEditsFeature
+// feature.
 type NoteTierInformation struct {
-	MaxEdits       int            `yaml:"max-edits"`
-	CountEditsOver *time.Duration `yaml:"count-edits-over"`
+	// MaxNotes caps the number of distinct notes that can be edited in a window, whatever their target. Unlimited if
+	// nil.
+	MaxNotes *int `yaml:"max-notes"`
+	// MaxNotesPerTarget caps the number of distinct notes that can be edited in a window, keyed by target. Targets
+	// without a cap are unlimited.
+	MaxNotesPerTarget map[string]int `yaml:"max-notes-per-target"`
+	// SlidingEditSession measures the edit session window from the last time the note was edited, instead of the
+	// edit that started the session. Sessions then only end after the buffer time of NoteEditsFeature of inactivity.
+	SlidingEditSession bool `yaml:"sliding-edit-session"`
+}
+
+func (notes NoteTierInformation) Validate() error {
+	if notes.MaxNotes != nil && *notes.MaxNotes < 0 {
+		return ErrInvalidTierMaxNotes
+	}
+
+	for target, maxNotes := range notes.MaxNotesPerTarget {
+		if !lo.Contains(noteTargets, target) {
+			return fmt.Errorf("%w: %q", ErrInvalidTierTarget, target)
+		}
+
+		if maxNotes < 0 {
+			return ErrInvalidTierMaxNotes
+		}
+	}
+
+	return nil
+}
+
+// FeatureTierInformation configures the quota of a metered feature, such as exports or profile enrichments.
+type FeatureTierInformation struct {
+	MaxUses int `yaml:"max-uses"`
+	// CountUsesOver is the length of rolling windows. It is ignored by other windows.
+	CountUsesOver *time.Duration `yaml:"count-uses-over"`
+	// Window is how uses are grouped for counting. Defaults to a rolling window.
+	Window Window `yaml:"window"`
+	// Timezone is the IANA name of the timezone calendar windows are aligned to. Defaults to UTC.
+	Timezone string `yaml:"timezone"`
+	// BufferTime is the time in which a key can be used again, without it counting as a new use. Every use counts if
+	// zero.
+	BufferTime time.Duration `yaml:"buffer-time"`
+}
+
+func (feature FeatureTierInformation) Validate() error {
+	if !feature.Window.Valid() {
+		return fmt.Errorf("%w: %q", ErrInvalidTierWindow, feature.Window)
+	}
+
+	if feature.Window == WindowRolling && (feature.CountUsesOver == nil || *feature.CountUsesOver <= 0) {
+		return ErrInvalidFeatureCountOver
+	}
+
+	if _, err := loadLocation(feature.Timezone); err != nil {
+		return errors.Join(ErrInvalidTierTimezone, err)
+	}
+
+	if feature.MaxUses < 0 {
+		return ErrInvalidFeatureMaxUses
+	}
+
+	if feature.BufferTime < 0 {
+		return ErrInvalidFeatureBufferTime
+	}
+
+	return nil
 }
 
 type TierInformation struct {
+	// Notes configures the limits that only apply to notes, on top of the quota of NoteEditsFeature.
 	Notes NoteTierInformation `yaml:"notes"`
+	// Features lists the quotas of the metered features, keyed by feature name. Every tier must configure the same
+	// features, including NoteEditsFeature.
+	Features map[string]FeatureTierInformation `yaml:"features"`
+	// Entitlements lists the feature flags and allowances of the tier, keyed by entitlement name. Every tier must
+	// configure the same entitlements, with the same type.
+	Entitlements map[string]Entitlement `yaml:"entitlements"`
+	// GracePeriod is how long the tier still applies after a renewal payment failed.
+	GracePeriod time.Duration `yaml:"grace-period"`
+	// Seats is the number of members an organization subscribed to the tier can have. Tiers without seats cannot be
+	// shared with an organization. It is also reported as the SeatsEntitlement entitlement.
+	Seats int `yaml:"seats"`
+}
+
+// NoteEdits returns the quota of note edits.
+func (tier TierInformation) NoteEdits() FeatureTierInformation {
+	return tier.Features[NoteEditsFeature]
+}
+
+func (tier TierInformation) Validate() error {
+	if tier.GracePeriod < 0 {
+		return ErrInvalidGracePeriod
+	}
+
+	if tier.Seats < 0 {
+		return ErrInvalidTierSeats
+	}
+
+	if _, ok := tier.Features[NoteEditsFeature]; !ok {
+		return ErrMissingNoteEditsFeature
+	}
+
+	if err := tier.Notes.Validate(); err != nil {
+		return fmt.Errorf("notes: %w", err)
+	}
+
+	for name, feature := range tier.Features {
+		if err := feature.Validate(); err != nil {
+			return fmt.Errorf("feature %q: %w", name, err)
+		}
+	}
+
+	for name, entitlement := range tier.Entitlements {
+		if err := entitlement.Validate(); err != nil {
+			return fmt.Errorf("entitlement %q: %w", name, err)
+		}
+	}
+
+	if entitlement, ok := tier.Entitlements[SeatsEntitlement]; ok {
+		if !entitlement.IsNumeric() || *entitlement.Limit != tier.Seats {
+			return ErrSeatsEntitlement
+		}
+	}
+
+	return nil
+}
+
+type StripeInformation struct {
+	// WebhookSecret is the signing secret of the webhook endpoint, used to verify the Stripe-Signature header.
+	WebhookSecret string `yaml:"webhook-secret"`
+	// SignatureTolerance is the maximum age of a signed webhook payload. Older payloads are rejected to prevent
+	// replay attacks.
+	SignatureTolerance time.Duration `yaml:"signature-tolerance"`
+	// Prices maps Stripe price IDs to the name of the tier they grant.
+	Prices map[string]string `yaml:"prices"`
+}
+
+type TrialInformation struct {
+	// Tier is the name of the tier granted to users during their trial. Trials are disabled if empty.
+	Tier string `yaml:"tier"`
+	// Duration is the length of a trial.
+	Duration time.Duration `yaml:"duration"`
+	// ExpireInterval is how often the background job looks for expired trials. The job is disabled if zero.
+	ExpireInterval time.Duration `yaml:"expire-interval"`
+}
+
+type ReservationInformation struct {
+	// TTL is how long a note edit counted by CanUpdateNote stays pending, waiting for the notes service to commit it.
+	// Pending edits that are not committed in time stop counting. Edits are committed right away if zero.
+	TTL time.Duration `yaml:"ttl"`
+	// ExpireInterval is how often the background job marks pending edits past their TTL as expired. The job is
+	// disabled if zero.
+	ExpireInterval time.Duration `yaml:"expire-interval"`
+}
+
+type OutboxInformation struct {
+	// RelayInterval is how often the background job publishes the events waiting in the outbox. The job is disabled
+	// if zero, and events pile up in the outbox until it is enabled again.
+	RelayInterval time.Duration `yaml:"relay-interval"`
+	// BatchSize is the number of events published per transaction.
+	BatchSize int `yaml:"batch-size"`
+}
+
+const (
+	// TracingExporterNone disables the export of traces.
+	TracingExporterNone = ""
+	// TracingExporterOTLP sends traces to an OpenTelemetry collector, over gRPC.
+	TracingExporterOTLP = "otlp"
+)
+
+type TracingInformation struct {
+	// Exporter selects where traces are sent. Traces are not exported if empty.
+	Exporter string `yaml:"exporter"`
+	// Endpoint is the host and port of the collector. Defaults to the OTEL_EXPORTER_OTLP_ENDPOINT environment
+	// variable, or localhost:4317, if empty.
+	Endpoint string `yaml:"endpoint"`
+	// Insecure disables TLS when connecting to the collector.
+	Insecure bool `yaml:"insecure"`
 }
 
 type AppType struct {
 	Server struct {
 		Port int `yaml:"port"`
 	} `yaml:"server"`
+	Webhook struct {
+		Port int `yaml:"port"`
+	} `yaml:"webhook"`
+	// Metrics serves Prometheus metrics over HTTP. Disabled if the port is zero.
+	Metrics struct {
+		Port int `yaml:"port"`
+	} `yaml:"metrics"`
 	Postgres struct {
 		DSN string `yaml:"dsn"`
 	} `yaml:"postgres"`
-	FreeTier TierInformation `yaml:"free-tier"`
+	Stripe StripeInformation `yaml:"stripe"`
+	Trial  TrialInformation  `yaml:"trial"`
+	// Reservation configures two-phase consumption of note edits.
+	Reservation ReservationInformation `yaml:"reservation"`
+	// Tracing configures the export of OpenTelemetry traces.
+	Tracing TracingInformation `yaml:"tracing"`
+	// Outbox configures the relay of domain events to other services.
+	Outbox OutboxInformation `yaml:"outbox"`
+	// DefaultTier is the name of the tier applied to users without an active subscription.
+	DefaultTier string `yaml:"default-tier"`
+	// Tiers lists every available tier, keyed by the tier name stored on subscriptions.
+	Tiers map[string]TierInformation `yaml:"tiers"`
 }
 
-var App = deploy.LoadConfig[AppType](
+func (app *AppType) Validate() error {
+	if len(app.Tiers) == 0 {
+		return ErrNoTiers
+	}
+
+	if _, ok := app.Tiers[app.DefaultTier]; !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownDefaultTier, app.DefaultTier)
+	}
+
+	for name, tier := range app.Tiers {
+		if err := tier.Validate(); err != nil {
+			return fmt.Errorf("tier %q: %w", name, err)
+		}
+	}
+
+	// A feature missing from a tier is most likely a typo. Tiers that cannot use a feature set its max-uses to 0.
+	for _, tier := range app.Tiers {
+		for feature := range tier.Features {
+			for name, other := range app.Tiers {
+				if _, ok := other.Features[feature]; !ok {
+					return fmt.Errorf("%w: feature %q, tier %q", ErrMissingFeature, feature, name)
+				}
+			}
+		}
+
+		for entitlementName, entitlement := range tier.Entitlements {
+			for name, other := range app.Tiers {
+				otherEntitlement, ok := other.Entitlements[entitlementName]
+				if !ok {
+					return fmt.Errorf("%w: entitlement %q, tier %q", ErrMissingEntitlement, entitlementName, name)
+				}
+
+				if otherEntitlement.IsNumeric() != entitlement.IsNumeric() {
+					return fmt.Errorf("%w: entitlement %q, tier %q", ErrMismatchedEntitlement, entitlementName, name)
+				}
+			}
+		}
+	}
+
+	for price, tier := range app.Stripe.Prices {
+		if _, ok := app.Tiers[tier]; !ok {
+			return fmt.Errorf("%w: price %q, tier %q", ErrUnknownPriceTier, price, tier)
+		}
+	}
+
+	if app.Trial.Tier != "" {
+		if _, ok := app.Tiers[app.Trial.Tier]; !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownTrialTier, app.Trial.Tier)
+		}
+
+		if app.Trial.Duration <= 0 {
+			return ErrInvalidTrialDuration
+		}
+	}
+
+	if app.Reservation.TTL < 0 {
+		return ErrInvalidReservationTTL
+	}
+
+	if app.Tracing.Exporter != TracingExporterNone && app.Tracing.Exporter != TracingExporterOTLP {
+		return fmt.Errorf("%w: %q", ErrUnknownTracingExporter, app.Tracing.Exporter)
+	}
+
+	if app.Outbox.RelayInterval > 0 && app.Outbox.BatchSize <= 0 {
+		return ErrInvalidOutboxBatchSize
+	}
+
+	return nil
+}
+
+// withSeatsEntitlement reports the seats of every tier as the SeatsEntitlement entitlement, so the seats enforced on
+// organizations and the allowance reported to clients cannot diverge.
+func withSeatsEntitlement(app *AppType) *AppType {
+	for name, tier := range app.Tiers {
+		tier.Entitlements = lo.Assign(tier.Entitlements, map[string]Entitlement{
+			SeatsEntitlement: NumericEntitlement(tier.Seats),
+		})
+		app.Tiers[name] = tier
+	}
+
+	return app
+}
+
+func mustValidate(app *AppType) *AppType {
+	if err := app.Validate(); err != nil {
+		panic(fmt.Errorf("invalid app config: %w", err))
+	}
+
+	return app
+}
+
+var App = mustValidate(withSeatsEntitlement(deploy.LoadConfig[AppType](
 	deploy.GlobalConfig(appFile),
 	deploy.DevConfig(appDevFile),
 	deploy.StagingConfig(appStagingFile),
 	deploy.ProdConfig(appProdFile),
-)
+)))
@@ -0,0 +1,688 @@
+package config_test
+
+import (
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestAppValidate(t *testing.T) {
+	validNoteEdits := config.FeatureTierInformation{
+		MaxUses:       5,
+		CountUsesOver: lo.ToPtr(24 * time.Hour),
+	}
+	validTier := config.TierInformation{
+		Features: map[string]config.FeatureTierInformation{
+			config.NoteEditsFeature: validNoteEdits,
+		},
+	}
+
+	testData := []struct {
+		name      string
+		app       *config.AppType
+		expectErr error
+	}{
+		{
+			name: "Validate",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+					"pro":  validTier,
+				},
+			},
+		},
+		{
+			name: "Validate/NoteEdits/ZeroMaxUses",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								CountUsesOver: lo.ToPtr(24 * time.Hour),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "Validate/NoTiers",
+			app:       &config.AppType{DefaultTier: "free"},
+			expectErr: config.ErrNoTiers,
+		},
+		{
+			name: "Validate/UnknownDefaultTier",
+			app: &config.AppType{
+				DefaultTier: "basic",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+				},
+			},
+			expectErr: config.ErrUnknownDefaultTier,
+		},
+		{
+			name: "Validate/MissingNoteEditsFeature",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							"exports": {MaxUses: 10, Window: config.WindowMonth},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrMissingNoteEditsFeature,
+		},
+		{
+			name: "Validate/NoteEdits/MissingCountUsesOver",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+					"pro": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								MaxUses: 5,
+							},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidFeatureCountOver,
+		},
+		{
+			name: "Validate/NoteEdits/NegativeCountUsesOver",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								MaxUses:       5,
+								CountUsesOver: lo.ToPtr(-time.Hour),
+							},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidFeatureCountOver,
+		},
+		{
+			name: "Validate/NoteEdits/NegativeMaxUses",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								MaxUses:       -1,
+								CountUsesOver: lo.ToPtr(24 * time.Hour),
+							},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidFeatureMaxUses,
+		},
+		{
+			name: "Validate/NoteEdits/NegativeBufferTime",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								CountUsesOver: lo.ToPtr(24 * time.Hour),
+								BufferTime:    -time.Minute,
+							},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidFeatureBufferTime,
+		},
+		{
+			name: "Validate/NoteCaps",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								CountUsesOver: lo.ToPtr(24 * time.Hour),
+							},
+						},
+						Notes: config.NoteTierInformation{
+							MaxNotes:          lo.ToPtr(20),
+							MaxNotesPerTarget: map[string]int{"company": 5, "user": 0},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Validate/NegativeMaxNotes",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								CountUsesOver: lo.ToPtr(24 * time.Hour),
+							},
+						},
+						Notes: config.NoteTierInformation{
+							MaxNotes: lo.ToPtr(-1),
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidTierMaxNotes,
+		},
+		{
+			name: "Validate/NegativeMaxNotesPerTarget",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								CountUsesOver: lo.ToPtr(24 * time.Hour),
+							},
+						},
+						Notes: config.NoteTierInformation{
+							MaxNotesPerTarget: map[string]int{"company": -1},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidTierMaxNotes,
+		},
+		{
+			name: "Validate/UnknownNoteTarget",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								CountUsesOver: lo.ToPtr(24 * time.Hour),
+							},
+						},
+						Notes: config.NoteTierInformation{
+							MaxNotesPerTarget: map[string]int{"school": 5},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidTierTarget,
+		},
+		{
+			name: "Validate/NoteEdits/CalendarWindow",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								MaxUses:  100,
+								Window:   config.WindowMonth,
+								Timezone: "Europe/Paris",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Validate/NoteEdits/UnknownWindow",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								MaxUses: 100,
+								Window:  "year",
+							},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidTierWindow,
+		},
+		{
+			name: "Validate/NoteEdits/UnknownTimezone",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								MaxUses:  100,
+								Window:   config.WindowDay,
+								Timezone: "Europe/Atlantis",
+							},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidTierTimezone,
+		},
+		{
+			name: "Validate/NegativeGracePeriod",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								MaxUses:       5,
+								CountUsesOver: lo.ToPtr(24 * time.Hour),
+							},
+						},
+						GracePeriod: -time.Hour,
+					},
+				},
+			},
+			expectErr: config.ErrInvalidGracePeriod,
+		},
+		{
+			name: "Validate/NegativeSeats",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: {
+								MaxUses:       5,
+								CountUsesOver: lo.ToPtr(24 * time.Hour),
+							},
+						},
+						Seats: -1,
+					},
+				},
+			},
+			expectErr: config.ErrInvalidTierSeats,
+		},
+		{
+			name: "Validate/Features",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: validNoteEdits,
+							"exports":               {MaxUses: 0, CountUsesOver: lo.ToPtr(24 * time.Hour)},
+						},
+					},
+					"pro": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: validNoteEdits,
+							"exports":               {MaxUses: 10, Window: config.WindowMonth, BufferTime: time.Hour},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Validate/MissingFeature",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+					"pro": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: validNoteEdits,
+							"exports":               {MaxUses: 10, Window: config.WindowMonth},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrMissingFeature,
+		},
+		{
+			name: "Validate/Feature/MissingCountUsesOver",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: validNoteEdits,
+							"exports":               {MaxUses: 10},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidFeatureCountOver,
+		},
+		{
+			name: "Validate/Feature/NegativeMaxUses",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: validNoteEdits,
+							"exports":               {MaxUses: -1, Window: config.WindowMonth},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidFeatureMaxUses,
+		},
+		{
+			name: "Validate/Feature/NegativeBufferTime",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: validNoteEdits,
+							"exports":               {MaxUses: 10, Window: config.WindowMonth, BufferTime: -time.Minute},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidFeatureBufferTime,
+		},
+		{
+			name: "Validate/Feature/UnknownWindow",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: map[string]config.FeatureTierInformation{
+							config.NoteEditsFeature: validNoteEdits,
+							"exports":               {MaxUses: 10, Window: "year"},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidTierWindow,
+		},
+		{
+			name: "Validate/Entitlements",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: validTier.Features,
+						Entitlements: map[string]config.Entitlement{
+							"can-export-csv":  {Enabled: false},
+							"max-saved-lists": config.NumericEntitlement(1),
+						},
+					},
+					"team": {
+						Features: validTier.Features,
+						Entitlements: map[string]config.Entitlement{
+							"can-export-csv":  {Enabled: true},
+							"max-saved-lists": config.NumericEntitlement(10),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Validate/MissingEntitlement",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+					"team": {
+						Features: validTier.Features,
+						Entitlements: map[string]config.Entitlement{
+							"can-export-csv": {Enabled: true},
+						},
+					},
+				},
+			},
+			expectErr: config.ErrMissingEntitlement,
+		},
+		{
+			name: "Validate/MismatchedEntitlement",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: validTier.Features,
+						Entitlements: map[string]config.Entitlement{
+							"max-saved-lists": {Enabled: false},
+						},
+					},
+					"team": {
+						Features: validTier.Features,
+						Entitlements: map[string]config.Entitlement{
+							"max-saved-lists": config.NumericEntitlement(10),
+						},
+					},
+				},
+			},
+			expectErr: config.ErrMismatchedEntitlement,
+		},
+		{
+			name: "Validate/SeatsEntitlement",
+			app: &config.AppType{
+				DefaultTier: "team",
+				Tiers: map[string]config.TierInformation{
+					"team": {
+						Features: validTier.Features,
+						Entitlements: map[string]config.Entitlement{
+							config.SeatsEntitlement: config.NumericEntitlement(10),
+						},
+						Seats: 10,
+					},
+				},
+			},
+		},
+		{
+			// The seats entitlement is derived from the seats, so it cannot be configured with another value.
+			name: "Validate/SeatsEntitlement/Mismatched",
+			app: &config.AppType{
+				DefaultTier: "team",
+				Tiers: map[string]config.TierInformation{
+					"team": {
+						Features: validTier.Features,
+						Entitlements: map[string]config.Entitlement{
+							config.SeatsEntitlement: config.NumericEntitlement(25),
+						},
+						Seats: 10,
+					},
+				},
+			},
+			expectErr: config.ErrSeatsEntitlement,
+		},
+		{
+			name: "Validate/NegativeEntitlementLimit",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": {
+						Features: validTier.Features,
+						Entitlements: map[string]config.Entitlement{
+							"max-saved-lists": config.NumericEntitlement(-1),
+						},
+					},
+				},
+			},
+			expectErr: config.ErrInvalidEntitlementLimit,
+		},
+		{
+			name: "Validate/StripePrices",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+					"pro":  validTier,
+				},
+				Stripe: config.StripeInformation{
+					Prices: map[string]string{
+						"price_pro_monthly": "pro",
+						"price_pro_yearly":  "pro",
+					},
+				},
+			},
+		},
+		{
+			name: "Validate/StripePriceUnknownTier",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+				},
+				Stripe: config.StripeInformation{
+					Prices: map[string]string{
+						"price_pro_monthly": "pro",
+					},
+				},
+			},
+			expectErr: config.ErrUnknownPriceTier,
+		},
+		{
+			name: "Validate/Trial",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+					"pro":  validTier,
+				},
+				Trial: config.TrialInformation{
+					Tier:     "pro",
+					Duration: 14 * 24 * time.Hour,
+				},
+			},
+		},
+		{
+			name: "Validate/TrialUnknownTier",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+				},
+				Trial: config.TrialInformation{
+					Tier:     "pro",
+					Duration: 14 * 24 * time.Hour,
+				},
+			},
+			expectErr: config.ErrUnknownTrialTier,
+		},
+		{
+			name: "Validate/TrialNoDuration",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+					"pro":  validTier,
+				},
+				Trial: config.TrialInformation{
+					Tier: "pro",
+				},
+			},
+			expectErr: config.ErrInvalidTrialDuration,
+		},
+		{
+			name: "Validate/Reservation",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+				},
+				Reservation: config.ReservationInformation{
+					TTL:            5 * time.Minute,
+					ExpireInterval: time.Minute,
+				},
+			},
+		},
+		{
+			name: "Validate/NegativeReservationTTL",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+				},
+				Reservation: config.ReservationInformation{
+					TTL: -time.Minute,
+				},
+			},
+			expectErr: config.ErrInvalidReservationTTL,
+		},
+		{
+			name: "Validate/OTLPTracing",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+				},
+				Tracing: config.TracingInformation{
+					Exporter: config.TracingExporterOTLP,
+					Endpoint: "localhost:4317",
+				},
+			},
+		},
+		{
+			name: "Validate/UnknownTracingExporter",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+				},
+				Tracing: config.TracingInformation{
+					Exporter: "zipkin",
+				},
+			},
+			expectErr: config.ErrUnknownTracingExporter,
+		},
+		{
+			name: "Validate/Outbox",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+				},
+				Outbox: config.OutboxInformation{
+					RelayInterval: 5 * time.Second,
+					BatchSize:     100,
+				},
+			},
+		},
+		{
+			name: "Validate/OutboxNoBatchSize",
+			app: &config.AppType{
+				DefaultTier: "free",
+				Tiers: map[string]config.TierInformation{
+					"free": validTier,
+				},
+				Outbox: config.OutboxInformation{
+					RelayInterval: 5 * time.Second,
+				},
+			},
+			expectErr: config.ErrInvalidOutboxBatchSize,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.app.Validate(), tt.expectErr)
+		})
+	}
+}
+
+func TestAppLoaded(t *testing.T) {
+	require.NoError(t, config.App.Validate())
+	require.Contains(t, config.App.Tiers, config.App.DefaultTier)
+
+	// Note edits are metered on every tier.
+	for _, tier := range config.App.Tiers {
+		require.Contains(t, tier.Features, config.NoteEditsFeature)
+	}
+
+	// Seats are configured once, and reported as an entitlement.
+	for _, tier := range config.App.Tiers {
+		require.Equal(t, config.NumericEntitlement(tier.Seats), tier.Entitlements[config.SeatsEntitlement])
+	}
+}
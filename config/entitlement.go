@@ -0,0 +1,51 @@
+package config
+
+import (
+	"errors"
+)
+
+// SeatsEntitlement is the entitlement reporting the seats of a tier. It is derived from TierInformation.Seats, rather
+// than configured.
+const SeatsEntitlement = "max-team-seats"
+
+// Entitlement is either an on/off feature flag, such as "can-export-csv", or a numeric allowance, such as
+// "max-team-seats". It is configured as a boolean or as a number.
+type Entitlement struct {
+	Enabled bool
+	// Limit is the allowance of numeric entitlements. It is nil for on/off entitlements.
+	Limit *int
+}
+
+// NumericEntitlement returns a numeric entitlement, enabled if it allows anything.
+func NumericEntitlement(limit int) Entitlement {
+	return Entitlement{Enabled: limit > 0, Limit: &limit}
+}
+
+// IsNumeric returns true if the entitlement is an allowance, rather than an on/off flag.
+func (entitlement Entitlement) IsNumeric() bool {
+	return entitlement.Limit != nil
+}
+
+func (entitlement *Entitlement) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var enabled bool
+	if err := unmarshal(&enabled); err == nil {
+		*entitlement = Entitlement{Enabled: enabled}
+		return nil
+	}
+
+	var limit int
+	if err := unmarshal(&limit); err != nil {
+		return errors.Join(ErrInvalidEntitlement, err)
+	}
+
+	*entitlement = NumericEntitlement(limit)
+	return nil
+}
+
+func (entitlement Entitlement) Validate() error {
+	if entitlement.Limit != nil && *entitlement.Limit < 0 {
+		return ErrInvalidEntitlementLimit
+	}
+
+	return nil
+}
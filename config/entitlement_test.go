@@ -0,0 +1,56 @@
+package config_test
+
+import (
+	"github.com/goccy/go-yaml"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestEntitlementUnmarshalYAML(t *testing.T) {
+	testData := []struct {
+		name      string
+		in        string
+		expect    config.Entitlement
+		expectErr error
+	}{
+		{
+			name:   "Boolean/Enabled",
+			in:     "true",
+			expect: config.Entitlement{Enabled: true},
+		},
+		{
+			name:   "Boolean/Disabled",
+			in:     "false",
+			expect: config.Entitlement{Enabled: false},
+		},
+		{
+			name:   "Numeric",
+			in:     "10",
+			expect: config.Entitlement{Enabled: true, Limit: lo.ToPtr(10)},
+		},
+		{
+			name:   "Numeric/Zero",
+			in:     "0",
+			expect: config.Entitlement{Enabled: false, Limit: lo.ToPtr(0)},
+		},
+		{
+			name:      "Invalid",
+			in:        "unlimited",
+			expectErr: config.ErrInvalidEntitlement,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			var entitlements map[string]config.Entitlement
+			err := yaml.Unmarshal([]byte("entitlement: "+tt.in), &entitlements)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			if tt.expectErr == nil {
+				require.Equal(t, tt.expect, entitlements["entitlement"])
+			}
+		})
+	}
+}
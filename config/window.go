@@ -0,0 +1,121 @@
+package config
+
+import (
+	"github.com/samber/lo"
+	"sync"
+	"time"
+	// Embed the timezone database, which is not available in the runtime image.
+	_ "time/tzdata"
+)
+
+type Window string
+
+const (
+	// WindowRolling counts the uses of the last CountUsesOver. Each use stops counting on its own.
+	WindowRolling Window = ""
+	// WindowDay counts the uses since midnight.
+	WindowDay Window = "day"
+	// WindowWeek counts the uses since Monday, midnight.
+	WindowWeek Window = "week"
+	// WindowMonth counts the uses since the 1st of the month, midnight.
+	WindowMonth Window = "month"
+	// WindowBillingCycle counts the uses since the start of the current billing period of the user. Users without a
+	// billing period fall back to WindowMonth.
+	WindowBillingCycle Window = "billing-cycle"
+)
+
+func (window Window) Valid() bool {
+	switch window {
+	case WindowRolling, WindowDay, WindowWeek, WindowMonth, WindowBillingCycle:
+		return true
+	default:
+		return false
+	}
+}
+
+var locations sync.Map
+
+// loadLocation caches the locations, which are otherwise read from the timezone database on every call.
+func loadLocation(name string) (*time.Location, error) {
+	if location, ok := locations.Load(name); ok {
+		return location.(*time.Location), nil
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	locations.Store(name, location)
+	return location, nil
+}
+
+// CountWindow returns the window in which uses are counted at the given time. billingPeriodStart and
+// billingPeriodEnd describe the current billing period of the user, if any.
+//
+// The end of the window is nil for rolling windows, as uses stop counting one by one. For other windows, every use
+// stops counting at the end of the window.
+func (feature FeatureTierInformation) CountWindow(now time.Time, billingPeriodStart, billingPeriodEnd *time.Time) (time.Time, *time.Time) {
+	window := feature.Window
+	if window == WindowBillingCycle && (billingPeriodStart == nil || billingPeriodStart.After(now)) {
+		window = WindowMonth
+	}
+
+	location, err := loadLocation(feature.Timezone)
+	if err != nil {
+		// Timezones are checked when the configuration is loaded.
+		location = time.UTC
+	}
+
+	local := now.In(location)
+	year, month, day := local.Date()
+
+	switch window {
+	case WindowDay:
+		return calendarWindow(
+			midnight(year, month, day, location),
+			midnight(year, month, day+1, location),
+		)
+	case WindowWeek:
+		// Weeks start on Monday.
+		offset := (int(local.Weekday()) + 6) % 7
+		return calendarWindow(
+			midnight(year, month, day-offset, location),
+			midnight(year, month, day-offset+7, location),
+		)
+	case WindowMonth:
+		return calendarWindow(
+			midnight(year, month, 1, location),
+			midnight(year, month+1, 1, location),
+		)
+	case WindowBillingCycle:
+		// The renewal of the billing period may not be recorded yet: the next period starts when the current one ends.
+		if billingPeriodEnd != nil && !billingPeriodEnd.After(now) {
+			return billingPeriodEnd.UTC(), nil
+		}
+		if billingPeriodEnd == nil {
+			return billingPeriodStart.UTC(), nil
+		}
+
+		return calendarWindow(*billingPeriodStart, *billingPeriodEnd)
+	default:
+		return now.UTC().Add(-*feature.CountUsesOver), nil
+	}
+}
+
+// midnight returns the first instant of a day. Days start later than midnight when a DST transition skips it.
+func midnight(year int, month time.Month, day int, location *time.Location) time.Time {
+	start := time.Date(year, month, day, 0, 0, 0, 0, location)
+
+	// Skipped midnights are normalized to the previous day, before the transition.
+	if start.Day() != time.Date(year, month, day, 12, 0, 0, 0, location).Day() {
+		_, transition := start.ZoneBounds()
+		return transition
+	}
+
+	return start
+}
+
+func calendarWindow(start, end time.Time) (time.Time, *time.Time) {
+	return start.UTC(), lo.ToPtr(end.UTC())
+}
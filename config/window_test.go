@@ -0,0 +1,252 @@
+package config_test
+
+import (
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCountWindow(t *testing.T) {
+	testData := []struct {
+		name string
+
+		feature            config.FeatureTierInformation
+		now                time.Time
+		billingPeriodStart *time.Time
+		billingPeriodEnd   *time.Time
+
+		expectStart time.Time
+		expectEnd   *time.Time
+	}{
+		{
+			name:        "Rolling",
+			feature:     config.FeatureTierInformation{CountUsesOver: lo.ToPtr(24 * time.Hour)},
+			now:         time.Date(2024, 3, 15, 13, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 14, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "Rolling/IgnoresTimezone",
+			feature:     config.FeatureTierInformation{CountUsesOver: lo.ToPtr(24 * time.Hour), Timezone: "Europe/Paris"},
+			now:         time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 30, 12, 0, 0, 0, time.UTC),
+		},
+
+		// Day windows.
+		{
+			name:        "Day",
+			feature:     config.FeatureTierInformation{Window: config.WindowDay},
+			now:         time.Date(2024, 3, 15, 13, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:        "Day/Midnight",
+			feature:     config.FeatureTierInformation{Window: config.WindowDay},
+			now:         time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 3, 17, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			// 00:30 in Paris, the day already changed locally.
+			name:        "Day/Timezone/AheadOfUTC",
+			feature:     config.FeatureTierInformation{Window: config.WindowDay, Timezone: "Europe/Paris"},
+			now:         time.Date(2024, 1, 15, 23, 30, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 1, 15, 23, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 1, 16, 23, 0, 0, 0, time.UTC)),
+		},
+		{
+			// 22:00 in New York, the day did not change locally yet.
+			name:        "Day/Timezone/BehindUTC",
+			feature:     config.FeatureTierInformation{Window: config.WindowDay, Timezone: "America/New_York"},
+			now:         time.Date(2024, 1, 16, 3, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 1, 15, 5, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 1, 16, 5, 0, 0, 0, time.UTC)),
+		},
+		{
+			// Clocks move forward at 02:00, the day lasts 23 hours.
+			name:        "Day/DST/SpringForward",
+			feature:     config.FeatureTierInformation{Window: config.WindowDay, Timezone: "Europe/Paris"},
+			now:         time.Date(2024, 3, 31, 10, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 30, 23, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 3, 31, 22, 0, 0, 0, time.UTC)),
+		},
+		{
+			// Clocks move back at 03:00, the day lasts 25 hours.
+			name:        "Day/DST/FallBack",
+			feature:     config.FeatureTierInformation{Window: config.WindowDay, Timezone: "Europe/Paris"},
+			now:         time.Date(2024, 10, 27, 12, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 10, 26, 22, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 10, 27, 23, 0, 0, 0, time.UTC)),
+		},
+		{
+			// Clocks move forward at midnight, the day starts at 01:00.
+			name:        "Day/DST/SkippedMidnight",
+			feature:     config.FeatureTierInformation{Window: config.WindowDay, Timezone: "America/Santiago"},
+			now:         time.Date(2024, 9, 8, 15, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 9, 8, 4, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 9, 9, 3, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:        "Day/DST/SkippedMidnight/PreviousDay",
+			feature:     config.FeatureTierInformation{Window: config.WindowDay, Timezone: "America/Santiago"},
+			now:         time.Date(2024, 9, 8, 3, 30, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 9, 7, 4, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 9, 8, 4, 0, 0, 0, time.UTC)),
+		},
+
+		// Week windows.
+		{
+			name:        "Week",
+			feature:     config.FeatureTierInformation{Window: config.WindowWeek},
+			now:         time.Date(2024, 3, 13, 13, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:        "Week/Sunday",
+			feature:     config.FeatureTierInformation{Window: config.WindowWeek},
+			now:         time.Date(2024, 3, 17, 23, 59, 59, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:        "Week/Monday",
+			feature:     config.FeatureTierInformation{Window: config.WindowWeek},
+			now:         time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:        "Week/AcrossMonths",
+			feature:     config.FeatureTierInformation{Window: config.WindowWeek},
+			now:         time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			// The week of the transition lasts 167 hours.
+			name:        "Week/DST/SpringForward",
+			feature:     config.FeatureTierInformation{Window: config.WindowWeek, Timezone: "Europe/Paris"},
+			now:         time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 24, 23, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 3, 31, 22, 0, 0, 0, time.UTC)),
+		},
+
+		// Month windows.
+		{
+			name:        "Month",
+			feature:     config.FeatureTierInformation{Window: config.WindowMonth},
+			now:         time.Date(2024, 3, 15, 13, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:        "Month/LastSecond",
+			feature:     config.FeatureTierInformation{Window: config.WindowMonth},
+			now:         time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC),
+			expectStart: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:        "Month/FirstSecond",
+			feature:     config.FeatureTierInformation{Window: config.WindowMonth},
+			now:         time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:        "Month/December",
+			feature:     config.FeatureTierInformation{Window: config.WindowMonth},
+			now:         time.Date(2024, 12, 31, 12, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			// Already the 1st of March in Paris. The month ends after the DST transition.
+			name:        "Month/Timezone/AheadOfUTC",
+			feature:     config.FeatureTierInformation{Window: config.WindowMonth, Timezone: "Europe/Paris"},
+			now:         time.Date(2024, 2, 29, 23, 30, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 2, 29, 23, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 3, 31, 22, 0, 0, 0, time.UTC)),
+		},
+		{
+			// Still the 31st of March in Los Angeles.
+			name:        "Month/Timezone/BehindUTC",
+			feature:     config.FeatureTierInformation{Window: config.WindowMonth, Timezone: "America/Los_Angeles"},
+			now:         time.Date(2024, 4, 1, 3, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 4, 1, 7, 0, 0, 0, time.UTC)),
+		},
+		{
+			// Already the 1st of February in Auckland.
+			name:        "Month/Timezone/SouthernHemisphere",
+			feature:     config.FeatureTierInformation{Window: config.WindowMonth, Timezone: "Pacific/Auckland"},
+			now:         time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 1, 31, 11, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 2, 29, 11, 0, 0, 0, time.UTC)),
+		},
+
+		// Billing cycle windows.
+		{
+			name:               "BillingCycle",
+			feature:            config.FeatureTierInformation{Window: config.WindowBillingCycle},
+			now:                time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			billingPeriodStart: lo.ToPtr(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)),
+			billingPeriodEnd:   lo.ToPtr(time.Date(2024, 2, 15, 10, 0, 0, 0, time.UTC)),
+			expectStart:        time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+			expectEnd:          lo.ToPtr(time.Date(2024, 2, 15, 10, 0, 0, 0, time.UTC)),
+		},
+		{
+			// Billing periods are not aligned to the timezone.
+			name:               "BillingCycle/IgnoresTimezone",
+			feature:            config.FeatureTierInformation{Window: config.WindowBillingCycle, Timezone: "Europe/Paris"},
+			now:                time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			billingPeriodStart: lo.ToPtr(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)),
+			billingPeriodEnd:   lo.ToPtr(time.Date(2024, 2, 15, 10, 0, 0, 0, time.UTC)),
+			expectStart:        time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+			expectEnd:          lo.ToPtr(time.Date(2024, 2, 15, 10, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:               "BillingCycle/PeriodEnded",
+			feature:            config.FeatureTierInformation{Window: config.WindowBillingCycle},
+			now:                time.Date(2024, 2, 15, 10, 0, 0, 0, time.UTC),
+			billingPeriodStart: lo.ToPtr(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)),
+			billingPeriodEnd:   lo.ToPtr(time.Date(2024, 2, 15, 10, 0, 0, 0, time.UTC)),
+			expectStart:        time.Date(2024, 2, 15, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:               "BillingCycle/NoPeriodEnd",
+			feature:            config.FeatureTierInformation{Window: config.WindowBillingCycle},
+			now:                time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			billingPeriodStart: lo.ToPtr(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)),
+			expectStart:        time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "BillingCycle/NoPeriod",
+			feature:     config.FeatureTierInformation{Window: config.WindowBillingCycle, Timezone: "Europe/Paris"},
+			now:         time.Date(2024, 3, 15, 13, 0, 0, 0, time.UTC),
+			expectStart: time.Date(2024, 2, 29, 23, 0, 0, 0, time.UTC),
+			expectEnd:   lo.ToPtr(time.Date(2024, 3, 31, 22, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:               "BillingCycle/PeriodNotStarted",
+			feature:            config.FeatureTierInformation{Window: config.WindowBillingCycle},
+			now:                time.Date(2024, 3, 15, 13, 0, 0, 0, time.UTC),
+			billingPeriodStart: lo.ToPtr(time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)),
+			billingPeriodEnd:   lo.ToPtr(time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC)),
+			expectStart:        time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expectEnd:          lo.ToPtr(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.feature.CountWindow(tt.now, tt.billingPeriodStart, tt.billingPeriodEnd)
+
+			require.Equal(t, tt.expectStart, start)
+			require.Equal(t, tt.expectEnd, end)
+		})
+	}
+}
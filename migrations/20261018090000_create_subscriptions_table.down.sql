@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS subscriptions_per_user;
+
+--bun:split
+
+DROP TABLE IF EXISTS subscriptions;
+
+--bun:split
+
+DROP TYPE IF EXISTS subscription_status;
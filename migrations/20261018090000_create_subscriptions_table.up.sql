@@ -0,0 +1,22 @@
+CREATE TYPE subscription_status AS ENUM ('active', 'canceled');
+
+--bun:split
+
+CREATE TABLE subscriptions (
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    user_id    VARCHAR(255) NOT NULL,
+
+    tier       VARCHAR(255) NOT NULL,
+    status     subscription_status NOT NULL,
+
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ends_at    TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE INDEX subscriptions_per_user ON subscriptions (user_id, created_at DESC);
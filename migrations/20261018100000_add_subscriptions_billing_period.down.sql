@@ -0,0 +1,5 @@
+ALTER TABLE subscriptions
+    DROP COLUMN IF EXISTS current_period_start,
+    DROP COLUMN IF EXISTS current_period_end,
+    DROP COLUMN IF EXISTS cancel_at_period_end,
+    DROP COLUMN IF EXISTS canceled_at;
@@ -0,0 +1,5 @@
+ALTER TABLE subscriptions
+    ADD COLUMN current_period_start TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN current_period_end   TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN cancel_at_period_end BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN canceled_at          TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS quota_overrides_per_author;
+
+--bun:split
+
+DROP TABLE IF EXISTS quota_overrides;
+
+--bun:split
+
+DROP TYPE IF EXISTS quota_override_kind;
@@ -0,0 +1,21 @@
+CREATE TYPE quota_override_kind AS ENUM ('add', 'replace', 'disable');
+
+--bun:split
+
+CREATE TABLE quota_overrides (
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    author_id  VARCHAR(255) NOT NULL,
+
+    kind       quota_override_kind NOT NULL,
+    max_edits  INTEGER NOT NULL DEFAULT 0,
+
+    expires_at TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE UNIQUE INDEX quota_overrides_per_author ON quota_overrides (author_id);
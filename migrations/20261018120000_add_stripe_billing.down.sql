@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS stripe_events;
+
+--bun:split
+
+DROP INDEX IF EXISTS subscriptions_per_stripe_subscription;
+
+--bun:split
+
+ALTER TABLE subscriptions
+    DROP COLUMN IF EXISTS stripe_subscription_id,
+    DROP COLUMN IF EXISTS payment_failed_at;
@@ -0,0 +1,16 @@
+ALTER TABLE subscriptions
+    ADD COLUMN stripe_subscription_id VARCHAR(255),
+    ADD COLUMN payment_failed_at      TIMESTAMP WITH TIME ZONE;
+
+--bun:split
+
+CREATE UNIQUE INDEX subscriptions_per_stripe_subscription ON subscriptions (stripe_subscription_id);
+
+--bun:split
+
+CREATE TABLE stripe_events (
+    id           VARCHAR(255) PRIMARY KEY,
+    type         VARCHAR(255) NOT NULL,
+
+    processed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,25 @@
+DROP INDEX IF EXISTS subscriptions_per_trial_end;
+
+--bun:split
+
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS trial_ends_at;
+
+--bun:split
+
+UPDATE subscriptions SET status = 'canceled' WHERE status = 'trialing';
+
+--bun:split
+
+ALTER TYPE subscription_status RENAME TO subscription_status_old;
+
+--bun:split
+
+CREATE TYPE subscription_status AS ENUM ('active', 'canceled');
+
+--bun:split
+
+ALTER TABLE subscriptions ALTER COLUMN status TYPE subscription_status USING status::text::subscription_status;
+
+--bun:split
+
+DROP TYPE IF EXISTS subscription_status_old;
@@ -0,0 +1,9 @@
+ALTER TYPE subscription_status ADD VALUE IF NOT EXISTS 'trialing';
+
+--bun:split
+
+ALTER TABLE subscriptions ADD COLUMN trial_ends_at TIMESTAMP WITH TIME ZONE;
+
+--bun:split
+
+CREATE INDEX subscriptions_per_trial_end ON subscriptions (status, trial_ends_at);
@@ -0,0 +1,17 @@
+UPDATE subscriptions SET status = 'active' WHERE status IN ('past_due', 'unpaid');
+
+--bun:split
+
+ALTER TYPE subscription_status RENAME TO subscription_status_old;
+
+--bun:split
+
+CREATE TYPE subscription_status AS ENUM ('active', 'canceled', 'trialing');
+
+--bun:split
+
+ALTER TABLE subscriptions ALTER COLUMN status TYPE subscription_status USING status::text::subscription_status;
+
+--bun:split
+
+DROP TYPE IF EXISTS subscription_status_old;
@@ -0,0 +1,5 @@
+ALTER TYPE subscription_status ADD VALUE IF NOT EXISTS 'past_due';
+
+--bun:split
+
+ALTER TYPE subscription_status ADD VALUE IF NOT EXISTS 'unpaid';
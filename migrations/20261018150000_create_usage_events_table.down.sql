@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS usage_events_per_subject_per_key;
+DROP INDEX IF EXISTS usage_events_per_subject;
+
+--bun:split
+
+DROP TABLE IF EXISTS usage_events;
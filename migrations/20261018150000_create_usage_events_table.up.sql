@@ -0,0 +1,14 @@
+CREATE TABLE usage_events (
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    feature    VARCHAR(255) NOT NULL,
+    subject    VARCHAR(255) NOT NULL,
+    key        VARCHAR(255) NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE INDEX usage_events_per_subject ON usage_events (feature, subject, created_at);
+CREATE INDEX usage_events_per_subject_per_key ON usage_events (feature, subject, key, created_at);
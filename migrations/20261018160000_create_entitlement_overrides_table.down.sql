@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS entitlement_overrides_per_user;
+
+--bun:split
+
+DROP TABLE IF EXISTS entitlement_overrides;
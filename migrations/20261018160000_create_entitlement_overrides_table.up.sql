@@ -0,0 +1,18 @@
+CREATE TABLE entitlement_overrides (
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    user_id     VARCHAR(255) NOT NULL,
+    entitlement VARCHAR(255) NOT NULL,
+
+    enabled     BOOLEAN NOT NULL,
+    limit_value INTEGER,
+
+    expires_at  TIMESTAMP WITH TIME ZONE,
+
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE UNIQUE INDEX entitlement_overrides_per_user ON entitlement_overrides (user_id, entitlement);
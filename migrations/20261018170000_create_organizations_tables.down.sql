@@ -0,0 +1,18 @@
+DROP INDEX IF EXISTS edits_per_organization;
+
+--bun:split
+
+ALTER TABLE note_edits DROP COLUMN IF EXISTS organization_id;
+
+--bun:split
+
+DROP INDEX IF EXISTS organization_members_per_organization;
+DROP INDEX IF EXISTS organization_members_per_user;
+
+--bun:split
+
+DROP TABLE IF EXISTS organization_members;
+
+--bun:split
+
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,35 @@
+CREATE TABLE organizations (
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    name       VARCHAR(255) NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE TABLE organization_members (
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    organization_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    user_id         VARCHAR(255) NOT NULL,
+
+    max_edits       INTEGER,
+
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE UNIQUE INDEX organization_members_per_user ON organization_members (user_id);
+CREATE INDEX organization_members_per_organization ON organization_members (organization_id);
+
+--bun:split
+
+ALTER TABLE note_edits ADD COLUMN organization_id UUID;
+
+--bun:split
+
+CREATE INDEX edits_per_organization ON note_edits (organization_id, created_at);
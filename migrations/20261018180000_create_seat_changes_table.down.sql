@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS seat_changes_per_organization;
+
+--bun:split
+
+DROP TABLE IF EXISTS seat_changes;
+
+--bun:split
+
+DROP TYPE IF EXISTS seat_change_kind;
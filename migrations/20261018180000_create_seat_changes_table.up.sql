@@ -0,0 +1,19 @@
+CREATE TYPE seat_change_kind AS ENUM ('added', 'removed');
+
+--bun:split
+
+CREATE TABLE seat_changes (
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    organization_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    user_id         VARCHAR(255) NOT NULL,
+
+    kind            seat_change_kind NOT NULL,
+    seats_used      INTEGER NOT NULL,
+
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+--bun:split
+
+CREATE INDEX seat_changes_per_organization ON seat_changes (organization_id, created_at);
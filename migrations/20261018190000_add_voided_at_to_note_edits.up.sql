@@ -0,0 +1 @@
+ALTER TABLE note_edits ADD COLUMN voided_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS pending_note_edits;
+
+--bun:split
+
+ALTER TABLE note_edits DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE note_edits DROP COLUMN IF EXISTS status;
+
+--bun:split
+
+DROP TYPE IF EXISTS note_edit_status;
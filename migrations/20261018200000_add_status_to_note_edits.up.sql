@@ -0,0 +1,10 @@
+CREATE TYPE note_edit_status AS ENUM ('pending', 'committed', 'expired');
+
+--bun:split
+
+ALTER TABLE note_edits ADD COLUMN status note_edit_status NOT NULL DEFAULT 'committed';
+ALTER TABLE note_edits ADD COLUMN expires_at TIMESTAMP WITH TIME ZONE;
+
+--bun:split
+
+CREATE INDEX pending_note_edits ON note_edits (expires_at) WHERE status = 'pending';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS note_edits_per_idempotency_key;
+
+--bun:split
+
+ALTER TABLE note_edits DROP COLUMN IF EXISTS remaining_edits;
+ALTER TABLE note_edits DROP COLUMN IF EXISTS idempotency_key;
@@ -0,0 +1,7 @@
+ALTER TABLE note_edits ADD COLUMN idempotency_key VARCHAR(255);
+ALTER TABLE note_edits ADD COLUMN remaining_edits INTEGER;
+
+--bun:split
+
+CREATE UNIQUE INDEX note_edits_per_idempotency_key ON note_edits (author_id, idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE note_edits DROP COLUMN IF EXISTS last_activity_at;
@@ -0,0 +1 @@
+ALTER TABLE note_edits ADD COLUMN last_activity_at TIMESTAMP WITH TIME ZONE;
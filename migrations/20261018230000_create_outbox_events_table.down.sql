@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS pending_outbox_events;
+
+--bun:split
+
+DROP TABLE IF EXISTS outbox_events;
@@ -0,0 +1,14 @@
+CREATE TABLE outbox_events (
+    id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    sequence     BIGSERIAL NOT NULL,
+
+    name         VARCHAR(255) NOT NULL,
+    payload      JSONB NOT NULL,
+
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMP WITH TIME ZONE
+);
+
+--bun:split
+
+CREATE INDEX pending_outbox_events ON outbox_events (sequence) WHERE published_at IS NULL;
@@ -0,0 +1 @@
+ALTER TABLE subscriptions ADD COLUMN stripe_event_created_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1 @@
+ALTER TABLE subscriptions ADD COLUMN stripe_event_id TEXT;
@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+// ClearNoteEditIdempotencyKeyRepository removes the idempotency key of an edit, so the key can be used again.
+type ClearNoteEditIdempotencyKeyRepository interface {
+	ClearNoteEditIdempotencyKey(ctx context.Context, id uuid.UUID) error
+}
+
+type clearNoteEditIdempotencyKeyRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *clearNoteEditIdempotencyKeyRepositoryImpl) ClearNoteEditIdempotencyKey(ctx context.Context, id uuid.UUID) error {
+	ctx, span := startSpan(ctx, "ClearNoteEditIdempotencyKey")
+	defer span.End()
+
+	res, err := getDB(ctx, r.db).NewUpdate().
+		Model((*entities.NoteEdit)(nil)).
+		Set("idempotency_key = NULL").
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrNoNoteEditFound
+	}
+
+	return nil
+}
+
+func NewClearNoteEditIdempotencyKeyRepository(db bun.IDB) ClearNoteEditIdempotencyKeyRepository {
+	return &clearNoteEditIdempotencyKeyRepositoryImpl{
+		db: db,
+	}
+}
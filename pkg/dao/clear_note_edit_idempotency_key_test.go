@@ -0,0 +1,52 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestClearNoteEditIdempotencyKey(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		id        uuid.UUID
+		expectErr error
+	}{
+		{
+			name: "ClearNoteEditIdempotencyKey",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		},
+		{
+			name:      "ClearNoteEditIdempotencyKey/NotFound",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000099"),
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+	}
+
+	stx := BeginTX(db, getNoteEditByIdempotencyKeyFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewClearNoteEditIdempotencyKeyRepository(tx)
+			err := repo.ClearNoteEditIdempotencyKey(context.TODO(), tt.id)
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			if err == nil {
+				// The key can be used again.
+				_, err = dao.NewGetNoteEditByIdempotencyKeyRepository(tx).
+					GetNoteEditByIdempotencyKey(context.TODO(), "author-id-1", "idempotency-key-1")
+				require.ErrorIs(t, err, dao.ErrNoNoteEditFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// CommitNoteEditRepository confirms a pending edit of an author, so it keeps counting against their quota after its
+// expiration at now. Committing an edit that is already committed has no effect. Voided and expired edits are not found.
+type CommitNoteEditRepository interface {
+	CommitNoteEdit(ctx context.Context, id uuid.UUID, author string, now time.Time) (*entities.NoteEdit, error)
+}
+
+type commitNoteEditRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *commitNoteEditRepositoryImpl) CommitNoteEdit(
+	ctx context.Context, id uuid.UUID, author string, now time.Time,
+) (*entities.NoteEdit, error) {
+	ctx, span := startSpan(ctx, "CommitNoteEdit")
+	defer span.End()
+
+	noteEdit := new(entities.NoteEdit)
+
+	res, err := getDB(ctx, r.db).NewUpdate().
+		Model(noteEdit).
+		Set("status = ?", entities.NoteEditStatusCommitted).
+		Set("expires_at = NULL").
+		Where("id = ?", id).
+		Where("author_id = ?", author).
+		Where("voided_at IS NULL").
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("status = ?", entities.NoteEditStatusCommitted).
+				WhereGroup(" OR ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+					return q.
+						Where("status = ?", entities.NoteEditStatusPending).
+						Where("expires_at > ?", now)
+				})
+		}).
+		Returning("*").
+		Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNoNoteEditFound
+	}
+
+	return noteEdit, nil
+}
+
+func NewCommitNoteEditRepository(db bun.IDB) CommitNoteEditRepository {
+	return &commitNoteEditRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,113 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCommitNoteEdit(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		id        uuid.UUID
+		authorID  string
+		now       time.Time
+		expect    *entities.NoteEdit
+		expectErr error
+	}{
+		{
+			name:     "CommitNoteEdit",
+			id:       uuid.MustParse("00000000-0000-0000-0000-000000000008"),
+			authorID: "author-id-4",
+			now:      time.Date(2021, 1, 3, 0, 10, 0, 0, time.UTC),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000008")),
+				AuthorID:         "author-id-4",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "CommitNoteEdit/AlreadyCommitted",
+			id:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			authorID: "author-id-1",
+			now:      time.Date(2021, 1, 3, 0, 10, 0, 0, time.UTC),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID:         "author-id-1",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "CommitNoteEdit/BeforeExpiration",
+			id:       uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			authorID: "author-id-4",
+			now:      time.Date(2021, 1, 3, 0, 4, 0, 0, time.UTC),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000009")),
+				AuthorID:         "author-id-4",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "CommitNoteEdit/PastExpiration",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			authorID:  "author-id-4",
+			now:       time.Date(2021, 1, 3, 0, 10, 0, 0, time.UTC),
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+		{
+			name:      "CommitNoteEdit/Expired",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			authorID:  "author-id-4",
+			now:       time.Date(2021, 1, 3, 0, 10, 0, 0, time.UTC),
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+		{
+			name:      "CommitNoteEdit/Voided",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+			authorID:  "author-id-3",
+			now:       time.Date(2021, 1, 3, 0, 10, 0, 0, time.UTC),
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+		{
+			name:      "CommitNoteEdit/DifferentAuthor",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000008"),
+			authorID:  "author-id-1",
+			now:       time.Date(2021, 1, 3, 0, 10, 0, 0, time.UTC),
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+	}
+
+	stx := BeginTX(db, getLatestNoteEditByAuthorFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCommitNoteEditRepository(tx)
+			noteEdit, err := repo.CommitNoteEdit(context.TODO(), tt.id, tt.authorID, tt.now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, noteEdit)
+		})
+	}
+}
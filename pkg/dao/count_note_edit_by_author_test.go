@@ -56,6 +56,8 @@ func TestCountNoteEditByAuthor(t *testing.T) {
 	db := OpenDB()
 	defer CloseDB(db)
 
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
 	testData := []struct {
 		name      string
 		authorID  string
@@ -81,6 +83,25 @@ func TestCountNoteEditByAuthor(t *testing.T) {
 			since:    lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
 			expect:   0,
 		},
+		{
+			name:     "CountNoteEditByAuthor/SkipVoided",
+			authorID: "author-id-3",
+			since:    lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect:   1,
+		},
+		{
+			name:     "CountNoteEditByAuthor/SkipExpired",
+			authorID: "author-id-4",
+			since:    lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect:   1,
+		},
+		{
+			// Organization edits are counted against the pool of the organization.
+			name:     "CountNoteEditByAuthor/SkipOrganizationEdits",
+			authorID: "author-id-5",
+			since:    lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect:   0,
+		},
 	}
 
 	stx := BeginTX(db, getLatestNoteEditByAuthorFixtures)
@@ -92,7 +113,7 @@ func TestCountNoteEditByAuthor(t *testing.T) {
 			defer RollbackTX(tx)
 
 			repo := dao.NewCountNoteEditsByAuthorRepository(tx)
-			count, err := repo.CountNoteEditsByAuthor(context.Background(), tt.authorID, tt.since)
+			count, err := repo.CountNoteEditsByAuthor(context.Background(), tt.authorID, tt.since, now)
 
 			require.ErrorIs(t, err, tt.expectErr)
 			require.Equal(t, tt.expect, count)
@@ -7,21 +7,28 @@ import (
 	"time"
 )
 
+// CountNoteEditsByAuthorRepository counts the edits an author made on their personal quota. Edits drawn from the pool
+// of an organization are counted by CountNoteEditsByOrganizationRepository instead.
 type CountNoteEditsByAuthorRepository interface {
-	CountNoteEditsByAuthor(ctx context.Context, author string, since *time.Time) (int, error)
+	CountNoteEditsByAuthor(ctx context.Context, author string, since *time.Time, now time.Time) (int, error)
 }
 
 type countNoteEditsByAuthorRepositoryImpl struct {
 	db bun.IDB
 }
 
-func (r *countNoteEditsByAuthorRepositoryImpl) CountNoteEditsByAuthor(ctx context.Context, author string, since *time.Time) (int, error) {
+func (r *countNoteEditsByAuthorRepositoryImpl) CountNoteEditsByAuthor(ctx context.Context, author string, since *time.Time, now time.Time) (int, error) {
+	ctx, span := startSpan(ctx, "CountNoteEditsByAuthor")
+	defer span.End()
+
 	var count int
 
-	count, err := r.db.NewSelect().
+	count, err := getDB(ctx, r.db).NewSelect().
 		Model((*entities.NoteEdit)(nil)).
 		Where("author_id = ?", author).
+		Where("organization_id IS NULL").
 		Where("created_at >= ?", since).
+		Apply(countedNoteEdits(now)).
 		Count(ctx)
 
 	return count, err
@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// OrganizationNoteEditsCount splits the usage of an organization pool between the whole organization and one of its
+// members.
+type OrganizationNoteEditsCount struct {
+	Total  int `bun:"total"`
+	Member int `bun:"member"`
+}
+
+type CountNoteEditsByOrganizationRepository interface {
+	CountNoteEditsByOrganization(
+		ctx context.Context, organization uuid.UUID, member string, since *time.Time, now time.Time,
+	) (*OrganizationNoteEditsCount, error)
+}
+
+type countNoteEditsByOrganizationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *countNoteEditsByOrganizationRepositoryImpl) CountNoteEditsByOrganization(
+	ctx context.Context, organization uuid.UUID, member string, since *time.Time, now time.Time,
+) (*OrganizationNoteEditsCount, error) {
+	ctx, span := startSpan(ctx, "CountNoteEditsByOrganization")
+	defer span.End()
+
+	count := new(OrganizationNoteEditsCount)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model((*entities.NoteEdit)(nil)).
+		ColumnExpr("count(*) AS total").
+		ColumnExpr("count(*) FILTER (WHERE author_id = ?) AS member", member).
+		Where("organization_id = ?", organization).
+		Where("created_at >= ?", since).
+		Apply(countedNoteEdits(now)).
+		Scan(ctx, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+func NewCountNoteEditsByOrganizationRepository(db bun.IDB) CountNoteEditsByOrganizationRepository {
+	return &countNoteEditsByOrganizationRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,136 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var noteEditsByOrganizationFixtures = []*entities.NoteEdit{
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		AuthorID:         "author-id-1",
+		OrganizationID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		AuthorID:         "author-id-2",
+		OrganizationID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+		PublicIdentifier: "public-identifier-2",
+		Target:           entities.TargetCompany,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		AuthorID:         "author-id-1",
+		OrganizationID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+		PublicIdentifier: "public-identifier-3",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)),
+	},
+	// Personal edit of a member, not drawn from the pool.
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+		AuthorID:         "author-id-1",
+		PublicIdentifier: "public-identifier-4",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	// Different organization
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000005")),
+		AuthorID:         "author-id-3",
+		OrganizationID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000102")),
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	// Voided edit, given back to the pool.
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000006")),
+		AuthorID:         "author-id-3",
+		OrganizationID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000102")),
+		PublicIdentifier: "public-identifier-2",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		VoidedAt:         lo.ToPtr(time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC)),
+	},
+}
+
+func TestCountNoteEditsByOrganization(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		member         string
+		since          *time.Time
+		expect         *dao.OrganizationNoteEditsCount
+		expectErr      error
+	}{
+		{
+			name:           "CountNoteEditsByOrganization",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			member:         "author-id-1",
+			since:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect:         &dao.OrganizationNoteEditsCount{Total: 3, Member: 2},
+		},
+		{
+			name:           "CountNoteEditsByOrganization/Since",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			member:         "author-id-1",
+			since:          lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			expect:         &dao.OrganizationNoteEditsCount{Total: 2, Member: 1},
+		},
+		{
+			name:           "CountNoteEditsByOrganization/MemberWithoutEdits",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			member:         "author-id-4",
+			since:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect:         &dao.OrganizationNoteEditsCount{Total: 3, Member: 0},
+		},
+		{
+			name:           "CountNoteEditsByOrganization/SkipVoided",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000102"),
+			member:         "author-id-3",
+			since:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect:         &dao.OrganizationNoteEditsCount{Total: 1, Member: 1},
+		},
+		{
+			name:           "CountNoteEditsByOrganization/None",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000103"),
+			member:         "author-id-1",
+			since:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect:         &dao.OrganizationNoteEditsCount{Total: 0, Member: 0},
+		},
+	}
+
+	stx := BeginTX(db, noteEditsByOrganizationFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCountNoteEditsByOrganizationRepository(tx)
+			count, err := repo.CountNoteEditsByOrganization(
+				context.Background(), tt.organizationID, tt.member, tt.since, now,
+			)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, count)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// CountNotesByAuthorRepository counts the distinct notes an author edited, grouped by target. Edits of the same note
+// only count once.
+type CountNotesByAuthorRepository interface {
+	CountNotesByAuthor(ctx context.Context, author string, since *time.Time, now time.Time) (map[entities.Target]int, error)
+}
+
+type countNotesByAuthorRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *countNotesByAuthorRepositoryImpl) CountNotesByAuthor(
+	ctx context.Context, author string, since *time.Time, now time.Time,
+) (map[entities.Target]int, error) {
+	ctx, span := startSpan(ctx, "CountNotesByAuthor")
+	defer span.End()
+
+	var rows []struct {
+		Target entities.Target `bun:"target"`
+		Notes  int             `bun:"notes"`
+	}
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model((*entities.NoteEdit)(nil)).
+		Column("target").
+		ColumnExpr("count(DISTINCT public_identifier) AS notes").
+		Where("author_id = ?", author).
+		Where("created_at >= ?", since).
+		Apply(countedNoteEdits(now)).
+		Group("target").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[entities.Target]int, len(rows))
+	for _, row := range rows {
+		counts[row.Target] = row.Notes
+	}
+
+	return counts, nil
+}
+
+func NewCountNotesByAuthorRepository(db bun.IDB) CountNotesByAuthorRepository {
+	return &countNotesByAuthorRepositoryImpl{
+		db: db,
+	}
+}
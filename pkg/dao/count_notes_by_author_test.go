@@ -0,0 +1,79 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCountNotesByAuthor(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name      string
+		authorID  string
+		since     *time.Time
+		expect    map[entities.Target]int
+		expectErr error
+	}{
+		{
+			name:     "CountNotesByAuthor",
+			authorID: "author-id-1",
+			since:    lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect: map[entities.Target]int{
+				entities.TargetUser:    2,
+				entities.TargetCompany: 1,
+			},
+		},
+		{
+			name:     "CountNotesByAuthor/Since",
+			authorID: "author-id-1",
+			since:    lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+			expect: map[entities.Target]int{
+				entities.TargetUser:    1,
+				entities.TargetCompany: 1,
+			},
+		},
+		{
+			name:     "CountNotesByAuthor/None",
+			authorID: "author-id-1",
+			since:    lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
+			expect:   map[entities.Target]int{},
+		},
+		{
+			name:     "CountNotesByAuthor/SkipVoided",
+			authorID: "author-id-3",
+			since:    lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect:   map[entities.Target]int{entities.TargetUser: 1},
+		},
+		{
+			name:     "CountNotesByAuthor/SkipExpired",
+			authorID: "author-id-4",
+			since:    lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			expect:   map[entities.Target]int{},
+		},
+	}
+
+	stx := BeginTX(db, getLatestNoteEditByAuthorFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCountNotesByAuthorRepository(tx)
+			counts, err := repo.CountNotesByAuthor(context.TODO(), tt.authorID, tt.since, now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, counts)
+		})
+	}
+}
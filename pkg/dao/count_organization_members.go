@@ -0,0 +1,34 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type CountOrganizationMembersRepository interface {
+	CountOrganizationMembers(ctx context.Context, organization uuid.UUID) (int, error)
+}
+
+type countOrganizationMembersRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *countOrganizationMembersRepositoryImpl) CountOrganizationMembers(
+	ctx context.Context, organization uuid.UUID,
+) (int, error) {
+	ctx, span := startSpan(ctx, "CountOrganizationMembers")
+	defer span.End()
+
+	return getDB(ctx, r.db).NewSelect().
+		Model((*entities.OrganizationMember)(nil)).
+		Where("organization_id = ?", organization).
+		Count(ctx)
+}
+
+func NewCountOrganizationMembersRepository(db bun.IDB) CountOrganizationMembersRepository {
+	return &countOrganizationMembersRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,48 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCountOrganizationMembers(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		expect         int
+		expectErr      error
+	}{
+		{
+			name:           "CountOrganizationMembers",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			expect:         2,
+		},
+		{
+			name:           "CountOrganizationMembers/None",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000103"),
+			expect:         0,
+		},
+	}
+
+	stx := BeginTX(db, organizationMembersFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCountOrganizationMembersRepository(tx)
+			count, err := repo.CountOrganizationMembers(context.TODO(), tt.organizationID)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, count)
+		})
+	}
+}
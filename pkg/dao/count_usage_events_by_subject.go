@@ -0,0 +1,36 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type CountUsageEventsBySubjectRepository interface {
+	CountUsageEventsBySubject(ctx context.Context, feature string, subject string, since *time.Time) (int, error)
+}
+
+type countUsageEventsBySubjectRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *countUsageEventsBySubjectRepositoryImpl) CountUsageEventsBySubject(
+	ctx context.Context, feature string, subject string, since *time.Time,
+) (int, error) {
+	ctx, span := startSpan(ctx, "CountUsageEventsBySubject")
+	defer span.End()
+
+	return getDB(ctx, r.db).NewSelect().
+		Model((*entities.UsageEvent)(nil)).
+		Where("feature = ?", feature).
+		Where("subject = ?", subject).
+		Where("created_at >= ?", since).
+		Count(ctx)
+}
+
+func NewCountUsageEventsBySubjectRepository(db bun.IDB) CountUsageEventsBySubjectRepository {
+	return &countUsageEventsBySubjectRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,69 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCountUsageEventsBySubject(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		feature   string
+		subject   string
+		since     *time.Time
+		expect    int
+		expectErr error
+	}{
+		{
+			name:    "CountUsageEventsBySubject",
+			feature: "exports",
+			subject: "user-id-1",
+			since:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect:  3,
+		},
+		{
+			name:    "CountUsageEventsBySubject/Since",
+			feature: "exports",
+			subject: "user-id-1",
+			since:   lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			expect:  2,
+		},
+		{
+			name:    "CountUsageEventsBySubject/OtherFeature",
+			feature: "ai-summaries",
+			subject: "user-id-1",
+			since:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect:  1,
+		},
+		{
+			name:    "CountUsageEventsBySubject/None",
+			feature: "exports",
+			subject: "user-id-1",
+			since:   lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
+			expect:  0,
+		},
+	}
+
+	stx := BeginTX(db, usageEventsBySubjectFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCountUsageEventsBySubjectRepository(tx)
+			count, err := repo.CountUsageEventsBySubject(context.Background(), tt.feature, tt.subject, tt.since)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, count)
+		})
+	}
+}
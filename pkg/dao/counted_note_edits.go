@@ -0,0 +1,26 @@
+package dao
+
+import (
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// countedNoteEdits restricts a query to the note edits that count against a quota at now: committed edits, and pending
+// edits that did not expire yet. Voided edits never count. Pending edits stop counting as soon as they expire, even
+// before the background job marks them as expired.
+func countedNoteEdits(now time.Time) func(q *bun.SelectQuery) *bun.SelectQuery {
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("voided_at IS NULL").
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where("status = ?", entities.NoteEditStatusCommitted).
+					WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+						return q.
+							Where("status = ?", entities.NoteEditStatusPending).
+							Where("expires_at > ?", now)
+					})
+			})
+	}
+}
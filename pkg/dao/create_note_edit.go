@@ -2,13 +2,29 @@ package dao
 
 import (
 	"context"
+	"errors"
+	"github.com/google/uuid"
 	"github.com/in-rich/uservice-subscription/pkg/entities"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"time"
 )
 
+// noteEditsIdempotencyKeyIndex is the unique index on the idempotency keys of the edits of an author.
+const noteEditsIdempotencyKeyIndex = "note_edits_per_idempotency_key"
+
 type CreateNoteEditData struct {
 	Target           entities.Target
 	PublicIdentifier string
+	// OrganizationID is set when the edit is drawn from the pool of an organization.
+	OrganizationID *uuid.UUID
+	// Status defaults to committed. Pending edits expire at ExpiresAt, unless committed before.
+	Status    entities.NoteEditStatus
+	ExpiresAt *time.Time
+	// IdempotencyKey is unique per author. Creating a second edit with the same key returns
+	// ErrIdempotencyKeyAlreadyUsed.
+	IdempotencyKey *string
+	RemainingEdits *int
 }
 
 type CreateNoteEditRepository interface {
@@ -22,13 +38,26 @@ type createNoteEditRepositoryImpl struct {
 func (r *createNoteEditRepositoryImpl) CreateNoteEdit(
 	ctx context.Context, author string, data *CreateNoteEditData,
 ) (*entities.NoteEdit, error) {
+	ctx, span := startSpan(ctx, "CreateNoteEdit")
+	defer span.End()
+
 	noteEdit := &entities.NoteEdit{
 		PublicIdentifier: data.PublicIdentifier,
 		Target:           data.Target,
 		AuthorID:         author,
+		OrganizationID:   data.OrganizationID,
+		Status:           data.Status,
+		ExpiresAt:        data.ExpiresAt,
+		IdempotencyKey:   data.IdempotencyKey,
+		RemainingEdits:   data.RemainingEdits,
 	}
 
-	if _, err := r.db.NewInsert().Model(noteEdit).Returning("*").Exec(ctx); err != nil {
+	if _, err := getDB(ctx, r.db).NewInsert().Model(noteEdit).Returning("*").Exec(ctx); err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('n') == noteEditsIdempotencyKeyIndex {
+			return nil, ErrIdempotencyKeyAlreadyUsed
+		}
+
 		return nil, err
 	}
 
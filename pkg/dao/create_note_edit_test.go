@@ -43,6 +43,7 @@ func TestCreateNoteEdit(t *testing.T) {
 				AuthorID:         "author-id-1",
 				PublicIdentifier: "public-identifier-2",
 				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
 			},
 		},
 		{
@@ -56,6 +57,58 @@ func TestCreateNoteEdit(t *testing.T) {
 				AuthorID:         "author-id-1",
 				PublicIdentifier: "public-identifier-1",
 				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+			},
+		},
+		{
+			name:     "CreateNoteEdit/Organization",
+			authorID: "author-id-1",
+			data: &dao.CreateNoteEditData{
+				PublicIdentifier: "public-identifier-2",
+				Target:           entities.TargetCompany,
+				OrganizationID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+			},
+			expect: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				OrganizationID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				PublicIdentifier: "public-identifier-2",
+				Target:           entities.TargetCompany,
+				Status:           entities.NoteEditStatusCommitted,
+			},
+		},
+		{
+			name:     "CreateNoteEdit/Pending",
+			authorID: "author-id-1",
+			data: &dao.CreateNoteEditData{
+				PublicIdentifier: "public-identifier-2",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusPending,
+				ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 5, 0, 0, time.UTC)),
+			},
+			expect: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				PublicIdentifier: "public-identifier-2",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusPending,
+				ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 5, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "CreateNoteEdit/IdempotencyKey",
+			authorID: "author-id-1",
+			data: &dao.CreateNoteEditData{
+				PublicIdentifier: "public-identifier-2",
+				Target:           entities.TargetUser,
+				IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+				RemainingEdits:   lo.ToPtr(4),
+			},
+			expect: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				PublicIdentifier: "public-identifier-2",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+				RemainingEdits:   lo.ToPtr(4),
 			},
 		},
 	}
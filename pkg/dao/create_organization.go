@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type CreateOrganizationData struct {
+	Name string
+}
+
+type CreateOrganizationRepository interface {
+	CreateOrganization(ctx context.Context, data *CreateOrganizationData) (*entities.Organization, error)
+}
+
+type createOrganizationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createOrganizationRepositoryImpl) CreateOrganization(
+	ctx context.Context, data *CreateOrganizationData,
+) (*entities.Organization, error) {
+	ctx, span := startSpan(ctx, "CreateOrganization")
+	defer span.End()
+
+	organization := &entities.Organization{
+		Name: data.Name,
+	}
+
+	if _, err := getDB(ctx, r.db).NewInsert().Model(organization).Returning("*").Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+func NewCreateOrganizationRepository(db bun.IDB) CreateOrganizationRepository {
+	return &createOrganizationRepositoryImpl{
+		db: db,
+	}
+}
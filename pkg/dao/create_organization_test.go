@@ -0,0 +1,54 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateOrganization(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		data      *dao.CreateOrganizationData
+		expect    *entities.Organization
+		expectErr error
+	}{
+		{
+			name: "CreateOrganization",
+			data: &dao.CreateOrganizationData{
+				Name: "Acme",
+			},
+			expect: &entities.Organization{
+				Name: "Acme",
+			},
+		},
+	}
+
+	stx := BeginTX[interface{}](db, nil)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateOrganizationRepository(tx)
+			organization, err := repo.CreateOrganization(context.TODO(), tt.data)
+
+			if organization != nil {
+				// Since ID and timestamps are random, nullify them for comparison.
+				organization.ID = nil
+				organization.CreatedAt = nil
+				organization.UpdatedAt = nil
+			}
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, organization)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type CreateOutboxEventData struct {
+	Name    string
+	Payload json.RawMessage
+}
+
+type CreateOutboxEventRepository interface {
+	CreateOutboxEvent(ctx context.Context, data *CreateOutboxEventData) (*entities.OutboxEvent, error)
+}
+
+type createOutboxEventRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createOutboxEventRepositoryImpl) CreateOutboxEvent(
+	ctx context.Context, data *CreateOutboxEventData,
+) (*entities.OutboxEvent, error) {
+	ctx, span := startSpan(ctx, "CreateOutboxEvent")
+	defer span.End()
+
+	event := &entities.OutboxEvent{
+		Name:    data.Name,
+		Payload: data.Payload,
+	}
+
+	if _, err := getDB(ctx, r.db).NewInsert().Model(event).Returning("*").Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func NewCreateOutboxEventRepository(db bun.IDB) CreateOutboxEventRepository {
+	return &createOutboxEventRepositoryImpl{
+		db: db,
+	}
+}
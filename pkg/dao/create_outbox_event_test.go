@@ -0,0 +1,65 @@
+package dao_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateOutboxEvent(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name          string
+		data          *dao.CreateOutboxEventData
+		expect        *entities.OutboxEvent
+		expectPayload string
+		expectErr     error
+	}{
+		{
+			name: "CreateOutboxEvent",
+			data: &dao.CreateOutboxEventData{
+				Name:    "subscription.changed",
+				Payload: json.RawMessage(`{"userID":"user-id-1","tier":"pro"}`),
+			},
+			expect: &entities.OutboxEvent{
+				Name: "subscription.changed",
+			},
+			expectPayload: `{"userID":"user-id-1","tier":"pro"}`,
+		},
+	}
+
+	stx := BeginTX[interface{}](db, nil)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateOutboxEventRepository(tx)
+			event, err := repo.CreateOutboxEvent(context.TODO(), tt.data)
+
+			if event != nil {
+				require.NotNil(t, event.ID)
+				require.NotZero(t, event.Sequence)
+				require.NotNil(t, event.CreatedAt)
+				// Postgres reformats JSONB payloads, so compare them separately.
+				require.JSONEq(t, tt.expectPayload, string(event.Payload))
+
+				// Since ID, Sequence and CreatedAt are random, nullify them for comparison.
+				event.ID = nil
+				event.Sequence = 0
+				event.CreatedAt = nil
+				event.Payload = nil
+			}
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, event)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type CreateSeatChangeData struct {
+	Kind      entities.SeatChangeKind
+	SeatsUsed int
+}
+
+type CreateSeatChangeRepository interface {
+	CreateSeatChange(
+		ctx context.Context, organization uuid.UUID, user string, data *CreateSeatChangeData,
+	) (*entities.SeatChange, error)
+}
+
+type createSeatChangeRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createSeatChangeRepositoryImpl) CreateSeatChange(
+	ctx context.Context, organization uuid.UUID, user string, data *CreateSeatChangeData,
+) (*entities.SeatChange, error) {
+	ctx, span := startSpan(ctx, "CreateSeatChange")
+	defer span.End()
+
+	seatChange := &entities.SeatChange{
+		OrganizationID: &organization,
+		UserID:         user,
+		Kind:           data.Kind,
+		SeatsUsed:      data.SeatsUsed,
+	}
+
+	if _, err := getDB(ctx, r.db).NewInsert().Model(seatChange).Returning("*").Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return seatChange, nil
+}
+
+func NewCreateSeatChangeRepository(db bun.IDB) CreateSeatChangeRepository {
+	return &createSeatChangeRepositoryImpl{
+		db: db,
+	}
+}
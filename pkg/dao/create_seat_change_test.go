@@ -0,0 +1,78 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateSeatChange(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		userID         string
+		data           *dao.CreateSeatChangeData
+		expect         *entities.SeatChange
+		expectErr      error
+	}{
+		{
+			name:           "CreateSeatChange/Added",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			userID:         "user-id-4",
+			data: &dao.CreateSeatChangeData{
+				Kind:      entities.SeatChangeKindAdded,
+				SeatsUsed: 3,
+			},
+			expect: &entities.SeatChange{
+				OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				UserID:         "user-id-4",
+				Kind:           entities.SeatChangeKindAdded,
+				SeatsUsed:      3,
+			},
+		},
+		{
+			name:           "CreateSeatChange/Removed",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			userID:         "user-id-1",
+			data: &dao.CreateSeatChangeData{
+				Kind:      entities.SeatChangeKindRemoved,
+				SeatsUsed: 1,
+			},
+			expect: &entities.SeatChange{
+				OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				UserID:         "user-id-1",
+				Kind:           entities.SeatChangeKindRemoved,
+				SeatsUsed:      1,
+			},
+		},
+	}
+
+	stx := BeginTX(db, organizationMembersFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateSeatChangeRepository(tx)
+			seatChange, err := repo.CreateSeatChange(context.TODO(), tt.organizationID, tt.userID, tt.data)
+
+			if seatChange != nil {
+				// Since ID and CreatedAt are random, nullify them for comparison.
+				seatChange.ID = nil
+				seatChange.CreatedAt = nil
+			}
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, seatChange)
+		})
+	}
+}
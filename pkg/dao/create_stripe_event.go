@@ -0,0 +1,52 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type CreateStripeEventRepository interface {
+	// CreateStripeEvent marks a Stripe event as processed. It returns ErrStripeEventAlreadyProcessed if the event was
+	// already recorded, so callers running it in a transaction can skip events they have seen before.
+	CreateStripeEvent(ctx context.Context, id string, eventType string) error
+}
+
+type createStripeEventRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createStripeEventRepositoryImpl) CreateStripeEvent(ctx context.Context, id string, eventType string) error {
+	ctx, span := startSpan(ctx, "CreateStripeEvent")
+	defer span.End()
+
+	event := &entities.StripeEvent{
+		ID:   id,
+		Type: eventType,
+	}
+
+	res, err := getDB(ctx, r.db).NewInsert().
+		Model(event).
+		On("CONFLICT (id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrStripeEventAlreadyProcessed
+	}
+
+	return nil
+}
+
+func NewCreateStripeEventRepository(db bun.IDB) CreateStripeEventRepository {
+	return &createStripeEventRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,58 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var createStripeEventFixtures = []*entities.StripeEvent{
+	{
+		ID:          "evt_1",
+		Type:        "customer.subscription.created",
+		ProcessedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestCreateStripeEvent(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		id        string
+		eventType string
+		expectErr error
+	}{
+		{
+			name:      "CreateStripeEvent",
+			id:        "evt_2",
+			eventType: "invoice.payment_failed",
+		},
+		{
+			name:      "CreateStripeEvent/AlreadyProcessed",
+			id:        "evt_1",
+			eventType: "customer.subscription.created",
+			expectErr: dao.ErrStripeEventAlreadyProcessed,
+		},
+	}
+
+	stx := BeginTX(db, createStripeEventFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateStripeEventRepository(tx)
+			err := repo.CreateStripeEvent(context.TODO(), tt.id, tt.eventType)
+
+			require.ErrorIs(t, err, tt.expectErr)
+		})
+	}
+}
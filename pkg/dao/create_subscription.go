@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type CreateSubscriptionData struct {
+	Tier      string
+	Status    entities.SubscriptionStatus
+	StartedAt time.Time
+	EndsAt    *time.Time
+
+	CurrentPeriodStart *time.Time
+	CurrentPeriodEnd   *time.Time
+	CancelAtPeriodEnd  bool
+	CanceledAt         *time.Time
+
+	TrialEndsAt *time.Time
+
+	StripeSubscriptionID *string
+	PaymentFailedAt      *time.Time
+	StripeEventCreatedAt *time.Time
+	StripeEventID        *string
+}
+
+type CreateSubscriptionRepository interface {
+	CreateSubscription(ctx context.Context, user string, data *CreateSubscriptionData) (*entities.Subscription, error)
+}
+
+type createSubscriptionRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createSubscriptionRepositoryImpl) CreateSubscription(
+	ctx context.Context, user string, data *CreateSubscriptionData,
+) (*entities.Subscription, error) {
+	ctx, span := startSpan(ctx, "CreateSubscription")
+	defer span.End()
+
+	subscription := &entities.Subscription{
+		UserID:    user,
+		Tier:      data.Tier,
+		Status:    data.Status,
+		StartedAt: &data.StartedAt,
+		EndsAt:    data.EndsAt,
+
+		CurrentPeriodStart: data.CurrentPeriodStart,
+		CurrentPeriodEnd:   data.CurrentPeriodEnd,
+		CancelAtPeriodEnd:  data.CancelAtPeriodEnd,
+		CanceledAt:         data.CanceledAt,
+
+		TrialEndsAt: data.TrialEndsAt,
+
+		StripeSubscriptionID: data.StripeSubscriptionID,
+		PaymentFailedAt:      data.PaymentFailedAt,
+		StripeEventCreatedAt: data.StripeEventCreatedAt,
+		StripeEventID:        data.StripeEventID,
+	}
+
+	if _, err := getDB(ctx, r.db).NewInsert().Model(subscription).Returning("*").Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func NewCreateSubscriptionRepository(db bun.IDB) CreateSubscriptionRepository {
+	return &createSubscriptionRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,94 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var createSubscriptionFixtures = []*entities.Subscription{
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:    "user-id-1",
+		Tier:      "pro",
+		Status:    entities.SubscriptionStatusCanceled,
+		StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndsAt:    lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestCreateSubscription(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		userID    string
+		data      *dao.CreateSubscriptionData
+		expect    *entities.Subscription
+		expectErr error
+	}{
+		{
+			name:   "CreateSubscription",
+			userID: "user-id-2",
+			data: &dao.CreateSubscriptionData{
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expect: &entities.Subscription{
+				UserID:    "user-id-2",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:   "CreateSubscription/ExistingUser",
+			userID: "user-id-1",
+			data: &dao.CreateSubscriptionData{
+				Tier:      "team",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+				EndsAt:    lo.ToPtr(time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &entities.Subscription{
+				UserID:    "user-id-1",
+				Tier:      "team",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+				EndsAt:    lo.ToPtr(time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+	}
+
+	stx := BeginTX(db, createSubscriptionFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateSubscriptionRepository(tx)
+			subscription, err := repo.CreateSubscription(context.TODO(), tt.userID, tt.data)
+
+			if subscription != nil {
+				// Since ID and timestamps are random, nullify them for comparison.
+				subscription.ID = nil
+				subscription.CreatedAt = nil
+				subscription.UpdatedAt = nil
+			}
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, subscription)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type CreateUsageEventData struct {
+	Feature string
+	Key     string
+}
+
+type CreateUsageEventRepository interface {
+	CreateUsageEvent(ctx context.Context, subject string, data *CreateUsageEventData) (*entities.UsageEvent, error)
+}
+
+type createUsageEventRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *createUsageEventRepositoryImpl) CreateUsageEvent(
+	ctx context.Context, subject string, data *CreateUsageEventData,
+) (*entities.UsageEvent, error) {
+	ctx, span := startSpan(ctx, "CreateUsageEvent")
+	defer span.End()
+
+	usageEvent := &entities.UsageEvent{
+		Feature: data.Feature,
+		Subject: subject,
+		Key:     data.Key,
+	}
+
+	if _, err := getDB(ctx, r.db).NewInsert().Model(usageEvent).Returning("*").Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return usageEvent, nil
+}
+
+func NewCreateUsageEventRepository(db bun.IDB) CreateUsageEventRepository {
+	return &createUsageEventRepositoryImpl{
+		db: db,
+	}
+}
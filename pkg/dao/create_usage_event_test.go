@@ -0,0 +1,84 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var createUsageEventFixtures = []*entities.UsageEvent{
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Feature:   "exports",
+		Subject:   "user-id-1",
+		Key:       "key-1",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestCreateUsageEvent(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		subject   string
+		data      *dao.CreateUsageEventData
+		expect    *entities.UsageEvent
+		expectErr error
+	}{
+		{
+			name:    "CreateUsageEvent",
+			subject: "user-id-1",
+			data: &dao.CreateUsageEventData{
+				Feature: "exports",
+				Key:     "key-2",
+			},
+			expect: &entities.UsageEvent{
+				Feature: "exports",
+				Subject: "user-id-1",
+				Key:     "key-2",
+			},
+		},
+		{
+			name:    "CreateUsageEvent/SameKey",
+			subject: "user-id-1",
+			data: &dao.CreateUsageEventData{
+				Feature: "exports",
+				Key:     "key-1",
+			},
+			expect: &entities.UsageEvent{
+				Feature: "exports",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+		},
+	}
+
+	stx := BeginTX(db, createUsageEventFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewCreateUsageEventRepository(tx)
+			usageEvent, err := repo.CreateUsageEvent(context.TODO(), tt.subject, tt.data)
+
+			if usageEvent != nil {
+				// Since ID and CreatedAt are random, nullify them for comparison.
+				usageEvent.ID = nil
+				usageEvent.CreatedAt = nil
+			}
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, usageEvent)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Package daotest holds contract test suites for dao repositories. Each suite runs against every implementation of
+// the repositories it covers, so their behavior cannot drift.
+package daotest
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// NoteEditRepositories groups the repositories covered by RunNoteEditsContract. They must share the same storage.
+type NoteEditRepositories struct {
+	CountNoteEditsByAuthor    dao.CountNoteEditsByAuthorRepository
+	CreateNoteEdit            dao.CreateNoteEditRepository
+	GetLatestNoteEditByAuthor dao.GetLatestNoteEditByAuthorRepository
+}
+
+// RunNoteEditsContract runs the contract of the note edit repositories. newRepositories is called once per test case,
+// and must return repositories backed by an empty storage.
+func RunNoteEditsContract(t *testing.T, newRepositories func(t *testing.T) *NoteEditRepositories) {
+	t.Run("CreateNoteEdit", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		noteEdit, err := repositories.CreateNoteEdit.CreateNoteEdit(context.TODO(), "author-id-1", &dao.CreateNoteEditData{
+			PublicIdentifier: "public-identifier-1",
+			Target:           entities.TargetUser,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, noteEdit.ID)
+		require.NotNil(t, noteEdit.CreatedAt)
+
+		// Since ID and CreatedAt are random, nullify them for comparison.
+		noteEdit.ID = nil
+		noteEdit.CreatedAt = nil
+
+		require.Equal(t, &entities.NoteEdit{
+			AuthorID:         "author-id-1",
+			PublicIdentifier: "public-identifier-1",
+			Target:           entities.TargetUser,
+			Status:           entities.NoteEditStatusCommitted,
+		}, noteEdit)
+	})
+
+	t.Run("CreateNoteEdit/Pending", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		noteEdit, err := repositories.CreateNoteEdit.CreateNoteEdit(context.TODO(), "author-id-1", &dao.CreateNoteEditData{
+			PublicIdentifier: "public-identifier-1",
+			Target:           entities.TargetCompany,
+			Status:           entities.NoteEditStatusPending,
+			ExpiresAt:        lo.ToPtr(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+			IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+			RemainingEdits:   lo.ToPtr(4),
+		})
+		require.NoError(t, err)
+
+		// Since ID and CreatedAt are random, nullify them for comparison.
+		noteEdit.ID = nil
+		noteEdit.CreatedAt = nil
+
+		require.Equal(t, &entities.NoteEdit{
+			AuthorID:         "author-id-1",
+			PublicIdentifier: "public-identifier-1",
+			Target:           entities.TargetCompany,
+			Status:           entities.NoteEditStatusPending,
+			ExpiresAt:        lo.ToPtr(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+			IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+			RemainingEdits:   lo.ToPtr(4),
+		}, noteEdit)
+	})
+
+	t.Run("CreateNoteEdit/IdempotencyKeyAlreadyUsed", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		data := &dao.CreateNoteEditData{
+			PublicIdentifier: "public-identifier-1",
+			Target:           entities.TargetUser,
+			IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+		}
+
+		_, err := repositories.CreateNoteEdit.CreateNoteEdit(context.TODO(), "author-id-1", data)
+		require.NoError(t, err)
+
+		// Keys are unique per author.
+		_, err = repositories.CreateNoteEdit.CreateNoteEdit(context.TODO(), "author-id-2", data)
+		require.NoError(t, err)
+
+		// The failed insert aborts the transaction of the Postgres implementation, so it must come last.
+		_, err = repositories.CreateNoteEdit.CreateNoteEdit(context.TODO(), "author-id-1", data)
+		require.ErrorIs(t, err, dao.ErrIdempotencyKeyAlreadyUsed)
+	})
+
+	t.Run("CountNoteEditsByAuthor", func(t *testing.T) {
+		repositories := newRepositories(t)
+		createNoteEdits(t, repositories.CreateNoteEdit)
+
+		count, err := repositories.CountNoteEditsByAuthor.CountNoteEditsByAuthor(
+			context.TODO(), "author-id-1", lo.ToPtr(time.Now().Add(-time.Hour)), time.Now(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+	})
+
+	t.Run("CountNoteEditsByAuthor/Since", func(t *testing.T) {
+		repositories := newRepositories(t)
+		createNoteEdits(t, repositories.CreateNoteEdit)
+
+		count, err := repositories.CountNoteEditsByAuthor.CountNoteEditsByAuthor(
+			context.TODO(), "author-id-1", lo.ToPtr(time.Now().Add(time.Hour)), time.Now(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+	})
+
+	t.Run("CountNoteEditsByAuthor/None", func(t *testing.T) {
+		repositories := newRepositories(t)
+		createNoteEdits(t, repositories.CreateNoteEdit)
+
+		count, err := repositories.CountNoteEditsByAuthor.CountNoteEditsByAuthor(
+			context.TODO(), "author-id-3", lo.ToPtr(time.Now().Add(-time.Hour)), time.Now(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+	})
+
+	t.Run("CountNoteEditsByAuthor/SkipOrganizationEdits", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		_, err := repositories.CreateNoteEdit.CreateNoteEdit(context.TODO(), "author-id-1", &dao.CreateNoteEditData{
+			PublicIdentifier: "public-identifier-1",
+			Target:           entities.TargetUser,
+			OrganizationID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+		})
+		require.NoError(t, err)
+
+		count, err := repositories.CountNoteEditsByAuthor.CountNoteEditsByAuthor(
+			context.TODO(), "author-id-1", lo.ToPtr(time.Now().Add(-time.Hour)), time.Now(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+	})
+
+	t.Run("GetLatestNoteEditByAuthor", func(t *testing.T) {
+		repositories := newRepositories(t)
+		noteEdits := createNoteEdits(t, repositories.CreateNoteEdit)
+
+		noteEdit, err := repositories.GetLatestNoteEditByAuthor.GetLatestNoteEditByAuthor(
+			context.TODO(), "author-id-1", entities.TargetCompany, "public-identifier-1", time.Now(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, noteEdits[1], noteEdit)
+	})
+
+	t.Run("GetLatestNoteEditByAuthor/SkipExpired", func(t *testing.T) {
+		repositories := newRepositories(t)
+		createNoteEdits(t, repositories.CreateNoteEdit)
+
+		_, err := repositories.GetLatestNoteEditByAuthor.GetLatestNoteEditByAuthor(
+			context.TODO(), "author-id-1", entities.TargetUser, "public-identifier-3", time.Now(),
+		)
+		require.ErrorIs(t, err, dao.ErrNoNoteEditFound)
+	})
+
+	t.Run("GetLatestNoteEditByAuthor/NotExpiredYet", func(t *testing.T) {
+		repositories := newRepositories(t)
+		noteEdits := createNoteEdits(t, repositories.CreateNoteEdit)
+
+		// Pending edits are compared with the time passed by the caller, not the clock of the storage.
+		noteEdit, err := repositories.GetLatestNoteEditByAuthor.GetLatestNoteEditByAuthor(
+			context.TODO(), "author-id-1", entities.TargetUser, "public-identifier-3",
+			time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC),
+		)
+		require.NoError(t, err)
+		require.Equal(t, noteEdits[3], noteEdit)
+	})
+
+	t.Run("GetLatestNoteEditByAuthor/NotFound", func(t *testing.T) {
+		repositories := newRepositories(t)
+		createNoteEdits(t, repositories.CreateNoteEdit)
+
+		_, err := repositories.GetLatestNoteEditByAuthor.GetLatestNoteEditByAuthor(
+			context.TODO(), "author-id-2", entities.TargetCompany, "public-identifier-1", time.Now(),
+		)
+		require.ErrorIs(t, err, dao.ErrNoNoteEditFound)
+	})
+}
+
+// createNoteEdits stores the edits the contract is checked against, and returns them in order of creation.
+func createNoteEdits(t *testing.T, repository dao.CreateNoteEditRepository) []*entities.NoteEdit {
+	data := []struct {
+		author string
+		data   *dao.CreateNoteEditData
+	}{
+		{
+			author: "author-id-1",
+			data:   &dao.CreateNoteEditData{PublicIdentifier: "public-identifier-1", Target: entities.TargetUser},
+		},
+		// Different target.
+		{
+			author: "author-id-1",
+			data:   &dao.CreateNoteEditData{PublicIdentifier: "public-identifier-1", Target: entities.TargetCompany},
+		},
+		// Different note.
+		{
+			author: "author-id-1",
+			data: &dao.CreateNoteEditData{
+				PublicIdentifier: "public-identifier-2",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusPending,
+				ExpiresAt:        lo.ToPtr(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		// Pending edits stop counting once expired.
+		{
+			author: "author-id-1",
+			data: &dao.CreateNoteEditData{
+				PublicIdentifier: "public-identifier-3",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusPending,
+				ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		// Different author.
+		{
+			author: "author-id-2",
+			data:   &dao.CreateNoteEditData{PublicIdentifier: "public-identifier-1", Target: entities.TargetUser},
+		},
+	}
+
+	noteEdits := make([]*entities.NoteEdit, len(data))
+	for i, item := range data {
+		noteEdit, err := repository.CreateNoteEdit(context.TODO(), item.author, item.data)
+		require.NoError(t, err)
+
+		noteEdits[i] = noteEdit
+	}
+
+	return noteEdits
+}
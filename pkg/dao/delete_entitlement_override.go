@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type DeleteEntitlementOverrideRepository interface {
+	DeleteEntitlementOverride(ctx context.Context, user string, entitlement string) error
+}
+
+type deleteEntitlementOverrideRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deleteEntitlementOverrideRepositoryImpl) DeleteEntitlementOverride(ctx context.Context, user string, entitlement string) error {
+	ctx, span := startSpan(ctx, "DeleteEntitlementOverride")
+	defer span.End()
+
+	res, err := getDB(ctx, r.db).NewDelete().
+		Model((*entities.EntitlementOverride)(nil)).
+		Where("user_id = ?", user).
+		Where("entitlement = ?", entitlement).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrEntitlementOverrideNotFound
+	}
+
+	return nil
+}
+
+func NewDeleteEntitlementOverrideRepository(db bun.IDB) DeleteEntitlementOverrideRepository {
+	return &deleteEntitlementOverrideRepositoryImpl{
+		db: db,
+	}
+}
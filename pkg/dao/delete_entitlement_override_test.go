@@ -0,0 +1,75 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var deleteEntitlementOverrideFixtures = []*entities.EntitlementOverride{
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:      "user-id-1",
+		Entitlement: "can-export-csv",
+		Enabled:     true,
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestDeleteEntitlementOverride(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		userID      string
+		entitlement string
+		expectErr   error
+	}{
+		{
+			name:        "DeleteEntitlementOverride",
+			userID:      "user-id-1",
+			entitlement: "can-export-csv",
+		},
+		{
+			name:        "DeleteEntitlementOverride/OtherEntitlement",
+			userID:      "user-id-1",
+			entitlement: "max-team-seats",
+			expectErr:   dao.ErrEntitlementOverrideNotFound,
+		},
+		{
+			name:        "DeleteEntitlementOverride/NotFound",
+			userID:      "user-id-2",
+			entitlement: "can-export-csv",
+			expectErr:   dao.ErrEntitlementOverrideNotFound,
+		},
+	}
+
+	stx := BeginTX(db, deleteEntitlementOverrideFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeleteEntitlementOverrideRepository(tx)
+			err := repo.DeleteEntitlementOverride(context.TODO(), tt.userID, tt.entitlement)
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			if err == nil {
+				overrides, err := dao.NewListEntitlementOverridesByUserRepository(tx).
+					ListEntitlementOverridesByUser(context.TODO(), tt.userID)
+				require.NoError(t, err)
+				require.Empty(t, overrides)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type DeleteOrganizationMemberRepository interface {
+	DeleteOrganizationMember(ctx context.Context, organization uuid.UUID, user string) error
+}
+
+type deleteOrganizationMemberRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deleteOrganizationMemberRepositoryImpl) DeleteOrganizationMember(
+	ctx context.Context, organization uuid.UUID, user string,
+) error {
+	ctx, span := startSpan(ctx, "DeleteOrganizationMember")
+	defer span.End()
+
+	res, err := getDB(ctx, r.db).NewDelete().
+		Model((*entities.OrganizationMember)(nil)).
+		Where("organization_id = ?", organization).
+		Where("user_id = ?", user).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrOrganizationMemberNotFound
+	}
+
+	return nil
+}
+
+func NewDeleteOrganizationMemberRepository(db bun.IDB) DeleteOrganizationMemberRepository {
+	return &deleteOrganizationMemberRepositoryImpl{
+		db: db,
+	}
+}
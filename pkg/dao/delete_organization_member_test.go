@@ -0,0 +1,59 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDeleteOrganizationMember(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		userID         string
+		expectErr      error
+	}{
+		{
+			name:           "DeleteOrganizationMember",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			userID:         "user-id-1",
+		},
+		{
+			name:           "DeleteOrganizationMember/OtherOrganization",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			userID:         "user-id-3",
+			expectErr:      dao.ErrOrganizationMemberNotFound,
+		},
+		{
+			name:           "DeleteOrganizationMember/NotFound",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			userID:         "user-id-4",
+			expectErr:      dao.ErrOrganizationMemberNotFound,
+		},
+	}
+
+	stx := BeginTX(db, organizationMembersFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeleteOrganizationMemberRepository(tx)
+			err := repo.DeleteOrganizationMember(context.TODO(), tt.organizationID, tt.userID)
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			if err == nil {
+				_, err := dao.NewGetOrganizationMemberByUserRepository(tx).GetOrganizationMemberByUser(context.TODO(), tt.userID)
+				require.ErrorIs(t, err, dao.ErrOrganizationMemberNotFound)
+			}
+		})
+	}
+}
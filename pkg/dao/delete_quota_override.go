@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type DeleteQuotaOverrideRepository interface {
+	DeleteQuotaOverride(ctx context.Context, author string) error
+}
+
+type deleteQuotaOverrideRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *deleteQuotaOverrideRepositoryImpl) DeleteQuotaOverride(ctx context.Context, author string) error {
+	ctx, span := startSpan(ctx, "DeleteQuotaOverride")
+	defer span.End()
+
+	res, err := getDB(ctx, r.db).NewDelete().
+		Model((*entities.QuotaOverride)(nil)).
+		Where("author_id = ?", author).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrQuotaOverrideNotFound
+	}
+
+	return nil
+}
+
+func NewDeleteQuotaOverrideRepository(db bun.IDB) DeleteQuotaOverrideRepository {
+	return &deleteQuotaOverrideRepositoryImpl{
+		db: db,
+	}
+}
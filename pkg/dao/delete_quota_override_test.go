@@ -0,0 +1,64 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var deleteQuotaOverrideFixtures = []*entities.QuotaOverride{
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		AuthorID:  "author-id-1",
+		Kind:      entities.QuotaOverrideKindAdd,
+		MaxEdits:  10,
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestDeleteQuotaOverride(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		authorID  string
+		expectErr error
+	}{
+		{
+			name:     "DeleteQuotaOverride",
+			authorID: "author-id-1",
+		},
+		{
+			name:      "DeleteQuotaOverride/NotFound",
+			authorID:  "author-id-2",
+			expectErr: dao.ErrQuotaOverrideNotFound,
+		},
+	}
+
+	stx := BeginTX(db, deleteQuotaOverrideFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewDeleteQuotaOverrideRepository(tx)
+			err := repo.DeleteQuotaOverride(context.TODO(), tt.authorID)
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			if err == nil {
+				_, err = dao.NewGetQuotaOverrideByAuthorRepository(tx).GetQuotaOverrideByAuthor(context.TODO(), tt.authorID)
+				require.ErrorIs(t, err, dao.ErrQuotaOverrideNotFound)
+			}
+		})
+	}
+}
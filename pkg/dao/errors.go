@@ -3,5 +3,15 @@ package dao
 import "errors"
 
 var (
-	ErrNoNoteEditFound = errors.New("no note edit found")
+	ErrNoNoteEditFound             = errors.New("no note edit found")
+	ErrNoUsageEventFound           = errors.New("no usage event found")
+	ErrSubscriptionNotFound        = errors.New("subscription not found")
+	ErrQuotaOverrideNotFound       = errors.New("quota override not found")
+	ErrEntitlementOverrideNotFound = errors.New("entitlement override not found")
+	ErrOrganizationNotFound        = errors.New("organization not found")
+	ErrOrganizationMemberNotFound  = errors.New("organization member not found")
+
+	ErrStripeEventAlreadyProcessed = errors.New("stripe event already processed")
+	ErrMemberOfAnotherOrganization = errors.New("user is a member of another organization")
+	ErrIdempotencyKeyAlreadyUsed   = errors.New("idempotency key already used")
 )
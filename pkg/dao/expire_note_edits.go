@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type ExpireNoteEditsRepository interface {
+	// ExpireNoteEdits marks up to limit pending edits that expired before now as expired, and returns them. Voided
+	// edits were already released, and are left out. Rows locked by another transaction are skipped, so multiple
+	// instances can expire edits concurrently.
+	ExpireNoteEdits(ctx context.Context, now time.Time, limit int) ([]*entities.NoteEdit, error)
+}
+
+type expireNoteEditsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *expireNoteEditsRepositoryImpl) ExpireNoteEdits(
+	ctx context.Context, now time.Time, limit int,
+) ([]*entities.NoteEdit, error) {
+	ctx, span := startSpan(ctx, "ExpireNoteEdits")
+	defer span.End()
+
+	noteEdits := make([]*entities.NoteEdit, 0)
+
+	expired := getDB(ctx, r.db).NewSelect().
+		Model((*entities.NoteEdit)(nil)).
+		Column("id").
+		Where("status = ?", entities.NoteEditStatusPending).
+		Where("voided_at IS NULL").
+		Where("expires_at <= ?", now).
+		OrderExpr("expires_at ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	_, err := getDB(ctx, r.db).NewUpdate().
+		Model((*entities.NoteEdit)(nil)).
+		Set("status = ?", entities.NoteEditStatusExpired).
+		Where("id IN (?)", expired).
+		Returning("*").
+		Exec(ctx, &noteEdits)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return noteEdits, nil
+}
+
+func NewExpireNoteEditsRepository(db bun.IDB) ExpireNoteEditsRepository {
+	return &expireNoteEditsRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,167 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var expireNoteEditsFixtures = []*entities.NoteEdit{
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		AuthorID:         "author-id-1",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		Status:           entities.NoteEditStatusPending,
+		ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 5, 0, 0, time.UTC)),
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		AuthorID:         "author-id-2",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		Status:           entities.NoteEditStatusPending,
+		ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 5, 0, 0, time.UTC)),
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	// Pending edit that did not expire yet
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		AuthorID:         "author-id-1",
+		PublicIdentifier: "public-identifier-2",
+		Target:           entities.TargetUser,
+		Status:           entities.NoteEditStatusPending,
+		ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 10, 0, 5, 0, 0, time.UTC)),
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
+	},
+	// Committed edit
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+		AuthorID:         "author-id-1",
+		PublicIdentifier: "public-identifier-3",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestExpireNoteEdits(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name   string
+		now    time.Time
+		limit  int
+		expect []*entities.NoteEdit
+	}{
+		{
+			name:  "ExpireNoteEdits",
+			now:   time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+			limit: 10,
+			expect: []*entities.NoteEdit{
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					AuthorID:         "author-id-1",
+					PublicIdentifier: "public-identifier-1",
+					Target:           entities.TargetUser,
+					Status:           entities.NoteEditStatusExpired,
+					ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 5, 0, 0, time.UTC)),
+					CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					AuthorID:         "author-id-2",
+					PublicIdentifier: "public-identifier-1",
+					Target:           entities.TargetUser,
+					Status:           entities.NoteEditStatusExpired,
+					ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 5, 0, 0, time.UTC)),
+					CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:  "ExpireNoteEdits/Limit",
+			now:   time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+			limit: 1,
+			expect: []*entities.NoteEdit{
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					AuthorID:         "author-id-1",
+					PublicIdentifier: "public-identifier-1",
+					Target:           entities.TargetUser,
+					Status:           entities.NoteEditStatusExpired,
+					ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 5, 0, 0, time.UTC)),
+					CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:   "ExpireNoteEdits/NoneExpired",
+			now:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			limit:  10,
+			expect: []*entities.NoteEdit{},
+		},
+	}
+
+	stx := BeginTX(db, expireNoteEditsFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewExpireNoteEditsRepository(tx)
+			noteEdits, err := repo.ExpireNoteEdits(context.TODO(), tt.now, tt.limit)
+
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.expect, noteEdits)
+		})
+	}
+}
+
+// An edit is released once, whether it is voided or expired first.
+func TestExpireNoteEditsAndVoid(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	expiredAt := time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)
+	noteEditID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	stx := BeginTX(db, expireNoteEditsFixtures)
+	defer RollbackTX(stx)
+
+	t.Run("VoidThenExpire", func(t *testing.T) {
+		tx := BeginTX[interface{}](stx, nil)
+		defer RollbackTX(tx)
+
+		voided, err := dao.NewVoidNoteEditRepository(tx).VoidNoteEdit(
+			context.TODO(), noteEditID, "author-id-1", time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC),
+		)
+		require.NoError(t, err)
+		require.Equal(t, noteEditID, *voided.ID)
+
+		expired, err := dao.NewExpireNoteEditsRepository(tx).ExpireNoteEdits(context.TODO(), expiredAt, 10)
+		require.NoError(t, err)
+		require.Len(t, expired, 1)
+		require.NotEqual(t, noteEditID, *expired[0].ID)
+	})
+
+	t.Run("ExpireThenVoid", func(t *testing.T) {
+		tx := BeginTX[interface{}](stx, nil)
+		defer RollbackTX(tx)
+
+		expired, err := dao.NewExpireNoteEditsRepository(tx).ExpireNoteEdits(context.TODO(), expiredAt, 10)
+		require.NoError(t, err)
+		require.Len(t, expired, 2)
+
+		_, err = dao.NewVoidNoteEditRepository(tx).VoidNoteEdit(context.TODO(), noteEditID, "author-id-1", expiredAt)
+		require.ErrorIs(t, err, dao.ErrNoNoteEditFound)
+	})
+}
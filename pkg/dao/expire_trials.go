@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type ExpireTrialsRepository interface {
+	// ExpireTrials cancels up to limit trial subscriptions that ended before now, and returns them. Rows locked by
+	// another transaction are skipped, so multiple instances can expire trials concurrently.
+	ExpireTrials(ctx context.Context, now time.Time, limit int) ([]*entities.Subscription, error)
+}
+
+type expireTrialsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *expireTrialsRepositoryImpl) ExpireTrials(ctx context.Context, now time.Time, limit int) ([]*entities.Subscription, error) {
+	ctx, span := startSpan(ctx, "ExpireTrials")
+	defer span.End()
+
+	subscriptions := make([]*entities.Subscription, 0)
+
+	expired := getDB(ctx, r.db).NewSelect().
+		Model((*entities.Subscription)(nil)).
+		Column("id").
+		Where("status = ?", entities.SubscriptionStatusTrialing).
+		Where("trial_ends_at <= ?", now).
+		OrderExpr("trial_ends_at ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	_, err := getDB(ctx, r.db).NewUpdate().
+		Model((*entities.Subscription)(nil)).
+		Set("status = ?", entities.SubscriptionStatusCanceled).
+		Set("ends_at = trial_ends_at").
+		Set("updated_at = NOW()").
+		Where("id IN (?)", expired).
+		Returning("*").
+		Exec(ctx, &subscriptions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func NewExpireTrialsRepository(db bun.IDB) ExpireTrialsRepository {
+	return &expireTrialsRepositoryImpl{
+		db: db,
+	}
+}
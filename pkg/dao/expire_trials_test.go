@@ -0,0 +1,141 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var expireTrialsFixtures = []*entities.Subscription{
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:      "user-id-1",
+		Tier:        "pro",
+		Status:      entities.SubscriptionStatusTrialing,
+		StartedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		UserID:      "user-id-2",
+		Tier:        "pro",
+		Status:      entities.SubscriptionStatusTrialing,
+		StartedAt:   lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+		TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 16, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	// Trial still running
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		UserID:      "user-id-3",
+		Tier:        "pro",
+		Status:      entities.SubscriptionStatusTrialing,
+		StartedAt:   lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
+		TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 24, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
+	},
+	// Trial converted to a paid subscription
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+		UserID:      "user-id-4",
+		Tier:        "pro",
+		Status:      entities.SubscriptionStatusActive,
+		StartedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestExpireTrials(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name   string
+		now    time.Time
+		limit  int
+		expect []*entities.Subscription
+	}{
+		{
+			name:  "ExpireTrials",
+			now:   time.Date(2021, 1, 20, 0, 0, 0, 0, time.UTC),
+			limit: 10,
+			expect: []*entities.Subscription{
+				{
+					ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					UserID:      "user-id-1",
+					Tier:        "pro",
+					Status:      entities.SubscriptionStatusCanceled,
+					StartedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+					EndsAt:      lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+					TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+					CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				{
+					ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					UserID:      "user-id-2",
+					Tier:        "pro",
+					Status:      entities.SubscriptionStatusCanceled,
+					StartedAt:   lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+					EndsAt:      lo.ToPtr(time.Date(2021, 1, 16, 0, 0, 0, 0, time.UTC)),
+					TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 16, 0, 0, 0, 0, time.UTC)),
+					CreatedAt:   lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:  "ExpireTrials/Limit",
+			now:   time.Date(2021, 1, 20, 0, 0, 0, 0, time.UTC),
+			limit: 1,
+			expect: []*entities.Subscription{
+				{
+					ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					UserID:      "user-id-1",
+					Tier:        "pro",
+					Status:      entities.SubscriptionStatusCanceled,
+					StartedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+					EndsAt:      lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+					TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+					CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:   "ExpireTrials/NoneExpired",
+			now:    time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC),
+			limit:  10,
+			expect: []*entities.Subscription{},
+		},
+	}
+
+	stx := BeginTX(db, expireTrialsFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewExpireTrialsRepository(tx)
+			subscriptions, err := repo.ExpireTrials(context.TODO(), tt.now, tt.limit)
+
+			for _, subscription := range subscriptions {
+				// UpdatedAt is set by the database, nullify it for comparison.
+				subscription.UpdatedAt = nil
+			}
+
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.expect, subscriptions)
+		})
+	}
+}
@@ -6,10 +6,13 @@ import (
 	"errors"
 	"github.com/in-rich/uservice-subscription/pkg/entities"
 	"github.com/uptrace/bun"
+	"time"
 )
 
 type GetLatestNoteEditByAuthorRepository interface {
-	GetLatestNoteEditByAuthor(ctx context.Context, author string, target entities.Target, publicIdentifier string) (*entities.NoteEdit, error)
+	GetLatestNoteEditByAuthor(
+		ctx context.Context, author string, target entities.Target, publicIdentifier string, now time.Time,
+	) (*entities.NoteEdit, error)
 }
 
 type getLatestNoteEditByAuthorRepositoryImpl struct {
@@ -17,15 +20,19 @@ type getLatestNoteEditByAuthorRepositoryImpl struct {
 }
 
 func (r *getLatestNoteEditByAuthorRepositoryImpl) GetLatestNoteEditByAuthor(
-	ctx context.Context, author string, target entities.Target, publicIdentifier string,
+	ctx context.Context, author string, target entities.Target, publicIdentifier string, now time.Time,
 ) (*entities.NoteEdit, error) {
+	ctx, span := startSpan(ctx, "GetLatestNoteEditByAuthor")
+	defer span.End()
+
 	noteEdit := new(entities.NoteEdit)
 
-	err := r.db.NewSelect().
+	err := getDB(ctx, r.db).NewSelect().
 		Model(noteEdit).
 		Where("author_id = ?", author).
 		Where("target = ?", target).
 		Where("public_identifier = ?", publicIdentifier).
+		Apply(countedNoteEdits(now)).
 		Order("created_at DESC").
 		Limit(1).
 		Scan(ctx)
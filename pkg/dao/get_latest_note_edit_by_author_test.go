@@ -50,6 +50,59 @@ var getLatestNoteEditByAuthorFixtures = []*entities.NoteEdit{
 		Target:           entities.TargetUser,
 		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
 	},
+	// Voided edits are ignored.
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000006")),
+		AuthorID:         "author-id-3",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+		VoidedAt:         lo.ToPtr(time.Date(2021, 1, 3, 0, 1, 0, 0, time.UTC)),
+	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000007")),
+		AuthorID:         "author-id-3",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	// Pending edits count until they expire.
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000008")),
+		AuthorID:         "author-id-4",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		Status:           entities.NoteEditStatusPending,
+		ExpiresAt:        lo.ToPtr(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000009")),
+		AuthorID:         "author-id-4",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		Status:           entities.NoteEditStatusPending,
+		ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 5, 0, 0, time.UTC)),
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+		AuthorID:         "author-id-4",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		Status:           entities.NoteEditStatusExpired,
+		ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 4, 0, 5, 0, 0, time.UTC)),
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+	},
+	// Edit drawn from the pool of an organization.
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000011")),
+		AuthorID:         "author-id-5",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		OrganizationID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+	},
 }
 
 func TestGetLatestNoteEditByAuthor(t *testing.T) {
@@ -61,6 +114,7 @@ func TestGetLatestNoteEditByAuthor(t *testing.T) {
 		authorID  string
 		target    entities.Target
 		publicID  string
+		now       time.Time
 		expect    *entities.NoteEdit
 		expectErr error
 	}{
@@ -69,11 +123,60 @@ func TestGetLatestNoteEditByAuthor(t *testing.T) {
 			authorID: "author-id-1",
 			target:   entities.TargetUser,
 			publicID: "public-identifier-1",
+			now:      time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
 			expect: &entities.NoteEdit{
 				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
 				AuthorID:         "author-id-1",
 				PublicIdentifier: "public-identifier-1",
 				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "GetLatestNoteEditByAuthor/SkipVoided",
+			authorID: "author-id-3",
+			target:   entities.TargetUser,
+			publicID: "public-identifier-1",
+			now:      time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000007")),
+				AuthorID:         "author-id-3",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "GetLatestNoteEditByAuthor/SkipExpired",
+			authorID: "author-id-4",
+			target:   entities.TargetUser,
+			publicID: "public-identifier-1",
+			now:      time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000008")),
+				AuthorID:         "author-id-4",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusPending,
+				ExpiresAt:        lo.ToPtr(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "GetLatestNoteEditByAuthor/NotExpiredYet",
+			authorID: "author-id-4",
+			target:   entities.TargetUser,
+			publicID: "public-identifier-1",
+			now:      time.Date(2021, 1, 3, 0, 2, 0, 0, time.UTC),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000009")),
+				AuthorID:         "author-id-4",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusPending,
+				ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 5, 0, 0, time.UTC)),
 				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
 			},
 		},
@@ -82,6 +185,7 @@ func TestGetLatestNoteEditByAuthor(t *testing.T) {
 			authorID:  "author-id-1",
 			target:    entities.TargetUser,
 			publicID:  "public-identifier-3",
+			now:       time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
 			expectErr: dao.ErrNoNoteEditFound,
 		},
 	}
@@ -95,7 +199,7 @@ func TestGetLatestNoteEditByAuthor(t *testing.T) {
 			defer RollbackTX(tx)
 
 			repo := dao.NewGetLatestNoteEditByAuthorRepository(tx)
-			noteEdit, err := repo.GetLatestNoteEditByAuthor(context.Background(), tt.authorID, tt.target, tt.publicID)
+			noteEdit, err := repo.GetLatestNoteEditByAuthor(context.Background(), tt.authorID, tt.target, tt.publicID, tt.now)
 
 			require.ErrorIs(t, err, tt.expectErr)
 			require.Equal(t, tt.expect, noteEdit)
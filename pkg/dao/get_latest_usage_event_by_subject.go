@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetLatestUsageEventBySubjectRepository interface {
+	GetLatestUsageEventBySubject(ctx context.Context, feature string, subject string, key string) (*entities.UsageEvent, error)
+}
+
+type getLatestUsageEventBySubjectRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getLatestUsageEventBySubjectRepositoryImpl) GetLatestUsageEventBySubject(
+	ctx context.Context, feature string, subject string, key string,
+) (*entities.UsageEvent, error) {
+	ctx, span := startSpan(ctx, "GetLatestUsageEventBySubject")
+	defer span.End()
+
+	usageEvent := new(entities.UsageEvent)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(usageEvent).
+		Where("feature = ?", feature).
+		Where("subject = ?", subject).
+		Where("key = ?", key).
+		Order("created_at DESC").
+		Limit(1).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoUsageEventFound
+		}
+
+		return nil, err
+	}
+
+	return usageEvent, nil
+}
+
+func NewGetLatestUsageEventBySubjectRepository(db bun.IDB) GetLatestUsageEventBySubjectRepository {
+	return &getLatestUsageEventBySubjectRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,111 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var usageEventsBySubjectFixtures = []*entities.UsageEvent{
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000005")),
+		Feature:   "exports",
+		Subject:   "user-id-1",
+		Key:       "key-1",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Feature:   "exports",
+		Subject:   "user-id-1",
+		Key:       "key-1",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+	// Different key
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		Feature:   "exports",
+		Subject:   "user-id-1",
+		Key:       "key-2",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+	},
+	// Different feature
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		Feature:   "ai-summaries",
+		Subject:   "user-id-1",
+		Key:       "key-1",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+	},
+	// Different subject
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+		Feature:   "exports",
+		Subject:   "user-id-2",
+		Key:       "key-1",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestGetLatestUsageEventBySubject(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		feature   string
+		subject   string
+		key       string
+		expect    *entities.UsageEvent
+		expectErr error
+	}{
+		{
+			name:    "GetLatestUsageEventBySubject",
+			feature: "exports",
+			subject: "user-id-1",
+			key:     "key-1",
+			expect: &entities.UsageEvent{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Feature:   "exports",
+				Subject:   "user-id-1",
+				Key:       "key-1",
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "GetLatestUsageEventBySubject/NoUsageEventFound",
+			feature:   "exports",
+			subject:   "user-id-1",
+			key:       "key-3",
+			expectErr: dao.ErrNoUsageEventFound,
+		},
+		{
+			name:      "GetLatestUsageEventBySubject/UnknownFeature",
+			feature:   "enrichments",
+			subject:   "user-id-1",
+			key:       "key-1",
+			expectErr: dao.ErrNoUsageEventFound,
+		},
+	}
+
+	stx := BeginTX(db, usageEventsBySubjectFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetLatestUsageEventBySubjectRepository(tx)
+			usageEvent, err := repo.GetLatestUsageEventBySubject(context.Background(), tt.feature, tt.subject, tt.key)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, usageEvent)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetNoteEditByIdempotencyKeyRepository interface {
+	GetNoteEditByIdempotencyKey(ctx context.Context, author string, key string) (*entities.NoteEdit, error)
+}
+
+type getNoteEditByIdempotencyKeyRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getNoteEditByIdempotencyKeyRepositoryImpl) GetNoteEditByIdempotencyKey(
+	ctx context.Context, author string, key string,
+) (*entities.NoteEdit, error) {
+	ctx, span := startSpan(ctx, "GetNoteEditByIdempotencyKey")
+	defer span.End()
+
+	noteEdit := new(entities.NoteEdit)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(noteEdit).
+		Where("author_id = ?", author).
+		Where("idempotency_key = ?", key).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoNoteEditFound
+		}
+
+		return nil, err
+	}
+
+	return noteEdit, nil
+}
+
+func NewGetNoteEditByIdempotencyKeyRepository(db bun.IDB) GetNoteEditByIdempotencyKeyRepository {
+	return &getNoteEditByIdempotencyKeyRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,108 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var getNoteEditByIdempotencyKeyFixtures = []*entities.NoteEdit{
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		AuthorID:         "author-id-1",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+		RemainingEdits:   lo.ToPtr(4),
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	// Same key, different author.
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		AuthorID:         "author-id-2",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+		RemainingEdits:   lo.ToPtr(2),
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	// No key.
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		AuthorID:         "author-id-1",
+		PublicIdentifier: "public-identifier-2",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestGetNoteEditByIdempotencyKey(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		authorID  string
+		key       string
+		expect    *entities.NoteEdit
+		expectErr error
+	}{
+		{
+			name:     "GetNoteEditByIdempotencyKey",
+			authorID: "author-id-1",
+			key:      "idempotency-key-1",
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID:         "author-id-1",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+				RemainingEdits:   lo.ToPtr(4),
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "GetNoteEditByIdempotencyKey/OtherAuthor",
+			authorID: "author-id-2",
+			key:      "idempotency-key-1",
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				AuthorID:         "author-id-2",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+				RemainingEdits:   lo.ToPtr(2),
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "GetNoteEditByIdempotencyKey/NotFound",
+			authorID:  "author-id-1",
+			key:       "idempotency-key-2",
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+	}
+
+	stx := BeginTX(db, getNoteEditByIdempotencyKeyFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetNoteEditByIdempotencyKeyRepository(tx)
+			noteEdit, err := repo.GetNoteEditByIdempotencyKey(context.TODO(), tt.authorID, tt.key)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, noteEdit)
+		})
+	}
+}
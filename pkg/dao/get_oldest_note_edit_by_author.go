@@ -0,0 +1,52 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type GetOldestNoteEditByAuthorRepository interface {
+	GetOldestNoteEditByAuthor(ctx context.Context, author string, since *time.Time, now time.Time) (*entities.NoteEdit, error)
+}
+
+type getOldestNoteEditByAuthorRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getOldestNoteEditByAuthorRepositoryImpl) GetOldestNoteEditByAuthor(
+	ctx context.Context, author string, since *time.Time, now time.Time,
+) (*entities.NoteEdit, error) {
+	ctx, span := startSpan(ctx, "GetOldestNoteEditByAuthor")
+	defer span.End()
+
+	noteEdit := new(entities.NoteEdit)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(noteEdit).
+		Where("author_id = ?", author).
+		Where("created_at >= ?", since).
+		Apply(countedNoteEdits(now)).
+		Order("created_at ASC").
+		Limit(1).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoNoteEditFound
+		}
+
+		return nil, err
+	}
+
+	return noteEdit, nil
+}
+
+func NewGetOldestNoteEditByAuthorRepository(db bun.IDB) GetOldestNoteEditByAuthorRepository {
+	return &getOldestNoteEditByAuthorRepositoryImpl{
+		db: db,
+	}
+}
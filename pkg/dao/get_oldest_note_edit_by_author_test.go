@@ -0,0 +1,130 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var getOldestNoteEditByAuthorFixtures = []*entities.NoteEdit{
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		AuthorID:         "author-id-1",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		AuthorID:         "author-id-1",
+		PublicIdentifier: "public-identifier-2",
+		Target:           entities.TargetCompany,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		AuthorID:         "author-id-1",
+		PublicIdentifier: "public-identifier-3",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)),
+	},
+	// Different author
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+		AuthorID:         "author-id-2",
+		PublicIdentifier: "public-identifier-1",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	// Voided edit
+	{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000005")),
+		AuthorID:         "author-id-2",
+		PublicIdentifier: "public-identifier-2",
+		Target:           entities.TargetUser,
+		CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		VoidedAt:         lo.ToPtr(time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC)),
+	},
+}
+
+func TestGetOldestNoteEditByAuthor(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name      string
+		authorID  string
+		since     *time.Time
+		expect    *entities.NoteEdit
+		expectErr error
+	}{
+		{
+			name:     "GetOldestNoteEditByAuthor",
+			authorID: "author-id-1",
+			since:    lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID:         "author-id-1",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "GetOldestNoteEditByAuthorSince",
+			authorID: "author-id-1",
+			since:    lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				AuthorID:         "author-id-1",
+				PublicIdentifier: "public-identifier-2",
+				Target:           entities.TargetCompany,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "GetOldestNoteEditByAuthor/SkipVoided",
+			authorID: "author-id-2",
+			since:    lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+				AuthorID:         "author-id-2",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "GetOldestNoteEditByAuthor/NoNoteEditFound",
+			authorID:  "author-id-1",
+			since:     lo.ToPtr(time.Date(2021, 1, 6, 0, 0, 0, 0, time.UTC)),
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+	}
+
+	stx := BeginTX(db, getOldestNoteEditByAuthorFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetOldestNoteEditByAuthorRepository(tx)
+			noteEdit, err := repo.GetOldestNoteEditByAuthor(context.Background(), tt.authorID, tt.since, now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, noteEdit)
+		})
+	}
+}
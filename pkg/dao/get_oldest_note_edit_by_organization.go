@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type GetOldestNoteEditByOrganizationRepository interface {
+	GetOldestNoteEditByOrganization(
+		ctx context.Context, organization uuid.UUID, since *time.Time, now time.Time,
+	) (*entities.NoteEdit, error)
+}
+
+type getOldestNoteEditByOrganizationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getOldestNoteEditByOrganizationRepositoryImpl) GetOldestNoteEditByOrganization(
+	ctx context.Context, organization uuid.UUID, since *time.Time, now time.Time,
+) (*entities.NoteEdit, error) {
+	ctx, span := startSpan(ctx, "GetOldestNoteEditByOrganization")
+	defer span.End()
+
+	noteEdit := new(entities.NoteEdit)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(noteEdit).
+		Where("organization_id = ?", organization).
+		Where("created_at >= ?", since).
+		Apply(countedNoteEdits(now)).
+		Order("created_at ASC").
+		Limit(1).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoNoteEditFound
+		}
+
+		return nil, err
+	}
+
+	return noteEdit, nil
+}
+
+func NewGetOldestNoteEditByOrganizationRepository(db bun.IDB) GetOldestNoteEditByOrganizationRepository {
+	return &getOldestNoteEditByOrganizationRepositoryImpl{
+		db: db,
+	}
+}
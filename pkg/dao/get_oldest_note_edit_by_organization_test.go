@@ -0,0 +1,78 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestGetOldestNoteEditByOrganization(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		since          *time.Time
+		expect         *entities.NoteEdit
+		expectErr      error
+	}{
+		{
+			name:           "GetOldestNoteEditByOrganization",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			since:          lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				AuthorID:         "author-id-2",
+				OrganizationID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				PublicIdentifier: "public-identifier-2",
+				Target:           entities.TargetCompany,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:           "GetOldestNoteEditByOrganization/SkipVoided",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000102"),
+			since:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000005")),
+				AuthorID:         "author-id-3",
+				OrganizationID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000102")),
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:           "GetOldestNoteEditByOrganization/NoNoteEditFound",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			since:          lo.ToPtr(time.Date(2021, 1, 6, 0, 0, 0, 0, time.UTC)),
+			expectErr:      dao.ErrNoNoteEditFound,
+		},
+	}
+
+	stx := BeginTX(db, noteEditsByOrganizationFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetOldestNoteEditByOrganizationRepository(tx)
+			noteEdit, err := repo.GetOldestNoteEditByOrganization(context.Background(), tt.organizationID, tt.since, now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, noteEdit)
+		})
+	}
+}
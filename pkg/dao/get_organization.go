@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetOrganizationRepository interface {
+	GetOrganization(ctx context.Context, id uuid.UUID) (*entities.Organization, error)
+}
+
+type getOrganizationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getOrganizationRepositoryImpl) GetOrganization(ctx context.Context, id uuid.UUID) (*entities.Organization, error) {
+	ctx, span := startSpan(ctx, "GetOrganization")
+	defer span.End()
+
+	organization := new(entities.Organization)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(organization).
+		Where("id = ?", id).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrganizationNotFound
+		}
+
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+func NewGetOrganizationRepository(db bun.IDB) GetOrganizationRepository {
+	return &getOrganizationRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetOrganizationMemberByUserRepository interface {
+	GetOrganizationMemberByUser(ctx context.Context, user string) (*entities.OrganizationMember, error)
+}
+
+type getOrganizationMemberByUserRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getOrganizationMemberByUserRepositoryImpl) GetOrganizationMemberByUser(
+	ctx context.Context, user string,
+) (*entities.OrganizationMember, error) {
+	ctx, span := startSpan(ctx, "GetOrganizationMemberByUser")
+	defer span.End()
+
+	member := new(entities.OrganizationMember)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(member).
+		Where("user_id = ?", user).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrganizationMemberNotFound
+		}
+
+		return nil, err
+	}
+
+	return member, nil
+}
+
+func NewGetOrganizationMemberByUserRepository(db bun.IDB) GetOrganizationMemberByUserRepository {
+	return &getOrganizationMemberByUserRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,58 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestGetOrganizationMemberByUser(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		userID    string
+		expect    *entities.OrganizationMember
+		expectErr error
+	}{
+		{
+			name:   "GetOrganizationMemberByUser",
+			userID: "user-id-2",
+			expect: &entities.OrganizationMember{
+				ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				UserID:         "user-id-2",
+				MaxEdits:       lo.ToPtr(5),
+				CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "GetOrganizationMemberByUser/NotFound",
+			userID:    "user-id-4",
+			expectErr: dao.ErrOrganizationMemberNotFound,
+		},
+	}
+
+	stx := BeginTX(db, organizationMembersFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetOrganizationMemberByUserRepository(tx)
+			member, err := repo.GetOrganizationMemberByUser(context.TODO(), tt.userID)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, member)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// organizationMembersFixtures is shared by the tests of organization and membership repositories. Organizations come
+// first, so members can reference them.
+var organizationMembersFixtures = []interface{}{
+	&entities.Organization{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+		Name:      "Acme",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.Organization{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000102")),
+		Name:      "Globex",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.OrganizationMember{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+		UserID:         "user-id-1",
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	&entities.OrganizationMember{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+		UserID:         "user-id-2",
+		MaxEdits:       lo.ToPtr(5),
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	// Different organization
+	&entities.OrganizationMember{
+		ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000102")),
+		UserID:         "user-id-3",
+		CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestGetOrganization(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		id        uuid.UUID
+		expect    *entities.Organization
+		expectErr error
+	}{
+		{
+			name: "GetOrganization",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			expect: &entities.Organization{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				Name:      "Acme",
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "GetOrganization/NotFound",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000103"),
+			expectErr: dao.ErrOrganizationNotFound,
+		},
+	}
+
+	stx := BeginTX(db, organizationMembersFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetOrganizationRepository(tx)
+			organization, err := repo.GetOrganization(context.TODO(), tt.id)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, organization)
+		})
+	}
+}
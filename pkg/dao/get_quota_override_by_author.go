@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetQuotaOverrideByAuthorRepository interface {
+	GetQuotaOverrideByAuthor(ctx context.Context, author string) (*entities.QuotaOverride, error)
+}
+
+type getQuotaOverrideByAuthorRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getQuotaOverrideByAuthorRepositoryImpl) GetQuotaOverrideByAuthor(
+	ctx context.Context, author string,
+) (*entities.QuotaOverride, error) {
+	ctx, span := startSpan(ctx, "GetQuotaOverrideByAuthor")
+	defer span.End()
+
+	override := new(entities.QuotaOverride)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(override).
+		Where("author_id = ?", author).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrQuotaOverrideNotFound
+		}
+
+		return nil, err
+	}
+
+	return override, nil
+}
+
+func NewGetQuotaOverrideByAuthorRepository(db bun.IDB) GetQuotaOverrideByAuthorRepository {
+	return &getQuotaOverrideByAuthorRepositoryImpl{
+		db: db,
+	}
+}
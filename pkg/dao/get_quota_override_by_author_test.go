@@ -0,0 +1,79 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var getQuotaOverrideByAuthorFixtures = []*entities.QuotaOverride{
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		AuthorID:  "author-id-1",
+		Kind:      entities.QuotaOverrideKindAdd,
+		MaxEdits:  10,
+		ExpiresAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	// Different author
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		AuthorID:  "author-id-2",
+		Kind:      entities.QuotaOverrideKindDisable,
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestGetQuotaOverrideByAuthor(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		authorID  string
+		expect    *entities.QuotaOverride
+		expectErr error
+	}{
+		{
+			name:     "GetQuotaOverrideByAuthor",
+			authorID: "author-id-1",
+			expect: &entities.QuotaOverride{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID:  "author-id-1",
+				Kind:      entities.QuotaOverrideKindAdd,
+				MaxEdits:  10,
+				ExpiresAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "GetQuotaOverrideByAuthor/NotFound",
+			authorID:  "author-id-3",
+			expectErr: dao.ErrQuotaOverrideNotFound,
+		},
+	}
+
+	stx := BeginTX(db, getQuotaOverrideByAuthorFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetQuotaOverrideByAuthorRepository(tx)
+			override, err := repo.GetQuotaOverrideByAuthor(context.TODO(), tt.authorID)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, override)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type GetSubscriptionByStripeIDRepository interface {
+	GetSubscriptionByStripeID(ctx context.Context, stripeSubscriptionID string) (*entities.Subscription, error)
+}
+
+type getSubscriptionByStripeIDRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getSubscriptionByStripeIDRepositoryImpl) GetSubscriptionByStripeID(
+	ctx context.Context, stripeSubscriptionID string,
+) (*entities.Subscription, error) {
+	ctx, span := startSpan(ctx, "GetSubscriptionByStripeID")
+	defer span.End()
+
+	subscription := new(entities.Subscription)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(subscription).
+		Where("stripe_subscription_id = ?", stripeSubscriptionID).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSubscriptionNotFound
+		}
+
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func NewGetSubscriptionByStripeIDRepository(db bun.IDB) GetSubscriptionByStripeIDRepository {
+	return &getSubscriptionByStripeIDRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,74 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var getSubscriptionByStripeIDFixtures = []*entities.Subscription{
+	{
+		ID:                   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:               "user-id-1",
+		Tier:                 "pro",
+		Status:               entities.SubscriptionStatusActive,
+		StartedAt:            lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		StripeSubscriptionID: lo.ToPtr("sub_1"),
+		CreatedAt:            lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:            lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	// Not billed through Stripe
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		UserID:    "user-id-2",
+		Tier:      "team",
+		Status:    entities.SubscriptionStatusActive,
+		StartedAt: lo.ToPtr(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt: lo.ToPtr(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestGetSubscriptionByStripeID(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name                 string
+		stripeSubscriptionID string
+		expect               *entities.Subscription
+		expectErr            error
+	}{
+		{
+			name:                 "GetSubscriptionByStripeID",
+			stripeSubscriptionID: "sub_1",
+			expect:               getSubscriptionByStripeIDFixtures[0],
+		},
+		{
+			name:                 "GetSubscriptionByStripeID/NotFound",
+			stripeSubscriptionID: "sub_2",
+			expectErr:            dao.ErrSubscriptionNotFound,
+		},
+	}
+
+	stx := BeginTX(db, getSubscriptionByStripeIDFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetSubscriptionByStripeIDRepository(tx)
+			subscription, err := repo.GetSubscriptionByStripeID(context.TODO(), tt.stripeSubscriptionID)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, subscription)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+// GetSubscriptionByUserRepository returns the most recent subscription of a user, regardless of its status.
+type GetSubscriptionByUserRepository interface {
+	GetSubscriptionByUser(ctx context.Context, user string) (*entities.Subscription, error)
+}
+
+type getSubscriptionByUserRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *getSubscriptionByUserRepositoryImpl) GetSubscriptionByUser(ctx context.Context, user string) (*entities.Subscription, error) {
+	ctx, span := startSpan(ctx, "GetSubscriptionByUser")
+	defer span.End()
+
+	subscription := new(entities.Subscription)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(subscription).
+		Where("user_id = ?", user).
+		Order("created_at DESC").
+		Limit(1).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSubscriptionNotFound
+		}
+
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func NewGetSubscriptionByUserRepository(db bun.IDB) GetSubscriptionByUserRepository {
+	return &getSubscriptionByUserRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,83 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var getSubscriptionByUserFixtures = []*entities.Subscription{
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:    "user-id-1",
+		Tier:      "pro",
+		Status:    entities.SubscriptionStatusCanceled,
+		StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndsAt:    lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		UserID:    "user-id-1",
+		Tier:      "team",
+		Status:    entities.SubscriptionStatusActive,
+		StartedAt: lo.ToPtr(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt: lo.ToPtr(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	// Different user
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		UserID:    "user-id-2",
+		Tier:      "enterprise",
+		Status:    entities.SubscriptionStatusActive,
+		StartedAt: lo.ToPtr(time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt: lo.ToPtr(time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestGetSubscriptionByUser(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		userID    string
+		expect    *entities.Subscription
+		expectErr error
+	}{
+		{
+			name:   "GetSubscriptionByUser",
+			userID: "user-id-1",
+			expect: getSubscriptionByUserFixtures[1],
+		},
+		{
+			name:      "GetSubscriptionByUser/NotFound",
+			userID:    "user-id-3",
+			expectErr: dao.ErrSubscriptionNotFound,
+		},
+	}
+
+	stx := BeginTX(db, getSubscriptionByUserFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewGetSubscriptionByUserRepository(tx)
+			subscription, err := repo.GetSubscriptionByUser(context.TODO(), tt.userID)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, subscription)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type HasUsedTrialRepository interface {
+	// HasUsedTrial returns true if the user ever had a trial subscription, whether it is still running or not.
+	HasUsedTrial(ctx context.Context, user string) (bool, error)
+}
+
+type hasUsedTrialRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *hasUsedTrialRepositoryImpl) HasUsedTrial(ctx context.Context, user string) (bool, error) {
+	ctx, span := startSpan(ctx, "HasUsedTrial")
+	defer span.End()
+
+	return getDB(ctx, r.db).NewSelect().
+		Model((*entities.Subscription)(nil)).
+		Where("user_id = ?", user).
+		Where("trial_ends_at IS NOT NULL").
+		Exists(ctx)
+}
+
+func NewHasUsedTrialRepository(db bun.IDB) HasUsedTrialRepository {
+	return &hasUsedTrialRepositoryImpl{
+		db: db,
+	}
+}
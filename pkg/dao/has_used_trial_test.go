@@ -0,0 +1,87 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var hasUsedTrialFixtures = []*entities.Subscription{
+	// Expired trial
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:      "user-id-1",
+		Tier:        "pro",
+		Status:      entities.SubscriptionStatusCanceled,
+		StartedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndsAt:      lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+		TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		UserID:    "user-id-1",
+		Tier:      "pro",
+		Status:    entities.SubscriptionStatusActive,
+		StartedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	// Never had a trial
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		UserID:    "user-id-2",
+		Tier:      "team",
+		Status:    entities.SubscriptionStatusActive,
+		StartedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestHasUsedTrial(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name   string
+		userID string
+		expect bool
+	}{
+		{
+			name:   "HasUsedTrial",
+			userID: "user-id-1",
+			expect: true,
+		},
+		{
+			name:   "HasUsedTrial/NoTrial",
+			userID: "user-id-2",
+		},
+		{
+			name:   "HasUsedTrial/NoSubscription",
+			userID: "user-id-3",
+		},
+	}
+
+	stx := BeginTX(db, hasUsedTrialFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewHasUsedTrialRepository(tx)
+			used, err := repo.HasUsedTrial(context.TODO(), tt.userID)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, used)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListEntitlementOverridesByUserRepository interface {
+	ListEntitlementOverridesByUser(ctx context.Context, user string) ([]*entities.EntitlementOverride, error)
+}
+
+type listEntitlementOverridesByUserRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listEntitlementOverridesByUserRepositoryImpl) ListEntitlementOverridesByUser(
+	ctx context.Context, user string,
+) ([]*entities.EntitlementOverride, error) {
+	ctx, span := startSpan(ctx, "ListEntitlementOverridesByUser")
+	defer span.End()
+
+	overrides := make([]*entities.EntitlementOverride, 0)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(&overrides).
+		Where("user_id = ?", user).
+		Order("entitlement ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+func NewListEntitlementOverridesByUserRepository(db bun.IDB) ListEntitlementOverridesByUserRepository {
+	return &listEntitlementOverridesByUserRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,84 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var listEntitlementOverridesByUserFixtures = []*entities.EntitlementOverride{
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:      "user-id-1",
+		Entitlement: "max-team-seats",
+		Enabled:     true,
+		Limit:       lo.ToPtr(10),
+		ExpiresAt:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		UserID:      "user-id-1",
+		Entitlement: "can-export-csv",
+		Enabled:     true,
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	// Different user
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		UserID:      "user-id-2",
+		Entitlement: "can-export-csv",
+		Enabled:     false,
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestListEntitlementOverridesByUser(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		userID    string
+		expect    []*entities.EntitlementOverride
+		expectErr error
+	}{
+		{
+			name:   "ListEntitlementOverridesByUser",
+			userID: "user-id-1",
+			expect: []*entities.EntitlementOverride{
+				listEntitlementOverridesByUserFixtures[1],
+				listEntitlementOverridesByUserFixtures[0],
+			},
+		},
+		{
+			name:   "ListEntitlementOverridesByUser/None",
+			userID: "user-id-3",
+			expect: []*entities.EntitlementOverride{},
+		},
+	}
+
+	stx := BeginTX(db, listEntitlementOverridesByUserFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListEntitlementOverridesByUserRepository(tx)
+			overrides, err := repo.ListEntitlementOverridesByUser(context.TODO(), tt.userID)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, overrides)
+		})
+	}
+}
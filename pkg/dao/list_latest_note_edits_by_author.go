@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// NoteKey identifies a note of an author.
+type NoteKey struct {
+	Target           entities.Target
+	PublicIdentifier string
+}
+
+// ListLatestNoteEditsByAuthorRepository returns the latest edit of each of the given notes, in a single query. Notes
+// without any counted edit are omitted.
+type ListLatestNoteEditsByAuthorRepository interface {
+	ListLatestNoteEditsByAuthor(ctx context.Context, author string, notes []NoteKey, now time.Time) ([]*entities.NoteEdit, error)
+}
+
+type listLatestNoteEditsByAuthorRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listLatestNoteEditsByAuthorRepositoryImpl) ListLatestNoteEditsByAuthor(
+	ctx context.Context, author string, notes []NoteKey, now time.Time,
+) ([]*entities.NoteEdit, error) {
+	ctx, span := startSpan(ctx, "ListLatestNoteEditsByAuthor")
+	defer span.End()
+
+	noteEdits := make([]*entities.NoteEdit, 0)
+
+	if len(notes) == 0 {
+		return noteEdits, nil
+	}
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(&noteEdits).
+		DistinctOn("target, public_identifier").
+		Where("author_id = ?", author).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			for _, note := range notes {
+				q = q.WhereOr("target = ? AND public_identifier = ?", note.Target, note.PublicIdentifier)
+			}
+
+			return q
+		}).
+		Apply(countedNoteEdits(now)).
+		Order("target", "public_identifier", "created_at DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return noteEdits, nil
+}
+
+func NewListLatestNoteEditsByAuthorRepository(db bun.IDB) ListLatestNoteEditsByAuthorRepository {
+	return &listLatestNoteEditsByAuthorRepositoryImpl{
+		db: db,
+	}
+}
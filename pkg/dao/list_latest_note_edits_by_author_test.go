@@ -0,0 +1,112 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestListLatestNoteEditsByAuthor(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name      string
+		authorID  string
+		notes     []dao.NoteKey
+		expect    []*entities.NoteEdit
+		expectErr error
+	}{
+		{
+			name:     "ListLatestNoteEditsByAuthor",
+			authorID: "author-id-1",
+			notes: []dao.NoteKey{
+				{Target: entities.TargetCompany, PublicIdentifier: "public-identifier-1"},
+				{Target: entities.TargetUser, PublicIdentifier: "public-identifier-1"},
+				// No edit.
+				{Target: entities.TargetUser, PublicIdentifier: "public-identifier-3"},
+			},
+			expect: []*entities.NoteEdit{
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					AuthorID:         "author-id-1",
+					PublicIdentifier: "public-identifier-1",
+					Target:           entities.TargetUser,
+					Status:           entities.NoteEditStatusCommitted,
+					CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+				},
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+					AuthorID:         "author-id-1",
+					PublicIdentifier: "public-identifier-1",
+					Target:           entities.TargetCompany,
+					Status:           entities.NoteEditStatusCommitted,
+					CreatedAt:        lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:     "ListLatestNoteEditsByAuthor/SkipVoided",
+			authorID: "author-id-3",
+			notes: []dao.NoteKey{
+				{Target: entities.TargetUser, PublicIdentifier: "public-identifier-1"},
+			},
+			expect: []*entities.NoteEdit{
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000007")),
+					AuthorID:         "author-id-3",
+					PublicIdentifier: "public-identifier-1",
+					Target:           entities.TargetUser,
+					Status:           entities.NoteEditStatusCommitted,
+					CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:     "ListLatestNoteEditsByAuthor/SkipExpired",
+			authorID: "author-id-4",
+			notes: []dao.NoteKey{
+				{Target: entities.TargetUser, PublicIdentifier: "public-identifier-1"},
+			},
+			expect: []*entities.NoteEdit{
+				{
+					ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000008")),
+					AuthorID:         "author-id-4",
+					PublicIdentifier: "public-identifier-1",
+					Target:           entities.TargetUser,
+					Status:           entities.NoteEditStatusPending,
+					ExpiresAt:        lo.ToPtr(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+					CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name:     "ListLatestNoteEditsByAuthor/NoNotes",
+			authorID: "author-id-1",
+			expect:   []*entities.NoteEdit{},
+		},
+	}
+
+	stx := BeginTX(db, getLatestNoteEditByAuthorFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListLatestNoteEditsByAuthorRepository(tx)
+			noteEdits, err := repo.ListLatestNoteEditsByAuthor(context.TODO(), tt.authorID, tt.notes, now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, noteEdits)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListOrganizationMembersRepository interface {
+	ListOrganizationMembers(ctx context.Context, organization uuid.UUID) ([]*entities.OrganizationMember, error)
+}
+
+type listOrganizationMembersRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listOrganizationMembersRepositoryImpl) ListOrganizationMembers(
+	ctx context.Context, organization uuid.UUID,
+) ([]*entities.OrganizationMember, error) {
+	ctx, span := startSpan(ctx, "ListOrganizationMembers")
+	defer span.End()
+
+	members := make([]*entities.OrganizationMember, 0)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(&members).
+		Where("organization_id = ?", organization).
+		Order("user_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func NewListOrganizationMembersRepository(db bun.IDB) ListOrganizationMembersRepository {
+	return &listOrganizationMembersRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type ListPendingOutboxEventsRepository interface {
+	// ListPendingOutboxEvents returns up to limit events that were not published yet, by sequence. The events are
+	// locked until the end of the current transaction. Callers hold LockOutboxEvents first, so a single relay reads
+	// the outbox at a time.
+	ListPendingOutboxEvents(ctx context.Context, limit int) ([]*entities.OutboxEvent, error)
+}
+
+type listPendingOutboxEventsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *listPendingOutboxEventsRepositoryImpl) ListPendingOutboxEvents(
+	ctx context.Context, limit int,
+) ([]*entities.OutboxEvent, error) {
+	ctx, span := startSpan(ctx, "ListPendingOutboxEvents")
+	defer span.End()
+
+	events := make([]*entities.OutboxEvent, 0)
+
+	err := getDB(ctx, r.db).NewSelect().
+		Model(&events).
+		Where("published_at IS NULL").
+		Order("sequence ASC").
+		Limit(limit).
+		For("UPDATE").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func NewListPendingOutboxEventsRepository(db bun.IDB) ListPendingOutboxEventsRepository {
+	return &listPendingOutboxEventsRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,94 @@
+package dao_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var outboxEventsFixtures = []*entities.OutboxEvent{
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Sequence:  2,
+		Name:      "subscription.changed",
+		Payload:   json.RawMessage(`{}`),
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		Sequence:  1,
+		Name:      "subscription.note_edit_consumed",
+		Payload:   json.RawMessage(`{}`),
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		Sequence:  4,
+		Name:      "subscription.note_edits_exhausted",
+		Payload:   json.RawMessage(`{}`),
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	// Already published
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+		Sequence:    3,
+		Name:        "subscription.changed",
+		Payload:     json.RawMessage(`{}`),
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		PublishedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC)),
+	},
+}
+
+func TestListPendingOutboxEvents(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		limit     int
+		expect    []uuid.UUID
+		expectErr error
+	}{
+		{
+			name:  "ListPendingOutboxEvents",
+			limit: 10,
+			expect: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			},
+		},
+		{
+			name:  "ListPendingOutboxEvents/Limit",
+			limit: 2,
+			expect: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+		},
+	}
+
+	stx := BeginTX(db, outboxEventsFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewListPendingOutboxEventsRepository(tx)
+			events, err := repo.ListPendingOutboxEvents(context.TODO(), tt.limit)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, lo.Map(events, func(item *entities.OutboxEvent, _ int) uuid.UUID {
+				return *item.ID
+			}))
+		})
+	}
+}
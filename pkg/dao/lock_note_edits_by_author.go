@@ -0,0 +1,33 @@
+package dao
+
+import (
+	"context"
+	"github.com/uptrace/bun"
+)
+
+// LockNoteEditsByAuthorRepository acquires an exclusive lock on the note edits of an author. The lock is held until
+// the end of the current transaction, and is a no-op outside a transaction.
+type LockNoteEditsByAuthorRepository interface {
+	LockNoteEditsByAuthor(ctx context.Context, author string) error
+}
+
+type lockNoteEditsByAuthorRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *lockNoteEditsByAuthorRepositoryImpl) LockNoteEditsByAuthor(ctx context.Context, author string) error {
+	ctx, span := startSpan(ctx, "LockNoteEditsByAuthor")
+	defer span.End()
+
+	_, err := getDB(ctx, r.db).
+		NewRaw("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "note_edits:"+author).
+		Exec(ctx)
+
+	return err
+}
+
+func NewLockNoteEditsByAuthorRepository(db bun.IDB) LockNoteEditsByAuthorRepository {
+	return &lockNoteEditsByAuthorRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,87 @@
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+var errLimitReached = errors.New("limit reached")
+
+func TestLockNoteEditsByAuthor(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	// Concurrent transactions cannot share a single connection, so this test runs against the database directly.
+	const concurrentCalls = 20
+	const maxEdits = 1
+
+	transactionRepo := dao.NewRunInTransactionRepository(db)
+	lockRepo := dao.NewLockNoteEditsByAuthorRepository(db)
+	countRepo := dao.NewCountNoteEditsByAuthorRepository(db)
+	createRepo := dao.NewCreateNoteEditRepository(db)
+
+	since := lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	consume := func() error {
+		return transactionRepo.RunInTransaction(context.TODO(), func(ctx context.Context) error {
+			if err := lockRepo.LockNoteEditsByAuthor(ctx, "author-id-1"); err != nil {
+				return err
+			}
+
+			count, err := countRepo.CountNoteEditsByAuthor(ctx, "author-id-1", since, time.Now())
+			if err != nil {
+				return err
+			}
+
+			if count >= maxEdits {
+				return errLimitReached
+			}
+
+			_, err = createRepo.CreateNoteEdit(ctx, "author-id-1", &dao.CreateNoteEditData{
+				Target:           entities.TargetUser,
+				PublicIdentifier: "public-identifier-1",
+			})
+
+			return err
+		})
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, concurrentCalls)
+
+	start := make(chan struct{})
+	for i := 0; i < concurrentCalls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs[i] = consume()
+		}(i)
+	}
+
+	close(start)
+	wg.Wait()
+
+	successes := 0
+	for _, err := range errs {
+		if err == nil {
+			successes++
+			continue
+		}
+
+		require.ErrorIs(t, err, errLimitReached)
+	}
+
+	require.Equal(t, 1, successes)
+
+	count, err := countRepo.CountNoteEditsByAuthor(context.TODO(), "author-id-1", since, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, maxEdits, count)
+}
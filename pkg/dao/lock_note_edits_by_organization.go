@@ -0,0 +1,36 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// LockNoteEditsByOrganizationRepository acquires an exclusive lock on the note edit pool of an organization. The lock
+// is held until the end of the current transaction, and is a no-op outside a transaction.
+type LockNoteEditsByOrganizationRepository interface {
+	LockNoteEditsByOrganization(ctx context.Context, organization uuid.UUID) error
+}
+
+type lockNoteEditsByOrganizationRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *lockNoteEditsByOrganizationRepositoryImpl) LockNoteEditsByOrganization(
+	ctx context.Context, organization uuid.UUID,
+) error {
+	ctx, span := startSpan(ctx, "LockNoteEditsByOrganization")
+	defer span.End()
+
+	_, err := getDB(ctx, r.db).
+		NewRaw("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "note_edits:organization:"+organization.String()).
+		Exec(ctx)
+
+	return err
+}
+
+func NewLockNoteEditsByOrganizationRepository(db bun.IDB) LockNoteEditsByOrganizationRepository {
+	return &lockNoteEditsByOrganizationRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,90 @@
+package dao_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockNoteEditsByOrganization(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	// Concurrent transactions cannot share a single connection, so this test runs against the database directly.
+	const concurrentCalls = 20
+	const maxEdits = 1
+
+	organizationID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+
+	transactionRepo := dao.NewRunInTransactionRepository(db)
+	lockRepo := dao.NewLockNoteEditsByOrganizationRepository(db)
+	countRepo := dao.NewCountNoteEditsByOrganizationRepository(db)
+	createRepo := dao.NewCreateNoteEditRepository(db)
+
+	since := lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// Every call comes from a different member, so only the organization lock can serialize them.
+	consume := func(author string) error {
+		return transactionRepo.RunInTransaction(context.TODO(), func(ctx context.Context) error {
+			if err := lockRepo.LockNoteEditsByOrganization(ctx, organizationID); err != nil {
+				return err
+			}
+
+			count, err := countRepo.CountNoteEditsByOrganization(ctx, organizationID, author, since, time.Now())
+			if err != nil {
+				return err
+			}
+
+			if count.Total >= maxEdits {
+				return errLimitReached
+			}
+
+			_, err = createRepo.CreateNoteEdit(ctx, author, &dao.CreateNoteEditData{
+				Target:           entities.TargetUser,
+				PublicIdentifier: "public-identifier-1",
+				OrganizationID:   &organizationID,
+			})
+
+			return err
+		})
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, concurrentCalls)
+
+	start := make(chan struct{})
+	for i := 0; i < concurrentCalls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs[i] = consume(fmt.Sprintf("author-id-%d", i))
+		}(i)
+	}
+
+	close(start)
+	wg.Wait()
+
+	successes := 0
+	for _, err := range errs {
+		if err == nil {
+			successes++
+			continue
+		}
+
+		require.ErrorIs(t, err, errLimitReached)
+	}
+
+	require.Equal(t, 1, successes)
+
+	count, err := countRepo.CountNoteEditsByOrganization(context.TODO(), organizationID, "author-id-0", since, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, maxEdits, count.Total)
+}
@@ -0,0 +1,34 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// LockOrganizationMembersRepository acquires an exclusive lock on the members of an organization. The lock is held
+// until the end of the current transaction, and is a no-op outside a transaction.
+type LockOrganizationMembersRepository interface {
+	LockOrganizationMembers(ctx context.Context, organization uuid.UUID) error
+}
+
+type lockOrganizationMembersRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *lockOrganizationMembersRepositoryImpl) LockOrganizationMembers(ctx context.Context, organization uuid.UUID) error {
+	ctx, span := startSpan(ctx, "LockOrganizationMembers")
+	defer span.End()
+
+	_, err := getDB(ctx, r.db).
+		NewRaw("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "organization_members:"+organization.String()).
+		Exec(ctx)
+
+	return err
+}
+
+func NewLockOrganizationMembersRepository(db bun.IDB) LockOrganizationMembersRepository {
+	return &lockOrganizationMembersRepositoryImpl{
+		db: db,
+	}
+}
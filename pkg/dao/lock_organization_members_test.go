@@ -0,0 +1,92 @@
+package dao_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockOrganizationMembers(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	// Concurrent transactions cannot share a single connection, so this test runs against the database directly.
+	const concurrentCalls = 20
+	const seats = 1
+
+	organizationID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+
+	_, err := db.NewInsert().Model(&entities.Organization{
+		ID:        &organizationID,
+		Name:      "Acme",
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}).Exec(context.TODO())
+	require.NoError(t, err)
+
+	transactionRepo := dao.NewRunInTransactionRepository(db)
+	lockRepo := dao.NewLockOrganizationMembersRepository(db)
+	countRepo := dao.NewCountOrganizationMembersRepository(db)
+	upsertRepo := dao.NewUpsertOrganizationMemberRepository(db)
+
+	// Every call adds a different user, so only the lock can keep the organization within its seats.
+	join := func(user string) error {
+		return transactionRepo.RunInTransaction(context.TODO(), func(ctx context.Context) error {
+			if err := lockRepo.LockOrganizationMembers(ctx, organizationID); err != nil {
+				return err
+			}
+
+			count, err := countRepo.CountOrganizationMembers(ctx, organizationID)
+			if err != nil {
+				return err
+			}
+
+			if count >= seats {
+				return errLimitReached
+			}
+
+			_, err = upsertRepo.UpsertOrganizationMember(ctx, organizationID, user, &dao.UpsertOrganizationMemberData{})
+
+			return err
+		})
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, concurrentCalls)
+
+	start := make(chan struct{})
+	for i := 0; i < concurrentCalls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs[i] = join(fmt.Sprintf("user-id-%d", i))
+		}(i)
+	}
+
+	close(start)
+	wg.Wait()
+
+	successes := 0
+	for _, err := range errs {
+		if err == nil {
+			successes++
+			continue
+		}
+
+		require.ErrorIs(t, err, errLimitReached)
+	}
+
+	require.Equal(t, 1, successes)
+
+	count, err := countRepo.CountOrganizationMembers(context.TODO(), organizationID)
+	require.NoError(t, err)
+	require.Equal(t, seats, count)
+}
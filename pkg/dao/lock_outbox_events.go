@@ -0,0 +1,33 @@
+package dao
+
+import (
+	"context"
+	"github.com/uptrace/bun"
+)
+
+// LockOutboxEventsRepository acquires an exclusive lock on the outbox, so a single relay publishes events at a time.
+// The lock is held until the end of the current transaction, and is a no-op outside a transaction.
+type LockOutboxEventsRepository interface {
+	LockOutboxEvents(ctx context.Context) error
+}
+
+type lockOutboxEventsRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *lockOutboxEventsRepositoryImpl) LockOutboxEvents(ctx context.Context) error {
+	ctx, span := startSpan(ctx, "LockOutboxEvents")
+	defer span.End()
+
+	_, err := getDB(ctx, r.db).
+		NewRaw("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "outbox_events").
+		Exec(ctx)
+
+	return err
+}
+
+func NewLockOutboxEventsRepository(db bun.IDB) LockOutboxEventsRepository {
+	return &lockOutboxEventsRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,68 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLockOutboxEvents(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	// Concurrent transactions cannot share a single connection, so this test runs against the database directly.
+	const concurrentCalls = 5
+
+	transactionRepo := dao.NewRunInTransactionRepository(db)
+	lockRepo := dao.NewLockOutboxEventsRepository(db)
+
+	var holders, maxHolders atomic.Int32
+
+	relay := func() error {
+		return transactionRepo.RunInTransaction(context.TODO(), func(ctx context.Context) error {
+			if err := lockRepo.LockOutboxEvents(ctx); err != nil {
+				return err
+			}
+
+			current := holders.Add(1)
+			defer holders.Add(-1)
+
+			for {
+				previous := maxHolders.Load()
+				if current <= previous || maxHolders.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+
+			return nil
+		})
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, concurrentCalls)
+
+	start := make(chan struct{})
+	for i := 0; i < concurrentCalls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs[i] = relay()
+		}(i)
+	}
+
+	close(start)
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, int32(1), maxHolders.Load())
+}
@@ -0,0 +1,33 @@
+package dao
+
+import (
+	"context"
+	"github.com/uptrace/bun"
+)
+
+// LockSubscriptionsByUserRepository acquires an exclusive lock on the subscriptions of a user. The lock is held until
+// the end of the current transaction, and is a no-op outside a transaction.
+type LockSubscriptionsByUserRepository interface {
+	LockSubscriptionsByUser(ctx context.Context, user string) error
+}
+
+type lockSubscriptionsByUserRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *lockSubscriptionsByUserRepositoryImpl) LockSubscriptionsByUser(ctx context.Context, user string) error {
+	ctx, span := startSpan(ctx, "LockSubscriptionsByUser")
+	defer span.End()
+
+	_, err := getDB(ctx, r.db).
+		NewRaw("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "subscriptions:"+user).
+		Exec(ctx)
+
+	return err
+}
+
+func NewLockSubscriptionsByUserRepository(db bun.IDB) LockSubscriptionsByUserRepository {
+	return &lockSubscriptionsByUserRepositoryImpl{
+		db: db,
+	}
+}
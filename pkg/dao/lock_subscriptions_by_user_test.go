@@ -0,0 +1,79 @@
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockSubscriptionsByUser(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	// Concurrent transactions cannot share a single connection, so this test runs against the database directly. The
+	// user is not shared with other tests, as the subscriptions are not rolled back.
+	const concurrentCalls = 20
+	const user = "user-id-lock-subscriptions"
+
+	transactionRepo := dao.NewRunInTransactionRepository(db)
+	lockRepo := dao.NewLockSubscriptionsByUserRepository(db)
+	getRepo := dao.NewGetSubscriptionByUserRepository(db)
+	createRepo := dao.NewCreateSubscriptionRepository(db)
+
+	create := func() error {
+		return transactionRepo.RunInTransaction(context.TODO(), func(ctx context.Context) error {
+			if err := lockRepo.LockSubscriptionsByUser(ctx, user); err != nil {
+				return err
+			}
+
+			_, err := getRepo.GetSubscriptionByUser(ctx, user)
+			if err == nil {
+				return errLimitReached
+			}
+			if !errors.Is(err, dao.ErrSubscriptionNotFound) {
+				return err
+			}
+
+			_, err = createRepo.CreateSubscription(ctx, user, &dao.CreateSubscriptionData{
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			})
+
+			return err
+		})
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, concurrentCalls)
+
+	start := make(chan struct{})
+	for i := 0; i < concurrentCalls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs[i] = create()
+		}(i)
+	}
+
+	close(start)
+	wg.Wait()
+
+	successes := 0
+	for _, err := range errs {
+		if err == nil {
+			successes++
+			continue
+		}
+
+		require.ErrorIs(t, err, errLimitReached)
+	}
+
+	require.Equal(t, 1, successes)
+}
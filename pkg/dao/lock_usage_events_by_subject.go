@@ -0,0 +1,33 @@
+package dao
+
+import (
+	"context"
+	"github.com/uptrace/bun"
+)
+
+// LockUsageEventsBySubjectRepository acquires an exclusive lock on the uses of a feature by a subject. The lock is
+// held until the end of the current transaction, and is a no-op outside a transaction.
+type LockUsageEventsBySubjectRepository interface {
+	LockUsageEventsBySubject(ctx context.Context, feature string, subject string) error
+}
+
+type lockUsageEventsBySubjectRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *lockUsageEventsBySubjectRepositoryImpl) LockUsageEventsBySubject(ctx context.Context, feature string, subject string) error {
+	ctx, span := startSpan(ctx, "LockUsageEventsBySubject")
+	defer span.End()
+
+	_, err := getDB(ctx, r.db).
+		NewRaw("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "usage_events:"+feature+":"+subject).
+		Exec(ctx)
+
+	return err
+}
+
+func NewLockUsageEventsBySubjectRepository(db bun.IDB) LockUsageEventsBySubjectRepository {
+	return &lockUsageEventsBySubjectRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,83 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockUsageEventsBySubject(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	// Concurrent transactions cannot share a single connection, so this test runs against the database directly.
+	const concurrentCalls = 20
+	const maxUses = 1
+
+	transactionRepo := dao.NewRunInTransactionRepository(db)
+	lockRepo := dao.NewLockUsageEventsBySubjectRepository(db)
+	countRepo := dao.NewCountUsageEventsBySubjectRepository(db)
+	createRepo := dao.NewCreateUsageEventRepository(db)
+
+	since := lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	consume := func() error {
+		return transactionRepo.RunInTransaction(context.TODO(), func(ctx context.Context) error {
+			if err := lockRepo.LockUsageEventsBySubject(ctx, "exports", "user-id-1"); err != nil {
+				return err
+			}
+
+			count, err := countRepo.CountUsageEventsBySubject(ctx, "exports", "user-id-1", since)
+			if err != nil {
+				return err
+			}
+
+			if count >= maxUses {
+				return errLimitReached
+			}
+
+			_, err = createRepo.CreateUsageEvent(ctx, "user-id-1", &dao.CreateUsageEventData{
+				Feature: "exports",
+				Key:     "key-1",
+			})
+
+			return err
+		})
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, concurrentCalls)
+
+	start := make(chan struct{})
+	for i := 0; i < concurrentCalls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs[i] = consume()
+		}(i)
+	}
+
+	close(start)
+	wg.Wait()
+
+	successes := 0
+	for _, err := range errs {
+		if err == nil {
+			successes++
+			continue
+		}
+
+		require.ErrorIs(t, err, errLimitReached)
+	}
+
+	require.Equal(t, 1, successes)
+
+	count, err := countRepo.CountUsageEventsBySubject(context.TODO(), "exports", "user-id-1", since)
+	require.NoError(t, err)
+	require.Equal(t, maxUses, count)
+}
@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type MarkOutboxEventsPublishedRepository interface {
+	MarkOutboxEventsPublished(ctx context.Context, ids []uuid.UUID, at time.Time) error
+}
+
+type markOutboxEventsPublishedRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *markOutboxEventsPublishedRepositoryImpl) MarkOutboxEventsPublished(
+	ctx context.Context, ids []uuid.UUID, at time.Time,
+) error {
+	ctx, span := startSpan(ctx, "MarkOutboxEventsPublished")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := getDB(ctx, r.db).NewUpdate().
+		Model((*entities.OutboxEvent)(nil)).
+		Set("published_at = ?", at).
+		Where("id IN (?)", bun.In(ids)).
+		Where("published_at IS NULL").
+		Exec(ctx)
+
+	return err
+}
+
+func NewMarkOutboxEventsPublishedRepository(db bun.IDB) MarkOutboxEventsPublishedRepository {
+	return &markOutboxEventsPublishedRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,75 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMarkOutboxEventsPublished(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name          string
+		ids           []uuid.UUID
+		expectPending []uuid.UUID
+		expectErr     error
+	}{
+		{
+			name: "MarkOutboxEventsPublished",
+			ids: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+			expectPending: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			},
+		},
+		{
+			name: "MarkOutboxEventsPublished/AlreadyPublished",
+			ids: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			},
+			expectPending: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			},
+		},
+		{
+			name: "MarkOutboxEventsPublished/NoEvents",
+			expectPending: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			},
+		},
+	}
+
+	stx := BeginTX(db, outboxEventsFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewMarkOutboxEventsPublishedRepository(tx)
+			err := repo.MarkOutboxEventsPublished(context.TODO(), tt.ids, time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC))
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			pending, err := dao.NewListPendingOutboxEventsRepository(tx).ListPendingOutboxEvents(context.TODO(), 10)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectPending, lo.Map(pending, func(item *entities.OutboxEvent, _ int) uuid.UUID {
+				return *item.ID
+			}))
+		})
+	}
+}
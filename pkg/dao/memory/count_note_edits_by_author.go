@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"time"
+)
+
+type countNoteEditsByAuthorRepositoryImpl struct {
+	store *Store
+}
+
+func (r *countNoteEditsByAuthorRepositoryImpl) CountNoteEditsByAuthor(
+	_ context.Context, author string, since *time.Time, now time.Time,
+) (int, error) {
+	// Like in SQL, comparing with a missing time matches nothing.
+	if since == nil {
+		return 0, nil
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var count int
+	for _, noteEdit := range r.store.noteEdits {
+		if noteEdit.AuthorID == author && noteEdit.OrganizationID == nil &&
+			!noteEdit.CreatedAt.Before(*since) && noteEdit.IsCounted(now) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func NewCountNoteEditsByAuthorRepository(store *Store) dao.CountNoteEditsByAuthorRepository {
+	return &countNoteEditsByAuthorRepositoryImpl{
+		store: store,
+	}
+}
@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"time"
+)
+
+type createNoteEditRepositoryImpl struct {
+	store *Store
+}
+
+func (r *createNoteEditRepositoryImpl) CreateNoteEdit(
+	_ context.Context, author string, data *dao.CreateNoteEditData,
+) (*entities.NoteEdit, error) {
+	noteEdit := &entities.NoteEdit{
+		ID:               lo.ToPtr(uuid.New()),
+		AuthorID:         author,
+		PublicIdentifier: data.PublicIdentifier,
+		Target:           data.Target,
+		OrganizationID:   data.OrganizationID,
+		Status:           data.Status,
+		ExpiresAt:        data.ExpiresAt,
+		IdempotencyKey:   data.IdempotencyKey,
+		RemainingEdits:   data.RemainingEdits,
+		// Postgres stores timestamps with a microsecond precision.
+		CreatedAt: lo.ToPtr(time.Now().Round(time.Microsecond)),
+	}
+	if noteEdit.Status == "" {
+		noteEdit.Status = entities.NoteEditStatusCommitted
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if noteEdit.IdempotencyKey != nil {
+		for _, stored := range r.store.noteEdits {
+			if stored.AuthorID == author && lo.FromPtr(stored.IdempotencyKey) == *noteEdit.IdempotencyKey {
+				return nil, dao.ErrIdempotencyKeyAlreadyUsed
+			}
+		}
+	}
+
+	r.store.noteEdits[*noteEdit.ID] = noteEdit
+
+	return copyNoteEdit(noteEdit), nil
+}
+
+func NewCreateNoteEditRepository(store *Store) dao.CreateNoteEditRepository {
+	return &createNoteEditRepositoryImpl{
+		store: store,
+	}
+}
@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"time"
+)
+
+type getLatestNoteEditByAuthorRepositoryImpl struct {
+	store *Store
+}
+
+func (r *getLatestNoteEditByAuthorRepositoryImpl) GetLatestNoteEditByAuthor(
+	_ context.Context, author string, target entities.Target, publicIdentifier string, now time.Time,
+) (*entities.NoteEdit, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var latestEdit *entities.NoteEdit
+	for _, noteEdit := range r.store.noteEdits {
+		if noteEdit.AuthorID != author || noteEdit.Target != target || noteEdit.PublicIdentifier != publicIdentifier {
+			continue
+		}
+
+		if !noteEdit.IsCounted(now) {
+			continue
+		}
+
+		if latestEdit == nil || noteEdit.CreatedAt.After(*latestEdit.CreatedAt) {
+			latestEdit = noteEdit
+		}
+	}
+
+	if latestEdit == nil {
+		return nil, dao.ErrNoNoteEditFound
+	}
+
+	return copyNoteEdit(latestEdit), nil
+}
+
+func NewGetLatestNoteEditByAuthorRepository(store *Store) dao.GetLatestNoteEditByAuthorRepository {
+	return &getLatestNoteEditByAuthorRepositoryImpl{
+		store: store,
+	}
+}
@@ -0,0 +1,54 @@
+package memory_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/dao/daotest"
+	"github.com/in-rich/uservice-subscription/pkg/dao/memory"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNoteEditsContract(t *testing.T) {
+	daotest.RunNoteEditsContract(t, func(t *testing.T) *daotest.NoteEditRepositories {
+		store := memory.NewStore()
+
+		return &daotest.NoteEditRepositories{
+			CountNoteEditsByAuthor:    memory.NewCountNoteEditsByAuthorRepository(store),
+			CreateNoteEdit:            memory.NewCreateNoteEditRepository(store),
+			GetLatestNoteEditByAuthor: memory.NewGetLatestNoteEditByAuthorRepository(store),
+		}
+	})
+}
+
+func TestNoteEditsConcurrency(t *testing.T) {
+	store := memory.NewStore()
+	createRepository := memory.NewCreateNoteEditRepository(store)
+	countRepository := memory.NewCountNoteEditsByAuthorRepository(store)
+
+	wg := new(sync.WaitGroup)
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := createRepository.CreateNoteEdit(context.TODO(), "author-id-1", &dao.CreateNoteEditData{
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+			})
+			require.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	count, err := countRepository.CountNoteEditsByAuthor(
+		context.TODO(), "author-id-1", lo.ToPtr(time.Now().Add(-time.Hour)), time.Now(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 20, count)
+}
@@ -0,0 +1,27 @@
+// Package memory implements dao repositories on top of in-memory maps, for fast tests and local development. The
+// repositories follow the same contract as their Postgres implementations, which is enforced by the daotest suites.
+package memory
+
+import (
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"sync"
+)
+
+// Store holds the data shared by the in-memory repositories. It is safe for concurrent use.
+type Store struct {
+	mu        sync.RWMutex
+	noteEdits map[uuid.UUID]*entities.NoteEdit
+}
+
+func NewStore() *Store {
+	return &Store{
+		noteEdits: make(map[uuid.UUID]*entities.NoteEdit),
+	}
+}
+
+// copyNoteEdit returns a copy of an edit, so callers cannot alter the store.
+func copyNoteEdit(noteEdit *entities.NoteEdit) *entities.NoteEdit {
+	noteEditCopy := *noteEdit
+	return &noteEditCopy
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockClearNoteEditIdempotencyKeyRepository is an autogenerated mock type for the ClearNoteEditIdempotencyKeyRepository type
+type MockClearNoteEditIdempotencyKeyRepository struct {
+	mock.Mock
+}
+
+type MockClearNoteEditIdempotencyKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClearNoteEditIdempotencyKeyRepository) EXPECT() *MockClearNoteEditIdempotencyKeyRepository_Expecter {
+	return &MockClearNoteEditIdempotencyKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClearNoteEditIdempotencyKey provides a mock function with given fields: ctx, id
+func (_m *MockClearNoteEditIdempotencyKeyRepository) ClearNoteEditIdempotencyKey(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearNoteEditIdempotencyKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClearNoteEditIdempotencyKeyRepository_ClearNoteEditIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearNoteEditIdempotencyKey'
+type MockClearNoteEditIdempotencyKeyRepository_ClearNoteEditIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// ClearNoteEditIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockClearNoteEditIdempotencyKeyRepository_Expecter) ClearNoteEditIdempotencyKey(ctx interface{}, id interface{}) *MockClearNoteEditIdempotencyKeyRepository_ClearNoteEditIdempotencyKey_Call {
+	return &MockClearNoteEditIdempotencyKeyRepository_ClearNoteEditIdempotencyKey_Call{Call: _e.mock.On("ClearNoteEditIdempotencyKey", ctx, id)}
+}
+
+func (_c *MockClearNoteEditIdempotencyKeyRepository_ClearNoteEditIdempotencyKey_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockClearNoteEditIdempotencyKeyRepository_ClearNoteEditIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockClearNoteEditIdempotencyKeyRepository_ClearNoteEditIdempotencyKey_Call) Return(_a0 error) *MockClearNoteEditIdempotencyKeyRepository_ClearNoteEditIdempotencyKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClearNoteEditIdempotencyKeyRepository_ClearNoteEditIdempotencyKey_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockClearNoteEditIdempotencyKeyRepository_ClearNoteEditIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockClearNoteEditIdempotencyKeyRepository creates a new instance of MockClearNoteEditIdempotencyKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClearNoteEditIdempotencyKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClearNoteEditIdempotencyKeyRepository {
+	mock := &MockClearNoteEditIdempotencyKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
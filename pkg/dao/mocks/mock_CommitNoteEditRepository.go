@@ -0,0 +1,103 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockCommitNoteEditRepository is an autogenerated mock type for the CommitNoteEditRepository type
+type MockCommitNoteEditRepository struct {
+	mock.Mock
+}
+
+type MockCommitNoteEditRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCommitNoteEditRepository) EXPECT() *MockCommitNoteEditRepository_Expecter {
+	return &MockCommitNoteEditRepository_Expecter{mock: &_m.Mock}
+}
+
+// CommitNoteEdit provides a mock function with given fields: ctx, id, author, now
+func (_m *MockCommitNoteEditRepository) CommitNoteEdit(ctx context.Context, id uuid.UUID, author string, now time.Time) (*entities.NoteEdit, error) {
+	ret := _m.Called(ctx, id, author, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitNoteEdit")
+	}
+
+	var r0 *entities.NoteEdit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) (*entities.NoteEdit, error)); ok {
+		return rf(ctx, id, author, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) *entities.NoteEdit); ok {
+		r0 = rf(ctx, id, author, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.NoteEdit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Time) error); ok {
+		r1 = rf(ctx, id, author, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommitNoteEditRepository_CommitNoteEdit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitNoteEdit'
+type MockCommitNoteEditRepository_CommitNoteEdit_Call struct {
+	*mock.Call
+}
+
+// CommitNoteEdit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - author string
+//   - now time.Time
+func (_e *MockCommitNoteEditRepository_Expecter) CommitNoteEdit(ctx interface{}, id interface{}, author interface{}, now interface{}) *MockCommitNoteEditRepository_CommitNoteEdit_Call {
+	return &MockCommitNoteEditRepository_CommitNoteEdit_Call{Call: _e.mock.On("CommitNoteEdit", ctx, id, author, now)}
+}
+
+func (_c *MockCommitNoteEditRepository_CommitNoteEdit_Call) Run(run func(ctx context.Context, id uuid.UUID, author string, now time.Time)) *MockCommitNoteEditRepository_CommitNoteEdit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCommitNoteEditRepository_CommitNoteEdit_Call) Return(_a0 *entities.NoteEdit, _a1 error) *MockCommitNoteEditRepository_CommitNoteEdit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommitNoteEditRepository_CommitNoteEdit_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, time.Time) (*entities.NoteEdit, error)) *MockCommitNoteEditRepository_CommitNoteEdit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCommitNoteEditRepository creates a new instance of MockCommitNoteEditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCommitNoteEditRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCommitNoteEditRepository {
+	mock := &MockCommitNoteEditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -23,9 +23,9 @@ func (_m *MockCountNoteEditsByAuthorRepository) EXPECT() *MockCountNoteEditsByAu
 	return &MockCountNoteEditsByAuthorRepository_Expecter{mock: &_m.Mock}
 }
 
-// CountNoteEditsByAuthor provides a mock function with given fields: ctx, author, since
-func (_m *MockCountNoteEditsByAuthorRepository) CountNoteEditsByAuthor(ctx context.Context, author string, since *time.Time) (int, error) {
-	ret := _m.Called(ctx, author, since)
+// CountNoteEditsByAuthor provides a mock function with given fields: ctx, author, since, now
+func (_m *MockCountNoteEditsByAuthorRepository) CountNoteEditsByAuthor(ctx context.Context, author string, since *time.Time, now time.Time) (int, error) {
+	ret := _m.Called(ctx, author, since, now)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountNoteEditsByAuthor")
@@ -33,17 +33,17 @@ func (_m *MockCountNoteEditsByAuthorRepository) CountNoteEditsByAuthor(ctx conte
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) (int, error)); ok {
-		return rf(ctx, author, since)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, time.Time) (int, error)); ok {
+		return rf(ctx, author, since, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) int); ok {
-		r0 = rf(ctx, author, since)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, time.Time) int); ok {
+		r0 = rf(ctx, author, since, now)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time) error); ok {
-		r1 = rf(ctx, author, since)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time, time.Time) error); ok {
+		r1 = rf(ctx, author, since, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,13 +60,14 @@ type MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call struct {
 //   - ctx context.Context
 //   - author string
 //   - since *time.Time
-func (_e *MockCountNoteEditsByAuthorRepository_Expecter) CountNoteEditsByAuthor(ctx interface{}, author interface{}, since interface{}) *MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call {
-	return &MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call{Call: _e.mock.On("CountNoteEditsByAuthor", ctx, author, since)}
+//   - now time.Time
+func (_e *MockCountNoteEditsByAuthorRepository_Expecter) CountNoteEditsByAuthor(ctx interface{}, author interface{}, since interface{}, now interface{}) *MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call {
+	return &MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call{Call: _e.mock.On("CountNoteEditsByAuthor", ctx, author, since, now)}
 }
 
-func (_c *MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call) Run(run func(ctx context.Context, author string, since *time.Time)) *MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call {
+func (_c *MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call) Run(run func(ctx context.Context, author string, since *time.Time, now time.Time)) *MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*time.Time))
+		run(args[0].(context.Context), args[1].(string), args[2].(*time.Time), args[3].(time.Time))
 	})
 	return _c
 }
@@ -76,7 +77,7 @@ func (_c *MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call) Retu
 	return _c
 }
 
-func (_c *MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call) RunAndReturn(run func(context.Context, string, *time.Time) (int, error)) *MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call {
+func (_c *MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call) RunAndReturn(run func(context.Context, string, *time.Time, time.Time) (int, error)) *MockCountNoteEditsByAuthorRepository_CountNoteEditsByAuthor_Call {
 	_c.Call.Return(run)
 	return _c
 }
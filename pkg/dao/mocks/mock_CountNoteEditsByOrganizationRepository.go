@@ -0,0 +1,103 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-subscription/pkg/dao"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockCountNoteEditsByOrganizationRepository is an autogenerated mock type for the CountNoteEditsByOrganizationRepository type
+type MockCountNoteEditsByOrganizationRepository struct {
+	mock.Mock
+}
+
+type MockCountNoteEditsByOrganizationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCountNoteEditsByOrganizationRepository) EXPECT() *MockCountNoteEditsByOrganizationRepository_Expecter {
+	return &MockCountNoteEditsByOrganizationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountNoteEditsByOrganization provides a mock function with given fields: ctx, organization, member, since, now
+func (_m *MockCountNoteEditsByOrganizationRepository) CountNoteEditsByOrganization(ctx context.Context, organization uuid.UUID, member string, since *time.Time, now time.Time) (*dao.OrganizationNoteEditsCount, error) {
+	ret := _m.Called(ctx, organization, member, since, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountNoteEditsByOrganization")
+	}
+
+	var r0 *dao.OrganizationNoteEditsCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *time.Time, time.Time) (*dao.OrganizationNoteEditsCount, error)); ok {
+		return rf(ctx, organization, member, since, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *time.Time, time.Time) *dao.OrganizationNoteEditsCount); ok {
+		r0 = rf(ctx, organization, member, since, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.OrganizationNoteEditsCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, *time.Time, time.Time) error); ok {
+		r1 = rf(ctx, organization, member, since, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCountNoteEditsByOrganizationRepository_CountNoteEditsByOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountNoteEditsByOrganization'
+type MockCountNoteEditsByOrganizationRepository_CountNoteEditsByOrganization_Call struct {
+	*mock.Call
+}
+
+// CountNoteEditsByOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organization uuid.UUID
+//   - member string
+//   - since *time.Time
+//   - now time.Time
+func (_e *MockCountNoteEditsByOrganizationRepository_Expecter) CountNoteEditsByOrganization(ctx interface{}, organization interface{}, member interface{}, since interface{}, now interface{}) *MockCountNoteEditsByOrganizationRepository_CountNoteEditsByOrganization_Call {
+	return &MockCountNoteEditsByOrganizationRepository_CountNoteEditsByOrganization_Call{Call: _e.mock.On("CountNoteEditsByOrganization", ctx, organization, member, since, now)}
+}
+
+func (_c *MockCountNoteEditsByOrganizationRepository_CountNoteEditsByOrganization_Call) Run(run func(ctx context.Context, organization uuid.UUID, member string, since *time.Time, now time.Time)) *MockCountNoteEditsByOrganizationRepository_CountNoteEditsByOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(*time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCountNoteEditsByOrganizationRepository_CountNoteEditsByOrganization_Call) Return(_a0 *dao.OrganizationNoteEditsCount, _a1 error) *MockCountNoteEditsByOrganizationRepository_CountNoteEditsByOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCountNoteEditsByOrganizationRepository_CountNoteEditsByOrganization_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, *time.Time, time.Time) (*dao.OrganizationNoteEditsCount, error)) *MockCountNoteEditsByOrganizationRepository_CountNoteEditsByOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCountNoteEditsByOrganizationRepository creates a new instance of MockCountNoteEditsByOrganizationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCountNoteEditsByOrganizationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCountNoteEditsByOrganizationRepository {
+	mock := &MockCountNoteEditsByOrganizationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockCountNotesByAuthorRepository is an autogenerated mock type for the CountNotesByAuthorRepository type
+type MockCountNotesByAuthorRepository struct {
+	mock.Mock
+}
+
+type MockCountNotesByAuthorRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCountNotesByAuthorRepository) EXPECT() *MockCountNotesByAuthorRepository_Expecter {
+	return &MockCountNotesByAuthorRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountNotesByAuthor provides a mock function with given fields: ctx, author, since, now
+func (_m *MockCountNotesByAuthorRepository) CountNotesByAuthor(ctx context.Context, author string, since *time.Time, now time.Time) (map[entities.Target]int, error) {
+	ret := _m.Called(ctx, author, since, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountNotesByAuthor")
+	}
+
+	var r0 map[entities.Target]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, time.Time) (map[entities.Target]int, error)); ok {
+		return rf(ctx, author, since, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, time.Time) map[entities.Target]int); ok {
+		r0 = rf(ctx, author, since, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[entities.Target]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time, time.Time) error); ok {
+		r1 = rf(ctx, author, since, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCountNotesByAuthorRepository_CountNotesByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountNotesByAuthor'
+type MockCountNotesByAuthorRepository_CountNotesByAuthor_Call struct {
+	*mock.Call
+}
+
+// CountNotesByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+//   - since *time.Time
+//   - now time.Time
+func (_e *MockCountNotesByAuthorRepository_Expecter) CountNotesByAuthor(ctx interface{}, author interface{}, since interface{}, now interface{}) *MockCountNotesByAuthorRepository_CountNotesByAuthor_Call {
+	return &MockCountNotesByAuthorRepository_CountNotesByAuthor_Call{Call: _e.mock.On("CountNotesByAuthor", ctx, author, since, now)}
+}
+
+func (_c *MockCountNotesByAuthorRepository_CountNotesByAuthor_Call) Run(run func(ctx context.Context, author string, since *time.Time, now time.Time)) *MockCountNotesByAuthorRepository_CountNotesByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCountNotesByAuthorRepository_CountNotesByAuthor_Call) Return(_a0 map[entities.Target]int, _a1 error) *MockCountNotesByAuthorRepository_CountNotesByAuthor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCountNotesByAuthorRepository_CountNotesByAuthor_Call) RunAndReturn(run func(context.Context, string, *time.Time, time.Time) (map[entities.Target]int, error)) *MockCountNotesByAuthorRepository_CountNotesByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCountNotesByAuthorRepository creates a new instance of MockCountNotesByAuthorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCountNotesByAuthorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCountNotesByAuthorRepository {
+	mock := &MockCountNotesByAuthorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockCountOrganizationMembersRepository is an autogenerated mock type for the CountOrganizationMembersRepository type
+type MockCountOrganizationMembersRepository struct {
+	mock.Mock
+}
+
+type MockCountOrganizationMembersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCountOrganizationMembersRepository) EXPECT() *MockCountOrganizationMembersRepository_Expecter {
+	return &MockCountOrganizationMembersRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountOrganizationMembers provides a mock function with given fields: ctx, organization
+func (_m *MockCountOrganizationMembersRepository) CountOrganizationMembers(ctx context.Context, organization uuid.UUID) (int, error) {
+	ret := _m.Called(ctx, organization)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOrganizationMembers")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return rf(ctx, organization)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = rf(ctx, organization)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organization)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCountOrganizationMembersRepository_CountOrganizationMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOrganizationMembers'
+type MockCountOrganizationMembersRepository_CountOrganizationMembers_Call struct {
+	*mock.Call
+}
+
+// CountOrganizationMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organization uuid.UUID
+func (_e *MockCountOrganizationMembersRepository_Expecter) CountOrganizationMembers(ctx interface{}, organization interface{}) *MockCountOrganizationMembersRepository_CountOrganizationMembers_Call {
+	return &MockCountOrganizationMembersRepository_CountOrganizationMembers_Call{Call: _e.mock.On("CountOrganizationMembers", ctx, organization)}
+}
+
+func (_c *MockCountOrganizationMembersRepository_CountOrganizationMembers_Call) Run(run func(ctx context.Context, organization uuid.UUID)) *MockCountOrganizationMembersRepository_CountOrganizationMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockCountOrganizationMembersRepository_CountOrganizationMembers_Call) Return(_a0 int, _a1 error) *MockCountOrganizationMembersRepository_CountOrganizationMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCountOrganizationMembersRepository_CountOrganizationMembers_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int, error)) *MockCountOrganizationMembersRepository_CountOrganizationMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCountOrganizationMembersRepository creates a new instance of MockCountOrganizationMembersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCountOrganizationMembersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCountOrganizationMembersRepository {
+	mock := &MockCountOrganizationMembersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockCountUsageEventsBySubjectRepository is an autogenerated mock type for the CountUsageEventsBySubjectRepository type
+type MockCountUsageEventsBySubjectRepository struct {
+	mock.Mock
+}
+
+type MockCountUsageEventsBySubjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCountUsageEventsBySubjectRepository) EXPECT() *MockCountUsageEventsBySubjectRepository_Expecter {
+	return &MockCountUsageEventsBySubjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountUsageEventsBySubject provides a mock function with given fields: ctx, feature, subject, since
+func (_m *MockCountUsageEventsBySubjectRepository) CountUsageEventsBySubject(ctx context.Context, feature string, subject string, since *time.Time) (int, error) {
+	ret := _m.Called(ctx, feature, subject, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUsageEventsBySubject")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *time.Time) (int, error)); ok {
+		return rf(ctx, feature, subject, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *time.Time) int); ok {
+		r0 = rf(ctx, feature, subject, since)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *time.Time) error); ok {
+		r1 = rf(ctx, feature, subject, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCountUsageEventsBySubjectRepository_CountUsageEventsBySubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUsageEventsBySubject'
+type MockCountUsageEventsBySubjectRepository_CountUsageEventsBySubject_Call struct {
+	*mock.Call
+}
+
+// CountUsageEventsBySubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - feature string
+//   - subject string
+//   - since *time.Time
+func (_e *MockCountUsageEventsBySubjectRepository_Expecter) CountUsageEventsBySubject(ctx interface{}, feature interface{}, subject interface{}, since interface{}) *MockCountUsageEventsBySubjectRepository_CountUsageEventsBySubject_Call {
+	return &MockCountUsageEventsBySubjectRepository_CountUsageEventsBySubject_Call{Call: _e.mock.On("CountUsageEventsBySubject", ctx, feature, subject, since)}
+}
+
+func (_c *MockCountUsageEventsBySubjectRepository_CountUsageEventsBySubject_Call) Run(run func(ctx context.Context, feature string, subject string, since *time.Time)) *MockCountUsageEventsBySubjectRepository_CountUsageEventsBySubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCountUsageEventsBySubjectRepository_CountUsageEventsBySubject_Call) Return(_a0 int, _a1 error) *MockCountUsageEventsBySubjectRepository_CountUsageEventsBySubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCountUsageEventsBySubjectRepository_CountUsageEventsBySubject_Call) RunAndReturn(run func(context.Context, string, string, *time.Time) (int, error)) *MockCountUsageEventsBySubjectRepository_CountUsageEventsBySubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCountUsageEventsBySubjectRepository creates a new instance of MockCountUsageEventsBySubjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCountUsageEventsBySubjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCountUsageEventsBySubjectRepository {
+	mock := &MockCountUsageEventsBySubjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-subscription/pkg/dao"
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateOutboxEventRepository is an autogenerated mock type for the CreateOutboxEventRepository type
+type MockCreateOutboxEventRepository struct {
+	mock.Mock
+}
+
+type MockCreateOutboxEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateOutboxEventRepository) EXPECT() *MockCreateOutboxEventRepository_Expecter {
+	return &MockCreateOutboxEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateOutboxEvent provides a mock function with given fields: ctx, data
+func (_m *MockCreateOutboxEventRepository) CreateOutboxEvent(ctx context.Context, data *dao.CreateOutboxEventData) (*entities.OutboxEvent, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOutboxEvent")
+	}
+
+	var r0 *entities.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateOutboxEventData) (*entities.OutboxEvent, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dao.CreateOutboxEventData) *entities.OutboxEvent); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dao.CreateOutboxEventData) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateOutboxEventRepository_CreateOutboxEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOutboxEvent'
+type MockCreateOutboxEventRepository_CreateOutboxEvent_Call struct {
+	*mock.Call
+}
+
+// CreateOutboxEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *dao.CreateOutboxEventData
+func (_e *MockCreateOutboxEventRepository_Expecter) CreateOutboxEvent(ctx interface{}, data interface{}) *MockCreateOutboxEventRepository_CreateOutboxEvent_Call {
+	return &MockCreateOutboxEventRepository_CreateOutboxEvent_Call{Call: _e.mock.On("CreateOutboxEvent", ctx, data)}
+}
+
+func (_c *MockCreateOutboxEventRepository_CreateOutboxEvent_Call) Run(run func(ctx context.Context, data *dao.CreateOutboxEventData)) *MockCreateOutboxEventRepository_CreateOutboxEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dao.CreateOutboxEventData))
+	})
+	return _c
+}
+
+func (_c *MockCreateOutboxEventRepository_CreateOutboxEvent_Call) Return(_a0 *entities.OutboxEvent, _a1 error) *MockCreateOutboxEventRepository_CreateOutboxEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateOutboxEventRepository_CreateOutboxEvent_Call) RunAndReturn(run func(context.Context, *dao.CreateOutboxEventData) (*entities.OutboxEvent, error)) *MockCreateOutboxEventRepository_CreateOutboxEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateOutboxEventRepository creates a new instance of MockCreateOutboxEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateOutboxEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateOutboxEventRepository {
+	mock := &MockCreateOutboxEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
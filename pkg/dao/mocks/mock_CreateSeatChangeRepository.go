@@ -0,0 +1,102 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-subscription/pkg/dao"
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockCreateSeatChangeRepository is an autogenerated mock type for the CreateSeatChangeRepository type
+type MockCreateSeatChangeRepository struct {
+	mock.Mock
+}
+
+type MockCreateSeatChangeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateSeatChangeRepository) EXPECT() *MockCreateSeatChangeRepository_Expecter {
+	return &MockCreateSeatChangeRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateSeatChange provides a mock function with given fields: ctx, organization, user, data
+func (_m *MockCreateSeatChangeRepository) CreateSeatChange(ctx context.Context, organization uuid.UUID, user string, data *dao.CreateSeatChangeData) (*entities.SeatChange, error) {
+	ret := _m.Called(ctx, organization, user, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeatChange")
+	}
+
+	var r0 *entities.SeatChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *dao.CreateSeatChangeData) (*entities.SeatChange, error)); ok {
+		return rf(ctx, organization, user, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *dao.CreateSeatChangeData) *entities.SeatChange); ok {
+		r0 = rf(ctx, organization, user, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SeatChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, *dao.CreateSeatChangeData) error); ok {
+		r1 = rf(ctx, organization, user, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateSeatChangeRepository_CreateSeatChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeatChange'
+type MockCreateSeatChangeRepository_CreateSeatChange_Call struct {
+	*mock.Call
+}
+
+// CreateSeatChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organization uuid.UUID
+//   - user string
+//   - data *dao.CreateSeatChangeData
+func (_e *MockCreateSeatChangeRepository_Expecter) CreateSeatChange(ctx interface{}, organization interface{}, user interface{}, data interface{}) *MockCreateSeatChangeRepository_CreateSeatChange_Call {
+	return &MockCreateSeatChangeRepository_CreateSeatChange_Call{Call: _e.mock.On("CreateSeatChange", ctx, organization, user, data)}
+}
+
+func (_c *MockCreateSeatChangeRepository_CreateSeatChange_Call) Run(run func(ctx context.Context, organization uuid.UUID, user string, data *dao.CreateSeatChangeData)) *MockCreateSeatChangeRepository_CreateSeatChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(*dao.CreateSeatChangeData))
+	})
+	return _c
+}
+
+func (_c *MockCreateSeatChangeRepository_CreateSeatChange_Call) Return(_a0 *entities.SeatChange, _a1 error) *MockCreateSeatChangeRepository_CreateSeatChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateSeatChangeRepository_CreateSeatChange_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, *dao.CreateSeatChangeData) (*entities.SeatChange, error)) *MockCreateSeatChangeRepository_CreateSeatChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateSeatChangeRepository creates a new instance of MockCreateSeatChangeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateSeatChangeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateSeatChangeRepository {
+	mock := &MockCreateSeatChangeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateStripeEventRepository is an autogenerated mock type for the CreateStripeEventRepository type
+type MockCreateStripeEventRepository struct {
+	mock.Mock
+}
+
+type MockCreateStripeEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateStripeEventRepository) EXPECT() *MockCreateStripeEventRepository_Expecter {
+	return &MockCreateStripeEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateStripeEvent provides a mock function with given fields: ctx, id, eventType
+func (_m *MockCreateStripeEventRepository) CreateStripeEvent(ctx context.Context, id string, eventType string) error {
+	ret := _m.Called(ctx, id, eventType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStripeEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, eventType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCreateStripeEventRepository_CreateStripeEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStripeEvent'
+type MockCreateStripeEventRepository_CreateStripeEvent_Call struct {
+	*mock.Call
+}
+
+// CreateStripeEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - eventType string
+func (_e *MockCreateStripeEventRepository_Expecter) CreateStripeEvent(ctx interface{}, id interface{}, eventType interface{}) *MockCreateStripeEventRepository_CreateStripeEvent_Call {
+	return &MockCreateStripeEventRepository_CreateStripeEvent_Call{Call: _e.mock.On("CreateStripeEvent", ctx, id, eventType)}
+}
+
+func (_c *MockCreateStripeEventRepository_CreateStripeEvent_Call) Run(run func(ctx context.Context, id string, eventType string)) *MockCreateStripeEventRepository_CreateStripeEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCreateStripeEventRepository_CreateStripeEvent_Call) Return(_a0 error) *MockCreateStripeEventRepository_CreateStripeEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCreateStripeEventRepository_CreateStripeEvent_Call) RunAndReturn(run func(context.Context, string, string) error) *MockCreateStripeEventRepository_CreateStripeEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateStripeEventRepository creates a new instance of MockCreateStripeEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateStripeEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateStripeEventRepository {
+	mock := &MockCreateStripeEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
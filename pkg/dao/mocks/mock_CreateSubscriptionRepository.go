@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-subscription/pkg/dao"
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateSubscriptionRepository is an autogenerated mock type for the CreateSubscriptionRepository type
+type MockCreateSubscriptionRepository struct {
+	mock.Mock
+}
+
+type MockCreateSubscriptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateSubscriptionRepository) EXPECT() *MockCreateSubscriptionRepository_Expecter {
+	return &MockCreateSubscriptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateSubscription provides a mock function with given fields: ctx, user, data
+func (_m *MockCreateSubscriptionRepository) CreateSubscription(ctx context.Context, user string, data *dao.CreateSubscriptionData) (*entities.Subscription, error) {
+	ret := _m.Called(ctx, user, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubscription")
+	}
+
+	var r0 *entities.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.CreateSubscriptionData) (*entities.Subscription, error)); ok {
+		return rf(ctx, user, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.CreateSubscriptionData) *entities.Subscription); ok {
+		r0 = rf(ctx, user, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *dao.CreateSubscriptionData) error); ok {
+		r1 = rf(ctx, user, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateSubscriptionRepository_CreateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubscription'
+type MockCreateSubscriptionRepository_CreateSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+//   - data *dao.CreateSubscriptionData
+func (_e *MockCreateSubscriptionRepository_Expecter) CreateSubscription(ctx interface{}, user interface{}, data interface{}) *MockCreateSubscriptionRepository_CreateSubscription_Call {
+	return &MockCreateSubscriptionRepository_CreateSubscription_Call{Call: _e.mock.On("CreateSubscription", ctx, user, data)}
+}
+
+func (_c *MockCreateSubscriptionRepository_CreateSubscription_Call) Run(run func(ctx context.Context, user string, data *dao.CreateSubscriptionData)) *MockCreateSubscriptionRepository_CreateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*dao.CreateSubscriptionData))
+	})
+	return _c
+}
+
+func (_c *MockCreateSubscriptionRepository_CreateSubscription_Call) Return(_a0 *entities.Subscription, _a1 error) *MockCreateSubscriptionRepository_CreateSubscription_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateSubscriptionRepository_CreateSubscription_Call) RunAndReturn(run func(context.Context, string, *dao.CreateSubscriptionData) (*entities.Subscription, error)) *MockCreateSubscriptionRepository_CreateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateSubscriptionRepository creates a new instance of MockCreateSubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateSubscriptionRepository {
+	mock := &MockCreateSubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
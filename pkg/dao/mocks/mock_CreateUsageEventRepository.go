@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-subscription/pkg/dao"
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateUsageEventRepository is an autogenerated mock type for the CreateUsageEventRepository type
+type MockCreateUsageEventRepository struct {
+	mock.Mock
+}
+
+type MockCreateUsageEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateUsageEventRepository) EXPECT() *MockCreateUsageEventRepository_Expecter {
+	return &MockCreateUsageEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateUsageEvent provides a mock function with given fields: ctx, subject, data
+func (_m *MockCreateUsageEventRepository) CreateUsageEvent(ctx context.Context, subject string, data *dao.CreateUsageEventData) (*entities.UsageEvent, error) {
+	ret := _m.Called(ctx, subject, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUsageEvent")
+	}
+
+	var r0 *entities.UsageEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.CreateUsageEventData) (*entities.UsageEvent, error)); ok {
+		return rf(ctx, subject, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.CreateUsageEventData) *entities.UsageEvent); ok {
+		r0 = rf(ctx, subject, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UsageEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *dao.CreateUsageEventData) error); ok {
+		r1 = rf(ctx, subject, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCreateUsageEventRepository_CreateUsageEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUsageEvent'
+type MockCreateUsageEventRepository_CreateUsageEvent_Call struct {
+	*mock.Call
+}
+
+// CreateUsageEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+//   - data *dao.CreateUsageEventData
+func (_e *MockCreateUsageEventRepository_Expecter) CreateUsageEvent(ctx interface{}, subject interface{}, data interface{}) *MockCreateUsageEventRepository_CreateUsageEvent_Call {
+	return &MockCreateUsageEventRepository_CreateUsageEvent_Call{Call: _e.mock.On("CreateUsageEvent", ctx, subject, data)}
+}
+
+func (_c *MockCreateUsageEventRepository_CreateUsageEvent_Call) Run(run func(ctx context.Context, subject string, data *dao.CreateUsageEventData)) *MockCreateUsageEventRepository_CreateUsageEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*dao.CreateUsageEventData))
+	})
+	return _c
+}
+
+func (_c *MockCreateUsageEventRepository_CreateUsageEvent_Call) Return(_a0 *entities.UsageEvent, _a1 error) *MockCreateUsageEventRepository_CreateUsageEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCreateUsageEventRepository_CreateUsageEvent_Call) RunAndReturn(run func(context.Context, string, *dao.CreateUsageEventData) (*entities.UsageEvent, error)) *MockCreateUsageEventRepository_CreateUsageEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateUsageEventRepository creates a new instance of MockCreateUsageEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateUsageEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateUsageEventRepository {
+	mock := &MockCreateUsageEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeleteEntitlementOverrideRepository is an autogenerated mock type for the DeleteEntitlementOverrideRepository type
+type MockDeleteEntitlementOverrideRepository struct {
+	mock.Mock
+}
+
+type MockDeleteEntitlementOverrideRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteEntitlementOverrideRepository) EXPECT() *MockDeleteEntitlementOverrideRepository_Expecter {
+	return &MockDeleteEntitlementOverrideRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteEntitlementOverride provides a mock function with given fields: ctx, user, entitlement
+func (_m *MockDeleteEntitlementOverrideRepository) DeleteEntitlementOverride(ctx context.Context, user string, entitlement string) error {
+	ret := _m.Called(ctx, user, entitlement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEntitlementOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, user, entitlement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeleteEntitlementOverrideRepository_DeleteEntitlementOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEntitlementOverride'
+type MockDeleteEntitlementOverrideRepository_DeleteEntitlementOverride_Call struct {
+	*mock.Call
+}
+
+// DeleteEntitlementOverride is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+//   - entitlement string
+func (_e *MockDeleteEntitlementOverrideRepository_Expecter) DeleteEntitlementOverride(ctx interface{}, user interface{}, entitlement interface{}) *MockDeleteEntitlementOverrideRepository_DeleteEntitlementOverride_Call {
+	return &MockDeleteEntitlementOverrideRepository_DeleteEntitlementOverride_Call{Call: _e.mock.On("DeleteEntitlementOverride", ctx, user, entitlement)}
+}
+
+func (_c *MockDeleteEntitlementOverrideRepository_DeleteEntitlementOverride_Call) Run(run func(ctx context.Context, user string, entitlement string)) *MockDeleteEntitlementOverrideRepository_DeleteEntitlementOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDeleteEntitlementOverrideRepository_DeleteEntitlementOverride_Call) Return(_a0 error) *MockDeleteEntitlementOverrideRepository_DeleteEntitlementOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeleteEntitlementOverrideRepository_DeleteEntitlementOverride_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDeleteEntitlementOverrideRepository_DeleteEntitlementOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteEntitlementOverrideRepository creates a new instance of MockDeleteEntitlementOverrideRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteEntitlementOverrideRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteEntitlementOverrideRepository {
+	mock := &MockDeleteEntitlementOverrideRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,86 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDeleteOrganizationMemberRepository is an autogenerated mock type for the DeleteOrganizationMemberRepository type
+type MockDeleteOrganizationMemberRepository struct {
+	mock.Mock
+}
+
+type MockDeleteOrganizationMemberRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteOrganizationMemberRepository) EXPECT() *MockDeleteOrganizationMemberRepository_Expecter {
+	return &MockDeleteOrganizationMemberRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteOrganizationMember provides a mock function with given fields: ctx, organization, user
+func (_m *MockDeleteOrganizationMemberRepository) DeleteOrganizationMember(ctx context.Context, organization uuid.UUID, user string) error {
+	ret := _m.Called(ctx, organization, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, organization, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeleteOrganizationMemberRepository_DeleteOrganizationMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationMember'
+type MockDeleteOrganizationMemberRepository_DeleteOrganizationMember_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organization uuid.UUID
+//   - user string
+func (_e *MockDeleteOrganizationMemberRepository_Expecter) DeleteOrganizationMember(ctx interface{}, organization interface{}, user interface{}) *MockDeleteOrganizationMemberRepository_DeleteOrganizationMember_Call {
+	return &MockDeleteOrganizationMemberRepository_DeleteOrganizationMember_Call{Call: _e.mock.On("DeleteOrganizationMember", ctx, organization, user)}
+}
+
+func (_c *MockDeleteOrganizationMemberRepository_DeleteOrganizationMember_Call) Run(run func(ctx context.Context, organization uuid.UUID, user string)) *MockDeleteOrganizationMemberRepository_DeleteOrganizationMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDeleteOrganizationMemberRepository_DeleteOrganizationMember_Call) Return(_a0 error) *MockDeleteOrganizationMemberRepository_DeleteOrganizationMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeleteOrganizationMemberRepository_DeleteOrganizationMember_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *MockDeleteOrganizationMemberRepository_DeleteOrganizationMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteOrganizationMemberRepository creates a new instance of MockDeleteOrganizationMemberRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteOrganizationMemberRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteOrganizationMemberRepository {
+	mock := &MockDeleteOrganizationMemberRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
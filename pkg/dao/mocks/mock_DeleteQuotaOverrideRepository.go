@@ -0,0 +1,83 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeleteQuotaOverrideRepository is an autogenerated mock type for the DeleteQuotaOverrideRepository type
+type MockDeleteQuotaOverrideRepository struct {
+	mock.Mock
+}
+
+type MockDeleteQuotaOverrideRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteQuotaOverrideRepository) EXPECT() *MockDeleteQuotaOverrideRepository_Expecter {
+	return &MockDeleteQuotaOverrideRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteQuotaOverride provides a mock function with given fields: ctx, author
+func (_m *MockDeleteQuotaOverrideRepository) DeleteQuotaOverride(ctx context.Context, author string) error {
+	ret := _m.Called(ctx, author)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuotaOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, author)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeleteQuotaOverrideRepository_DeleteQuotaOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQuotaOverride'
+type MockDeleteQuotaOverrideRepository_DeleteQuotaOverride_Call struct {
+	*mock.Call
+}
+
+// DeleteQuotaOverride is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+func (_e *MockDeleteQuotaOverrideRepository_Expecter) DeleteQuotaOverride(ctx interface{}, author interface{}) *MockDeleteQuotaOverrideRepository_DeleteQuotaOverride_Call {
+	return &MockDeleteQuotaOverrideRepository_DeleteQuotaOverride_Call{Call: _e.mock.On("DeleteQuotaOverride", ctx, author)}
+}
+
+func (_c *MockDeleteQuotaOverrideRepository_DeleteQuotaOverride_Call) Run(run func(ctx context.Context, author string)) *MockDeleteQuotaOverrideRepository_DeleteQuotaOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDeleteQuotaOverrideRepository_DeleteQuotaOverride_Call) Return(_a0 error) *MockDeleteQuotaOverrideRepository_DeleteQuotaOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeleteQuotaOverrideRepository_DeleteQuotaOverride_Call) RunAndReturn(run func(context.Context, string) error) *MockDeleteQuotaOverrideRepository_DeleteQuotaOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteQuotaOverrideRepository creates a new instance of MockDeleteQuotaOverrideRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteQuotaOverrideRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteQuotaOverrideRepository {
+	mock := &MockDeleteQuotaOverrideRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockExpireNoteEditsRepository is an autogenerated mock type for the ExpireNoteEditsRepository type
+type MockExpireNoteEditsRepository struct {
+	mock.Mock
+}
+
+type MockExpireNoteEditsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpireNoteEditsRepository) EXPECT() *MockExpireNoteEditsRepository_Expecter {
+	return &MockExpireNoteEditsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ExpireNoteEdits provides a mock function with given fields: ctx, now, limit
+func (_m *MockExpireNoteEditsRepository) ExpireNoteEdits(ctx context.Context, now time.Time, limit int) ([]*entities.NoteEdit, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireNoteEdits")
+	}
+
+	var r0 []*entities.NoteEdit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*entities.NoteEdit, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*entities.NoteEdit); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.NoteEdit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpireNoteEditsRepository_ExpireNoteEdits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireNoteEdits'
+type MockExpireNoteEditsRepository_ExpireNoteEdits_Call struct {
+	*mock.Call
+}
+
+// ExpireNoteEdits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *MockExpireNoteEditsRepository_Expecter) ExpireNoteEdits(ctx interface{}, now interface{}, limit interface{}) *MockExpireNoteEditsRepository_ExpireNoteEdits_Call {
+	return &MockExpireNoteEditsRepository_ExpireNoteEdits_Call{Call: _e.mock.On("ExpireNoteEdits", ctx, now, limit)}
+}
+
+func (_c *MockExpireNoteEditsRepository_ExpireNoteEdits_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *MockExpireNoteEditsRepository_ExpireNoteEdits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockExpireNoteEditsRepository_ExpireNoteEdits_Call) Return(_a0 []*entities.NoteEdit, _a1 error) *MockExpireNoteEditsRepository_ExpireNoteEdits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpireNoteEditsRepository_ExpireNoteEdits_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*entities.NoteEdit, error)) *MockExpireNoteEditsRepository_ExpireNoteEdits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpireNoteEditsRepository creates a new instance of MockExpireNoteEditsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpireNoteEditsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpireNoteEditsRepository {
+	mock := &MockExpireNoteEditsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockExpireTrialsRepository is an autogenerated mock type for the ExpireTrialsRepository type
+type MockExpireTrialsRepository struct {
+	mock.Mock
+}
+
+type MockExpireTrialsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpireTrialsRepository) EXPECT() *MockExpireTrialsRepository_Expecter {
+	return &MockExpireTrialsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ExpireTrials provides a mock function with given fields: ctx, now, limit
+func (_m *MockExpireTrialsRepository) ExpireTrials(ctx context.Context, now time.Time, limit int) ([]*entities.Subscription, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireTrials")
+	}
+
+	var r0 []*entities.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*entities.Subscription, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*entities.Subscription); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpireTrialsRepository_ExpireTrials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireTrials'
+type MockExpireTrialsRepository_ExpireTrials_Call struct {
+	*mock.Call
+}
+
+// ExpireTrials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *MockExpireTrialsRepository_Expecter) ExpireTrials(ctx interface{}, now interface{}, limit interface{}) *MockExpireTrialsRepository_ExpireTrials_Call {
+	return &MockExpireTrialsRepository_ExpireTrials_Call{Call: _e.mock.On("ExpireTrials", ctx, now, limit)}
+}
+
+func (_c *MockExpireTrialsRepository_ExpireTrials_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *MockExpireTrialsRepository_ExpireTrials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockExpireTrialsRepository_ExpireTrials_Call) Return(_a0 []*entities.Subscription, _a1 error) *MockExpireTrialsRepository_ExpireTrials_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpireTrialsRepository_ExpireTrials_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*entities.Subscription, error)) *MockExpireTrialsRepository_ExpireTrials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpireTrialsRepository creates a new instance of MockExpireTrialsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpireTrialsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpireTrialsRepository {
+	mock := &MockExpireTrialsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
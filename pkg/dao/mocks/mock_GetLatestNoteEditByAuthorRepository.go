@@ -8,6 +8,8 @@ import (
 	entities "github.com/in-rich/uservice-subscription/pkg/entities"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockGetLatestNoteEditByAuthorRepository is an autogenerated mock type for the GetLatestNoteEditByAuthorRepository type
@@ -23,9 +25,9 @@ func (_m *MockGetLatestNoteEditByAuthorRepository) EXPECT() *MockGetLatestNoteEd
 	return &MockGetLatestNoteEditByAuthorRepository_Expecter{mock: &_m.Mock}
 }
 
-// GetLatestNoteEditByAuthor provides a mock function with given fields: ctx, author, target, publicIdentifier
-func (_m *MockGetLatestNoteEditByAuthorRepository) GetLatestNoteEditByAuthor(ctx context.Context, author string, target entities.Target, publicIdentifier string) (*entities.NoteEdit, error) {
-	ret := _m.Called(ctx, author, target, publicIdentifier)
+// GetLatestNoteEditByAuthor provides a mock function with given fields: ctx, author, target, publicIdentifier, now
+func (_m *MockGetLatestNoteEditByAuthorRepository) GetLatestNoteEditByAuthor(ctx context.Context, author string, target entities.Target, publicIdentifier string, now time.Time) (*entities.NoteEdit, error) {
+	ret := _m.Called(ctx, author, target, publicIdentifier, now)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLatestNoteEditByAuthor")
@@ -33,19 +35,19 @@ func (_m *MockGetLatestNoteEditByAuthorRepository) GetLatestNoteEditByAuthor(ctx
 
 	var r0 *entities.NoteEdit
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, entities.Target, string) (*entities.NoteEdit, error)); ok {
-		return rf(ctx, author, target, publicIdentifier)
+	if rf, ok := ret.Get(0).(func(context.Context, string, entities.Target, string, time.Time) (*entities.NoteEdit, error)); ok {
+		return rf(ctx, author, target, publicIdentifier, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, entities.Target, string) *entities.NoteEdit); ok {
-		r0 = rf(ctx, author, target, publicIdentifier)
+	if rf, ok := ret.Get(0).(func(context.Context, string, entities.Target, string, time.Time) *entities.NoteEdit); ok {
+		r0 = rf(ctx, author, target, publicIdentifier, now)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.NoteEdit)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, entities.Target, string) error); ok {
-		r1 = rf(ctx, author, target, publicIdentifier)
+	if rf, ok := ret.Get(1).(func(context.Context, string, entities.Target, string, time.Time) error); ok {
+		r1 = rf(ctx, author, target, publicIdentifier, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -63,13 +65,14 @@ type MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call stru
 //   - author string
 //   - target entities.Target
 //   - publicIdentifier string
-func (_e *MockGetLatestNoteEditByAuthorRepository_Expecter) GetLatestNoteEditByAuthor(ctx interface{}, author interface{}, target interface{}, publicIdentifier interface{}) *MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call {
-	return &MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call{Call: _e.mock.On("GetLatestNoteEditByAuthor", ctx, author, target, publicIdentifier)}
+//   - now time.Time
+func (_e *MockGetLatestNoteEditByAuthorRepository_Expecter) GetLatestNoteEditByAuthor(ctx interface{}, author interface{}, target interface{}, publicIdentifier interface{}, now interface{}) *MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call {
+	return &MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call{Call: _e.mock.On("GetLatestNoteEditByAuthor", ctx, author, target, publicIdentifier, now)}
 }
 
-func (_c *MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call) Run(run func(ctx context.Context, author string, target entities.Target, publicIdentifier string)) *MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call {
+func (_c *MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call) Run(run func(ctx context.Context, author string, target entities.Target, publicIdentifier string, now time.Time)) *MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(entities.Target), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(entities.Target), args[3].(string), args[4].(time.Time))
 	})
 	return _c
 }
@@ -79,7 +82,7 @@ func (_c *MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call
 	return _c
 }
 
-func (_c *MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call) RunAndReturn(run func(context.Context, string, entities.Target, string) (*entities.NoteEdit, error)) *MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call {
+func (_c *MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call) RunAndReturn(run func(context.Context, string, entities.Target, string, time.Time) (*entities.NoteEdit, error)) *MockGetLatestNoteEditByAuthorRepository_GetLatestNoteEditByAuthor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetLatestUsageEventBySubjectRepository is an autogenerated mock type for the GetLatestUsageEventBySubjectRepository type
+type MockGetLatestUsageEventBySubjectRepository struct {
+	mock.Mock
+}
+
+type MockGetLatestUsageEventBySubjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetLatestUsageEventBySubjectRepository) EXPECT() *MockGetLatestUsageEventBySubjectRepository_Expecter {
+	return &MockGetLatestUsageEventBySubjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLatestUsageEventBySubject provides a mock function with given fields: ctx, feature, subject, key
+func (_m *MockGetLatestUsageEventBySubjectRepository) GetLatestUsageEventBySubject(ctx context.Context, feature string, subject string, key string) (*entities.UsageEvent, error) {
+	ret := _m.Called(ctx, feature, subject, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestUsageEventBySubject")
+	}
+
+	var r0 *entities.UsageEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*entities.UsageEvent, error)); ok {
+		return rf(ctx, feature, subject, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *entities.UsageEvent); ok {
+		r0 = rf(ctx, feature, subject, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UsageEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, feature, subject, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetLatestUsageEventBySubjectRepository_GetLatestUsageEventBySubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestUsageEventBySubject'
+type MockGetLatestUsageEventBySubjectRepository_GetLatestUsageEventBySubject_Call struct {
+	*mock.Call
+}
+
+// GetLatestUsageEventBySubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - feature string
+//   - subject string
+//   - key string
+func (_e *MockGetLatestUsageEventBySubjectRepository_Expecter) GetLatestUsageEventBySubject(ctx interface{}, feature interface{}, subject interface{}, key interface{}) *MockGetLatestUsageEventBySubjectRepository_GetLatestUsageEventBySubject_Call {
+	return &MockGetLatestUsageEventBySubjectRepository_GetLatestUsageEventBySubject_Call{Call: _e.mock.On("GetLatestUsageEventBySubject", ctx, feature, subject, key)}
+}
+
+func (_c *MockGetLatestUsageEventBySubjectRepository_GetLatestUsageEventBySubject_Call) Run(run func(ctx context.Context, feature string, subject string, key string)) *MockGetLatestUsageEventBySubjectRepository_GetLatestUsageEventBySubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetLatestUsageEventBySubjectRepository_GetLatestUsageEventBySubject_Call) Return(_a0 *entities.UsageEvent, _a1 error) *MockGetLatestUsageEventBySubjectRepository_GetLatestUsageEventBySubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetLatestUsageEventBySubjectRepository_GetLatestUsageEventBySubject_Call) RunAndReturn(run func(context.Context, string, string, string) (*entities.UsageEvent, error)) *MockGetLatestUsageEventBySubjectRepository_GetLatestUsageEventBySubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetLatestUsageEventBySubjectRepository creates a new instance of MockGetLatestUsageEventBySubjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetLatestUsageEventBySubjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetLatestUsageEventBySubjectRepository {
+	mock := &MockGetLatestUsageEventBySubjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetNoteEditByIdempotencyKeyRepository is an autogenerated mock type for the GetNoteEditByIdempotencyKeyRepository type
+type MockGetNoteEditByIdempotencyKeyRepository struct {
+	mock.Mock
+}
+
+type MockGetNoteEditByIdempotencyKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetNoteEditByIdempotencyKeyRepository) EXPECT() *MockGetNoteEditByIdempotencyKeyRepository_Expecter {
+	return &MockGetNoteEditByIdempotencyKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetNoteEditByIdempotencyKey provides a mock function with given fields: ctx, author, key
+func (_m *MockGetNoteEditByIdempotencyKeyRepository) GetNoteEditByIdempotencyKey(ctx context.Context, author string, key string) (*entities.NoteEdit, error) {
+	ret := _m.Called(ctx, author, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNoteEditByIdempotencyKey")
+	}
+
+	var r0 *entities.NoteEdit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.NoteEdit, error)); ok {
+		return rf(ctx, author, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.NoteEdit); ok {
+		r0 = rf(ctx, author, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.NoteEdit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, author, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetNoteEditByIdempotencyKeyRepository_GetNoteEditByIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNoteEditByIdempotencyKey'
+type MockGetNoteEditByIdempotencyKeyRepository_GetNoteEditByIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// GetNoteEditByIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+//   - key string
+func (_e *MockGetNoteEditByIdempotencyKeyRepository_Expecter) GetNoteEditByIdempotencyKey(ctx interface{}, author interface{}, key interface{}) *MockGetNoteEditByIdempotencyKeyRepository_GetNoteEditByIdempotencyKey_Call {
+	return &MockGetNoteEditByIdempotencyKeyRepository_GetNoteEditByIdempotencyKey_Call{Call: _e.mock.On("GetNoteEditByIdempotencyKey", ctx, author, key)}
+}
+
+func (_c *MockGetNoteEditByIdempotencyKeyRepository_GetNoteEditByIdempotencyKey_Call) Run(run func(ctx context.Context, author string, key string)) *MockGetNoteEditByIdempotencyKeyRepository_GetNoteEditByIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetNoteEditByIdempotencyKeyRepository_GetNoteEditByIdempotencyKey_Call) Return(_a0 *entities.NoteEdit, _a1 error) *MockGetNoteEditByIdempotencyKeyRepository_GetNoteEditByIdempotencyKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetNoteEditByIdempotencyKeyRepository_GetNoteEditByIdempotencyKey_Call) RunAndReturn(run func(context.Context, string, string) (*entities.NoteEdit, error)) *MockGetNoteEditByIdempotencyKeyRepository_GetNoteEditByIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetNoteEditByIdempotencyKeyRepository creates a new instance of MockGetNoteEditByIdempotencyKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetNoteEditByIdempotencyKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetNoteEditByIdempotencyKeyRepository {
+	mock := &MockGetNoteEditByIdempotencyKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
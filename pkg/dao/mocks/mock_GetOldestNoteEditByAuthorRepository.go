@@ -0,0 +1,101 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockGetOldestNoteEditByAuthorRepository is an autogenerated mock type for the GetOldestNoteEditByAuthorRepository type
+type MockGetOldestNoteEditByAuthorRepository struct {
+	mock.Mock
+}
+
+type MockGetOldestNoteEditByAuthorRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetOldestNoteEditByAuthorRepository) EXPECT() *MockGetOldestNoteEditByAuthorRepository_Expecter {
+	return &MockGetOldestNoteEditByAuthorRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetOldestNoteEditByAuthor provides a mock function with given fields: ctx, author, since, now
+func (_m *MockGetOldestNoteEditByAuthorRepository) GetOldestNoteEditByAuthor(ctx context.Context, author string, since *time.Time, now time.Time) (*entities.NoteEdit, error) {
+	ret := _m.Called(ctx, author, since, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOldestNoteEditByAuthor")
+	}
+
+	var r0 *entities.NoteEdit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, time.Time) (*entities.NoteEdit, error)); ok {
+		return rf(ctx, author, since, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, time.Time) *entities.NoteEdit); ok {
+		r0 = rf(ctx, author, since, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.NoteEdit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time, time.Time) error); ok {
+		r1 = rf(ctx, author, since, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetOldestNoteEditByAuthorRepository_GetOldestNoteEditByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOldestNoteEditByAuthor'
+type MockGetOldestNoteEditByAuthorRepository_GetOldestNoteEditByAuthor_Call struct {
+	*mock.Call
+}
+
+// GetOldestNoteEditByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+//   - since *time.Time
+//   - now time.Time
+func (_e *MockGetOldestNoteEditByAuthorRepository_Expecter) GetOldestNoteEditByAuthor(ctx interface{}, author interface{}, since interface{}, now interface{}) *MockGetOldestNoteEditByAuthorRepository_GetOldestNoteEditByAuthor_Call {
+	return &MockGetOldestNoteEditByAuthorRepository_GetOldestNoteEditByAuthor_Call{Call: _e.mock.On("GetOldestNoteEditByAuthor", ctx, author, since, now)}
+}
+
+func (_c *MockGetOldestNoteEditByAuthorRepository_GetOldestNoteEditByAuthor_Call) Run(run func(ctx context.Context, author string, since *time.Time, now time.Time)) *MockGetOldestNoteEditByAuthorRepository_GetOldestNoteEditByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGetOldestNoteEditByAuthorRepository_GetOldestNoteEditByAuthor_Call) Return(_a0 *entities.NoteEdit, _a1 error) *MockGetOldestNoteEditByAuthorRepository_GetOldestNoteEditByAuthor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetOldestNoteEditByAuthorRepository_GetOldestNoteEditByAuthor_Call) RunAndReturn(run func(context.Context, string, *time.Time, time.Time) (*entities.NoteEdit, error)) *MockGetOldestNoteEditByAuthorRepository_GetOldestNoteEditByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetOldestNoteEditByAuthorRepository creates a new instance of MockGetOldestNoteEditByAuthorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetOldestNoteEditByAuthorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetOldestNoteEditByAuthorRepository {
+	mock := &MockGetOldestNoteEditByAuthorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
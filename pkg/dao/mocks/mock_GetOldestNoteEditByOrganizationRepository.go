@@ -0,0 +1,103 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockGetOldestNoteEditByOrganizationRepository is an autogenerated mock type for the GetOldestNoteEditByOrganizationRepository type
+type MockGetOldestNoteEditByOrganizationRepository struct {
+	mock.Mock
+}
+
+type MockGetOldestNoteEditByOrganizationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetOldestNoteEditByOrganizationRepository) EXPECT() *MockGetOldestNoteEditByOrganizationRepository_Expecter {
+	return &MockGetOldestNoteEditByOrganizationRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetOldestNoteEditByOrganization provides a mock function with given fields: ctx, organization, since, now
+func (_m *MockGetOldestNoteEditByOrganizationRepository) GetOldestNoteEditByOrganization(ctx context.Context, organization uuid.UUID, since *time.Time, now time.Time) (*entities.NoteEdit, error) {
+	ret := _m.Called(ctx, organization, since, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOldestNoteEditByOrganization")
+	}
+
+	var r0 *entities.NoteEdit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, time.Time) (*entities.NoteEdit, error)); ok {
+		return rf(ctx, organization, since, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, time.Time) *entities.NoteEdit); ok {
+		r0 = rf(ctx, organization, since, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.NoteEdit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *time.Time, time.Time) error); ok {
+		r1 = rf(ctx, organization, since, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetOldestNoteEditByOrganizationRepository_GetOldestNoteEditByOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOldestNoteEditByOrganization'
+type MockGetOldestNoteEditByOrganizationRepository_GetOldestNoteEditByOrganization_Call struct {
+	*mock.Call
+}
+
+// GetOldestNoteEditByOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organization uuid.UUID
+//   - since *time.Time
+//   - now time.Time
+func (_e *MockGetOldestNoteEditByOrganizationRepository_Expecter) GetOldestNoteEditByOrganization(ctx interface{}, organization interface{}, since interface{}, now interface{}) *MockGetOldestNoteEditByOrganizationRepository_GetOldestNoteEditByOrganization_Call {
+	return &MockGetOldestNoteEditByOrganizationRepository_GetOldestNoteEditByOrganization_Call{Call: _e.mock.On("GetOldestNoteEditByOrganization", ctx, organization, since, now)}
+}
+
+func (_c *MockGetOldestNoteEditByOrganizationRepository_GetOldestNoteEditByOrganization_Call) Run(run func(ctx context.Context, organization uuid.UUID, since *time.Time, now time.Time)) *MockGetOldestNoteEditByOrganizationRepository_GetOldestNoteEditByOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGetOldestNoteEditByOrganizationRepository_GetOldestNoteEditByOrganization_Call) Return(_a0 *entities.NoteEdit, _a1 error) *MockGetOldestNoteEditByOrganizationRepository_GetOldestNoteEditByOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetOldestNoteEditByOrganizationRepository_GetOldestNoteEditByOrganization_Call) RunAndReturn(run func(context.Context, uuid.UUID, *time.Time, time.Time) (*entities.NoteEdit, error)) *MockGetOldestNoteEditByOrganizationRepository_GetOldestNoteEditByOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetOldestNoteEditByOrganizationRepository creates a new instance of MockGetOldestNoteEditByOrganizationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetOldestNoteEditByOrganizationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetOldestNoteEditByOrganizationRepository {
+	mock := &MockGetOldestNoteEditByOrganizationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetOrganizationMemberByUserRepository is an autogenerated mock type for the GetOrganizationMemberByUserRepository type
+type MockGetOrganizationMemberByUserRepository struct {
+	mock.Mock
+}
+
+type MockGetOrganizationMemberByUserRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetOrganizationMemberByUserRepository) EXPECT() *MockGetOrganizationMemberByUserRepository_Expecter {
+	return &MockGetOrganizationMemberByUserRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetOrganizationMemberByUser provides a mock function with given fields: ctx, user
+func (_m *MockGetOrganizationMemberByUserRepository) GetOrganizationMemberByUser(ctx context.Context, user string) (*entities.OrganizationMember, error) {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMemberByUser")
+	}
+
+	var r0 *entities.OrganizationMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.OrganizationMember, error)); ok {
+		return rf(ctx, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.OrganizationMember); ok {
+		r0 = rf(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrganizationMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetOrganizationMemberByUserRepository_GetOrganizationMemberByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMemberByUser'
+type MockGetOrganizationMemberByUserRepository_GetOrganizationMemberByUser_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMemberByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+func (_e *MockGetOrganizationMemberByUserRepository_Expecter) GetOrganizationMemberByUser(ctx interface{}, user interface{}) *MockGetOrganizationMemberByUserRepository_GetOrganizationMemberByUser_Call {
+	return &MockGetOrganizationMemberByUserRepository_GetOrganizationMemberByUser_Call{Call: _e.mock.On("GetOrganizationMemberByUser", ctx, user)}
+}
+
+func (_c *MockGetOrganizationMemberByUserRepository_GetOrganizationMemberByUser_Call) Run(run func(ctx context.Context, user string)) *MockGetOrganizationMemberByUserRepository_GetOrganizationMemberByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetOrganizationMemberByUserRepository_GetOrganizationMemberByUser_Call) Return(_a0 *entities.OrganizationMember, _a1 error) *MockGetOrganizationMemberByUserRepository_GetOrganizationMemberByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetOrganizationMemberByUserRepository_GetOrganizationMemberByUser_Call) RunAndReturn(run func(context.Context, string) (*entities.OrganizationMember, error)) *MockGetOrganizationMemberByUserRepository_GetOrganizationMemberByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetOrganizationMemberByUserRepository creates a new instance of MockGetOrganizationMemberByUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetOrganizationMemberByUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetOrganizationMemberByUserRepository {
+	mock := &MockGetOrganizationMemberByUserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
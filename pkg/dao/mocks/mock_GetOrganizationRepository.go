@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockGetOrganizationRepository is an autogenerated mock type for the GetOrganizationRepository type
+type MockGetOrganizationRepository struct {
+	mock.Mock
+}
+
+type MockGetOrganizationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetOrganizationRepository) EXPECT() *MockGetOrganizationRepository_Expecter {
+	return &MockGetOrganizationRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetOrganization provides a mock function with given fields: ctx, id
+func (_m *MockGetOrganizationRepository) GetOrganization(ctx context.Context, id uuid.UUID) (*entities.Organization, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganization")
+	}
+
+	var r0 *entities.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entities.Organization, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entities.Organization); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetOrganizationRepository_GetOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganization'
+type MockGetOrganizationRepository_GetOrganization_Call struct {
+	*mock.Call
+}
+
+// GetOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockGetOrganizationRepository_Expecter) GetOrganization(ctx interface{}, id interface{}) *MockGetOrganizationRepository_GetOrganization_Call {
+	return &MockGetOrganizationRepository_GetOrganization_Call{Call: _e.mock.On("GetOrganization", ctx, id)}
+}
+
+func (_c *MockGetOrganizationRepository_GetOrganization_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockGetOrganizationRepository_GetOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockGetOrganizationRepository_GetOrganization_Call) Return(_a0 *entities.Organization, _a1 error) *MockGetOrganizationRepository_GetOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetOrganizationRepository_GetOrganization_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entities.Organization, error)) *MockGetOrganizationRepository_GetOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetOrganizationRepository creates a new instance of MockGetOrganizationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetOrganizationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetOrganizationRepository {
+	mock := &MockGetOrganizationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
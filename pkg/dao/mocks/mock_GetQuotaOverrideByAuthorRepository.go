@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetQuotaOverrideByAuthorRepository is an autogenerated mock type for the GetQuotaOverrideByAuthorRepository type
+type MockGetQuotaOverrideByAuthorRepository struct {
+	mock.Mock
+}
+
+type MockGetQuotaOverrideByAuthorRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetQuotaOverrideByAuthorRepository) EXPECT() *MockGetQuotaOverrideByAuthorRepository_Expecter {
+	return &MockGetQuotaOverrideByAuthorRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetQuotaOverrideByAuthor provides a mock function with given fields: ctx, author
+func (_m *MockGetQuotaOverrideByAuthorRepository) GetQuotaOverrideByAuthor(ctx context.Context, author string) (*entities.QuotaOverride, error) {
+	ret := _m.Called(ctx, author)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuotaOverrideByAuthor")
+	}
+
+	var r0 *entities.QuotaOverride
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.QuotaOverride, error)); ok {
+		return rf(ctx, author)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.QuotaOverride); ok {
+		r0 = rf(ctx, author)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.QuotaOverride)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, author)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetQuotaOverrideByAuthorRepository_GetQuotaOverrideByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuotaOverrideByAuthor'
+type MockGetQuotaOverrideByAuthorRepository_GetQuotaOverrideByAuthor_Call struct {
+	*mock.Call
+}
+
+// GetQuotaOverrideByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+func (_e *MockGetQuotaOverrideByAuthorRepository_Expecter) GetQuotaOverrideByAuthor(ctx interface{}, author interface{}) *MockGetQuotaOverrideByAuthorRepository_GetQuotaOverrideByAuthor_Call {
+	return &MockGetQuotaOverrideByAuthorRepository_GetQuotaOverrideByAuthor_Call{Call: _e.mock.On("GetQuotaOverrideByAuthor", ctx, author)}
+}
+
+func (_c *MockGetQuotaOverrideByAuthorRepository_GetQuotaOverrideByAuthor_Call) Run(run func(ctx context.Context, author string)) *MockGetQuotaOverrideByAuthorRepository_GetQuotaOverrideByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetQuotaOverrideByAuthorRepository_GetQuotaOverrideByAuthor_Call) Return(_a0 *entities.QuotaOverride, _a1 error) *MockGetQuotaOverrideByAuthorRepository_GetQuotaOverrideByAuthor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetQuotaOverrideByAuthorRepository_GetQuotaOverrideByAuthor_Call) RunAndReturn(run func(context.Context, string) (*entities.QuotaOverride, error)) *MockGetQuotaOverrideByAuthorRepository_GetQuotaOverrideByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetQuotaOverrideByAuthorRepository creates a new instance of MockGetQuotaOverrideByAuthorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetQuotaOverrideByAuthorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetQuotaOverrideByAuthorRepository {
+	mock := &MockGetQuotaOverrideByAuthorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
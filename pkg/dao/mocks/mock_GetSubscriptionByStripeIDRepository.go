@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetSubscriptionByStripeIDRepository is an autogenerated mock type for the GetSubscriptionByStripeIDRepository type
+type MockGetSubscriptionByStripeIDRepository struct {
+	mock.Mock
+}
+
+type MockGetSubscriptionByStripeIDRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetSubscriptionByStripeIDRepository) EXPECT() *MockGetSubscriptionByStripeIDRepository_Expecter {
+	return &MockGetSubscriptionByStripeIDRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetSubscriptionByStripeID provides a mock function with given fields: ctx, stripeSubscriptionID
+func (_m *MockGetSubscriptionByStripeIDRepository) GetSubscriptionByStripeID(ctx context.Context, stripeSubscriptionID string) (*entities.Subscription, error) {
+	ret := _m.Called(ctx, stripeSubscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscriptionByStripeID")
+	}
+
+	var r0 *entities.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.Subscription, error)); ok {
+		return rf(ctx, stripeSubscriptionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.Subscription); ok {
+		r0 = rf(ctx, stripeSubscriptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, stripeSubscriptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetSubscriptionByStripeIDRepository_GetSubscriptionByStripeID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscriptionByStripeID'
+type MockGetSubscriptionByStripeIDRepository_GetSubscriptionByStripeID_Call struct {
+	*mock.Call
+}
+
+// GetSubscriptionByStripeID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stripeSubscriptionID string
+func (_e *MockGetSubscriptionByStripeIDRepository_Expecter) GetSubscriptionByStripeID(ctx interface{}, stripeSubscriptionID interface{}) *MockGetSubscriptionByStripeIDRepository_GetSubscriptionByStripeID_Call {
+	return &MockGetSubscriptionByStripeIDRepository_GetSubscriptionByStripeID_Call{Call: _e.mock.On("GetSubscriptionByStripeID", ctx, stripeSubscriptionID)}
+}
+
+func (_c *MockGetSubscriptionByStripeIDRepository_GetSubscriptionByStripeID_Call) Run(run func(ctx context.Context, stripeSubscriptionID string)) *MockGetSubscriptionByStripeIDRepository_GetSubscriptionByStripeID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetSubscriptionByStripeIDRepository_GetSubscriptionByStripeID_Call) Return(_a0 *entities.Subscription, _a1 error) *MockGetSubscriptionByStripeIDRepository_GetSubscriptionByStripeID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetSubscriptionByStripeIDRepository_GetSubscriptionByStripeID_Call) RunAndReturn(run func(context.Context, string) (*entities.Subscription, error)) *MockGetSubscriptionByStripeIDRepository_GetSubscriptionByStripeID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetSubscriptionByStripeIDRepository creates a new instance of MockGetSubscriptionByStripeIDRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetSubscriptionByStripeIDRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetSubscriptionByStripeIDRepository {
+	mock := &MockGetSubscriptionByStripeIDRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
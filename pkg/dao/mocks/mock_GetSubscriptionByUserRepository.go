@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetSubscriptionByUserRepository is an autogenerated mock type for the GetSubscriptionByUserRepository type
+type MockGetSubscriptionByUserRepository struct {
+	mock.Mock
+}
+
+type MockGetSubscriptionByUserRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetSubscriptionByUserRepository) EXPECT() *MockGetSubscriptionByUserRepository_Expecter {
+	return &MockGetSubscriptionByUserRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetSubscriptionByUser provides a mock function with given fields: ctx, user
+func (_m *MockGetSubscriptionByUserRepository) GetSubscriptionByUser(ctx context.Context, user string) (*entities.Subscription, error) {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscriptionByUser")
+	}
+
+	var r0 *entities.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.Subscription, error)); ok {
+		return rf(ctx, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.Subscription); ok {
+		r0 = rf(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetSubscriptionByUserRepository_GetSubscriptionByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscriptionByUser'
+type MockGetSubscriptionByUserRepository_GetSubscriptionByUser_Call struct {
+	*mock.Call
+}
+
+// GetSubscriptionByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+func (_e *MockGetSubscriptionByUserRepository_Expecter) GetSubscriptionByUser(ctx interface{}, user interface{}) *MockGetSubscriptionByUserRepository_GetSubscriptionByUser_Call {
+	return &MockGetSubscriptionByUserRepository_GetSubscriptionByUser_Call{Call: _e.mock.On("GetSubscriptionByUser", ctx, user)}
+}
+
+func (_c *MockGetSubscriptionByUserRepository_GetSubscriptionByUser_Call) Run(run func(ctx context.Context, user string)) *MockGetSubscriptionByUserRepository_GetSubscriptionByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGetSubscriptionByUserRepository_GetSubscriptionByUser_Call) Return(_a0 *entities.Subscription, _a1 error) *MockGetSubscriptionByUserRepository_GetSubscriptionByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetSubscriptionByUserRepository_GetSubscriptionByUser_Call) RunAndReturn(run func(context.Context, string) (*entities.Subscription, error)) *MockGetSubscriptionByUserRepository_GetSubscriptionByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetSubscriptionByUserRepository creates a new instance of MockGetSubscriptionByUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetSubscriptionByUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetSubscriptionByUserRepository {
+	mock := &MockGetSubscriptionByUserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
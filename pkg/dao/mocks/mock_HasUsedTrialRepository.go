@@ -0,0 +1,93 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockHasUsedTrialRepository is an autogenerated mock type for the HasUsedTrialRepository type
+type MockHasUsedTrialRepository struct {
+	mock.Mock
+}
+
+type MockHasUsedTrialRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHasUsedTrialRepository) EXPECT() *MockHasUsedTrialRepository_Expecter {
+	return &MockHasUsedTrialRepository_Expecter{mock: &_m.Mock}
+}
+
+// HasUsedTrial provides a mock function with given fields: ctx, user
+func (_m *MockHasUsedTrialRepository) HasUsedTrial(ctx context.Context, user string) (bool, error) {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasUsedTrial")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHasUsedTrialRepository_HasUsedTrial_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasUsedTrial'
+type MockHasUsedTrialRepository_HasUsedTrial_Call struct {
+	*mock.Call
+}
+
+// HasUsedTrial is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+func (_e *MockHasUsedTrialRepository_Expecter) HasUsedTrial(ctx interface{}, user interface{}) *MockHasUsedTrialRepository_HasUsedTrial_Call {
+	return &MockHasUsedTrialRepository_HasUsedTrial_Call{Call: _e.mock.On("HasUsedTrial", ctx, user)}
+}
+
+func (_c *MockHasUsedTrialRepository_HasUsedTrial_Call) Run(run func(ctx context.Context, user string)) *MockHasUsedTrialRepository_HasUsedTrial_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockHasUsedTrialRepository_HasUsedTrial_Call) Return(_a0 bool, _a1 error) *MockHasUsedTrialRepository_HasUsedTrial_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHasUsedTrialRepository_HasUsedTrial_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockHasUsedTrialRepository_HasUsedTrial_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHasUsedTrialRepository creates a new instance of MockHasUsedTrialRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHasUsedTrialRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHasUsedTrialRepository {
+	mock := &MockHasUsedTrialRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
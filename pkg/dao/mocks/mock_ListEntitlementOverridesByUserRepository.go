@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListEntitlementOverridesByUserRepository is an autogenerated mock type for the ListEntitlementOverridesByUserRepository type
+type MockListEntitlementOverridesByUserRepository struct {
+	mock.Mock
+}
+
+type MockListEntitlementOverridesByUserRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListEntitlementOverridesByUserRepository) EXPECT() *MockListEntitlementOverridesByUserRepository_Expecter {
+	return &MockListEntitlementOverridesByUserRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListEntitlementOverridesByUser provides a mock function with given fields: ctx, user
+func (_m *MockListEntitlementOverridesByUserRepository) ListEntitlementOverridesByUser(ctx context.Context, user string) ([]*entities.EntitlementOverride, error) {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntitlementOverridesByUser")
+	}
+
+	var r0 []*entities.EntitlementOverride
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entities.EntitlementOverride, error)); ok {
+		return rf(ctx, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entities.EntitlementOverride); ok {
+		r0 = rf(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.EntitlementOverride)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListEntitlementOverridesByUserRepository_ListEntitlementOverridesByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntitlementOverridesByUser'
+type MockListEntitlementOverridesByUserRepository_ListEntitlementOverridesByUser_Call struct {
+	*mock.Call
+}
+
+// ListEntitlementOverridesByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+func (_e *MockListEntitlementOverridesByUserRepository_Expecter) ListEntitlementOverridesByUser(ctx interface{}, user interface{}) *MockListEntitlementOverridesByUserRepository_ListEntitlementOverridesByUser_Call {
+	return &MockListEntitlementOverridesByUserRepository_ListEntitlementOverridesByUser_Call{Call: _e.mock.On("ListEntitlementOverridesByUser", ctx, user)}
+}
+
+func (_c *MockListEntitlementOverridesByUserRepository_ListEntitlementOverridesByUser_Call) Run(run func(ctx context.Context, user string)) *MockListEntitlementOverridesByUserRepository_ListEntitlementOverridesByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockListEntitlementOverridesByUserRepository_ListEntitlementOverridesByUser_Call) Return(_a0 []*entities.EntitlementOverride, _a1 error) *MockListEntitlementOverridesByUserRepository_ListEntitlementOverridesByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListEntitlementOverridesByUserRepository_ListEntitlementOverridesByUser_Call) RunAndReturn(run func(context.Context, string) ([]*entities.EntitlementOverride, error)) *MockListEntitlementOverridesByUserRepository_ListEntitlementOverridesByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListEntitlementOverridesByUserRepository creates a new instance of MockListEntitlementOverridesByUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListEntitlementOverridesByUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListEntitlementOverridesByUserRepository {
+	mock := &MockListEntitlementOverridesByUserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,102 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-subscription/pkg/dao"
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockListLatestNoteEditsByAuthorRepository is an autogenerated mock type for the ListLatestNoteEditsByAuthorRepository type
+type MockListLatestNoteEditsByAuthorRepository struct {
+	mock.Mock
+}
+
+type MockListLatestNoteEditsByAuthorRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListLatestNoteEditsByAuthorRepository) EXPECT() *MockListLatestNoteEditsByAuthorRepository_Expecter {
+	return &MockListLatestNoteEditsByAuthorRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListLatestNoteEditsByAuthor provides a mock function with given fields: ctx, author, notes, now
+func (_m *MockListLatestNoteEditsByAuthorRepository) ListLatestNoteEditsByAuthor(ctx context.Context, author string, notes []dao.NoteKey, now time.Time) ([]*entities.NoteEdit, error) {
+	ret := _m.Called(ctx, author, notes, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLatestNoteEditsByAuthor")
+	}
+
+	var r0 []*entities.NoteEdit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []dao.NoteKey, time.Time) ([]*entities.NoteEdit, error)); ok {
+		return rf(ctx, author, notes, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []dao.NoteKey, time.Time) []*entities.NoteEdit); ok {
+		r0 = rf(ctx, author, notes, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.NoteEdit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []dao.NoteKey, time.Time) error); ok {
+		r1 = rf(ctx, author, notes, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListLatestNoteEditsByAuthorRepository_ListLatestNoteEditsByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLatestNoteEditsByAuthor'
+type MockListLatestNoteEditsByAuthorRepository_ListLatestNoteEditsByAuthor_Call struct {
+	*mock.Call
+}
+
+// ListLatestNoteEditsByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+//   - notes []dao.NoteKey
+//   - now time.Time
+func (_e *MockListLatestNoteEditsByAuthorRepository_Expecter) ListLatestNoteEditsByAuthor(ctx interface{}, author interface{}, notes interface{}, now interface{}) *MockListLatestNoteEditsByAuthorRepository_ListLatestNoteEditsByAuthor_Call {
+	return &MockListLatestNoteEditsByAuthorRepository_ListLatestNoteEditsByAuthor_Call{Call: _e.mock.On("ListLatestNoteEditsByAuthor", ctx, author, notes, now)}
+}
+
+func (_c *MockListLatestNoteEditsByAuthorRepository_ListLatestNoteEditsByAuthor_Call) Run(run func(ctx context.Context, author string, notes []dao.NoteKey, now time.Time)) *MockListLatestNoteEditsByAuthorRepository_ListLatestNoteEditsByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]dao.NoteKey), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockListLatestNoteEditsByAuthorRepository_ListLatestNoteEditsByAuthor_Call) Return(_a0 []*entities.NoteEdit, _a1 error) *MockListLatestNoteEditsByAuthorRepository_ListLatestNoteEditsByAuthor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListLatestNoteEditsByAuthorRepository_ListLatestNoteEditsByAuthor_Call) RunAndReturn(run func(context.Context, string, []dao.NoteKey, time.Time) ([]*entities.NoteEdit, error)) *MockListLatestNoteEditsByAuthorRepository_ListLatestNoteEditsByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListLatestNoteEditsByAuthorRepository creates a new instance of MockListLatestNoteEditsByAuthorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListLatestNoteEditsByAuthorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListLatestNoteEditsByAuthorRepository {
+	mock := &MockListLatestNoteEditsByAuthorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
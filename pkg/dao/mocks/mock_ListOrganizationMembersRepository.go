@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockListOrganizationMembersRepository is an autogenerated mock type for the ListOrganizationMembersRepository type
+type MockListOrganizationMembersRepository struct {
+	mock.Mock
+}
+
+type MockListOrganizationMembersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListOrganizationMembersRepository) EXPECT() *MockListOrganizationMembersRepository_Expecter {
+	return &MockListOrganizationMembersRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListOrganizationMembers provides a mock function with given fields: ctx, organization
+func (_m *MockListOrganizationMembersRepository) ListOrganizationMembers(ctx context.Context, organization uuid.UUID) ([]*entities.OrganizationMember, error) {
+	ret := _m.Called(ctx, organization)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizationMembers")
+	}
+
+	var r0 []*entities.OrganizationMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entities.OrganizationMember, error)); ok {
+		return rf(ctx, organization)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entities.OrganizationMember); ok {
+		r0 = rf(ctx, organization)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrganizationMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organization)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListOrganizationMembersRepository_ListOrganizationMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizationMembers'
+type MockListOrganizationMembersRepository_ListOrganizationMembers_Call struct {
+	*mock.Call
+}
+
+// ListOrganizationMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organization uuid.UUID
+func (_e *MockListOrganizationMembersRepository_Expecter) ListOrganizationMembers(ctx interface{}, organization interface{}) *MockListOrganizationMembersRepository_ListOrganizationMembers_Call {
+	return &MockListOrganizationMembersRepository_ListOrganizationMembers_Call{Call: _e.mock.On("ListOrganizationMembers", ctx, organization)}
+}
+
+func (_c *MockListOrganizationMembersRepository_ListOrganizationMembers_Call) Run(run func(ctx context.Context, organization uuid.UUID)) *MockListOrganizationMembersRepository_ListOrganizationMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListOrganizationMembersRepository_ListOrganizationMembers_Call) Return(_a0 []*entities.OrganizationMember, _a1 error) *MockListOrganizationMembersRepository_ListOrganizationMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListOrganizationMembersRepository_ListOrganizationMembers_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entities.OrganizationMember, error)) *MockListOrganizationMembersRepository_ListOrganizationMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListOrganizationMembersRepository creates a new instance of MockListOrganizationMembersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListOrganizationMembersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListOrganizationMembersRepository {
+	mock := &MockListOrganizationMembersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListPendingOutboxEventsRepository is an autogenerated mock type for the ListPendingOutboxEventsRepository type
+type MockListPendingOutboxEventsRepository struct {
+	mock.Mock
+}
+
+type MockListPendingOutboxEventsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListPendingOutboxEventsRepository) EXPECT() *MockListPendingOutboxEventsRepository_Expecter {
+	return &MockListPendingOutboxEventsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListPendingOutboxEvents provides a mock function with given fields: ctx, limit
+func (_m *MockListPendingOutboxEventsRepository) ListPendingOutboxEvents(ctx context.Context, limit int) ([]*entities.OutboxEvent, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingOutboxEvents")
+	}
+
+	var r0 []*entities.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entities.OutboxEvent, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entities.OutboxEvent); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListPendingOutboxEventsRepository_ListPendingOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingOutboxEvents'
+type MockListPendingOutboxEventsRepository_ListPendingOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// ListPendingOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockListPendingOutboxEventsRepository_Expecter) ListPendingOutboxEvents(ctx interface{}, limit interface{}) *MockListPendingOutboxEventsRepository_ListPendingOutboxEvents_Call {
+	return &MockListPendingOutboxEventsRepository_ListPendingOutboxEvents_Call{Call: _e.mock.On("ListPendingOutboxEvents", ctx, limit)}
+}
+
+func (_c *MockListPendingOutboxEventsRepository_ListPendingOutboxEvents_Call) Run(run func(ctx context.Context, limit int)) *MockListPendingOutboxEventsRepository_ListPendingOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockListPendingOutboxEventsRepository_ListPendingOutboxEvents_Call) Return(_a0 []*entities.OutboxEvent, _a1 error) *MockListPendingOutboxEventsRepository_ListPendingOutboxEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListPendingOutboxEventsRepository_ListPendingOutboxEvents_Call) RunAndReturn(run func(context.Context, int) ([]*entities.OutboxEvent, error)) *MockListPendingOutboxEventsRepository_ListPendingOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListPendingOutboxEventsRepository creates a new instance of MockListPendingOutboxEventsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListPendingOutboxEventsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListPendingOutboxEventsRepository {
+	mock := &MockListPendingOutboxEventsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLockNoteEditsByAuthorRepository is an autogenerated mock type for the LockNoteEditsByAuthorRepository type
+type MockLockNoteEditsByAuthorRepository struct {
+	mock.Mock
+}
+
+type MockLockNoteEditsByAuthorRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLockNoteEditsByAuthorRepository) EXPECT() *MockLockNoteEditsByAuthorRepository_Expecter {
+	return &MockLockNoteEditsByAuthorRepository_Expecter{mock: &_m.Mock}
+}
+
+// LockNoteEditsByAuthor provides a mock function with given fields: ctx, author
+func (_m *MockLockNoteEditsByAuthorRepository) LockNoteEditsByAuthor(ctx context.Context, author string) error {
+	ret := _m.Called(ctx, author)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockNoteEditsByAuthor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, author)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLockNoteEditsByAuthorRepository_LockNoteEditsByAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockNoteEditsByAuthor'
+type MockLockNoteEditsByAuthorRepository_LockNoteEditsByAuthor_Call struct {
+	*mock.Call
+}
+
+// LockNoteEditsByAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+func (_e *MockLockNoteEditsByAuthorRepository_Expecter) LockNoteEditsByAuthor(ctx interface{}, author interface{}) *MockLockNoteEditsByAuthorRepository_LockNoteEditsByAuthor_Call {
+	return &MockLockNoteEditsByAuthorRepository_LockNoteEditsByAuthor_Call{Call: _e.mock.On("LockNoteEditsByAuthor", ctx, author)}
+}
+
+func (_c *MockLockNoteEditsByAuthorRepository_LockNoteEditsByAuthor_Call) Run(run func(ctx context.Context, author string)) *MockLockNoteEditsByAuthorRepository_LockNoteEditsByAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLockNoteEditsByAuthorRepository_LockNoteEditsByAuthor_Call) Return(_a0 error) *MockLockNoteEditsByAuthorRepository_LockNoteEditsByAuthor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLockNoteEditsByAuthorRepository_LockNoteEditsByAuthor_Call) RunAndReturn(run func(context.Context, string) error) *MockLockNoteEditsByAuthorRepository_LockNoteEditsByAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLockNoteEditsByAuthorRepository creates a new instance of MockLockNoteEditsByAuthorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLockNoteEditsByAuthorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLockNoteEditsByAuthorRepository {
+	mock := &MockLockNoteEditsByAuthorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
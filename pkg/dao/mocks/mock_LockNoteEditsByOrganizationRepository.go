@@ -0,0 +1,85 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockLockNoteEditsByOrganizationRepository is an autogenerated mock type for the LockNoteEditsByOrganizationRepository type
+type MockLockNoteEditsByOrganizationRepository struct {
+	mock.Mock
+}
+
+type MockLockNoteEditsByOrganizationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLockNoteEditsByOrganizationRepository) EXPECT() *MockLockNoteEditsByOrganizationRepository_Expecter {
+	return &MockLockNoteEditsByOrganizationRepository_Expecter{mock: &_m.Mock}
+}
+
+// LockNoteEditsByOrganization provides a mock function with given fields: ctx, organization
+func (_m *MockLockNoteEditsByOrganizationRepository) LockNoteEditsByOrganization(ctx context.Context, organization uuid.UUID) error {
+	ret := _m.Called(ctx, organization)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockNoteEditsByOrganization")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, organization)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLockNoteEditsByOrganizationRepository_LockNoteEditsByOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockNoteEditsByOrganization'
+type MockLockNoteEditsByOrganizationRepository_LockNoteEditsByOrganization_Call struct {
+	*mock.Call
+}
+
+// LockNoteEditsByOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organization uuid.UUID
+func (_e *MockLockNoteEditsByOrganizationRepository_Expecter) LockNoteEditsByOrganization(ctx interface{}, organization interface{}) *MockLockNoteEditsByOrganizationRepository_LockNoteEditsByOrganization_Call {
+	return &MockLockNoteEditsByOrganizationRepository_LockNoteEditsByOrganization_Call{Call: _e.mock.On("LockNoteEditsByOrganization", ctx, organization)}
+}
+
+func (_c *MockLockNoteEditsByOrganizationRepository_LockNoteEditsByOrganization_Call) Run(run func(ctx context.Context, organization uuid.UUID)) *MockLockNoteEditsByOrganizationRepository_LockNoteEditsByOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockLockNoteEditsByOrganizationRepository_LockNoteEditsByOrganization_Call) Return(_a0 error) *MockLockNoteEditsByOrganizationRepository_LockNoteEditsByOrganization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLockNoteEditsByOrganizationRepository_LockNoteEditsByOrganization_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockLockNoteEditsByOrganizationRepository_LockNoteEditsByOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLockNoteEditsByOrganizationRepository creates a new instance of MockLockNoteEditsByOrganizationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLockNoteEditsByOrganizationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLockNoteEditsByOrganizationRepository {
+	mock := &MockLockNoteEditsByOrganizationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
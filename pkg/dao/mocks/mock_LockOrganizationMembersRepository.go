@@ -0,0 +1,85 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockLockOrganizationMembersRepository is an autogenerated mock type for the LockOrganizationMembersRepository type
+type MockLockOrganizationMembersRepository struct {
+	mock.Mock
+}
+
+type MockLockOrganizationMembersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLockOrganizationMembersRepository) EXPECT() *MockLockOrganizationMembersRepository_Expecter {
+	return &MockLockOrganizationMembersRepository_Expecter{mock: &_m.Mock}
+}
+
+// LockOrganizationMembers provides a mock function with given fields: ctx, organization
+func (_m *MockLockOrganizationMembersRepository) LockOrganizationMembers(ctx context.Context, organization uuid.UUID) error {
+	ret := _m.Called(ctx, organization)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockOrganizationMembers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, organization)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLockOrganizationMembersRepository_LockOrganizationMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockOrganizationMembers'
+type MockLockOrganizationMembersRepository_LockOrganizationMembers_Call struct {
+	*mock.Call
+}
+
+// LockOrganizationMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organization uuid.UUID
+func (_e *MockLockOrganizationMembersRepository_Expecter) LockOrganizationMembers(ctx interface{}, organization interface{}) *MockLockOrganizationMembersRepository_LockOrganizationMembers_Call {
+	return &MockLockOrganizationMembersRepository_LockOrganizationMembers_Call{Call: _e.mock.On("LockOrganizationMembers", ctx, organization)}
+}
+
+func (_c *MockLockOrganizationMembersRepository_LockOrganizationMembers_Call) Run(run func(ctx context.Context, organization uuid.UUID)) *MockLockOrganizationMembersRepository_LockOrganizationMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockLockOrganizationMembersRepository_LockOrganizationMembers_Call) Return(_a0 error) *MockLockOrganizationMembersRepository_LockOrganizationMembers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLockOrganizationMembersRepository_LockOrganizationMembers_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockLockOrganizationMembersRepository_LockOrganizationMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLockOrganizationMembersRepository creates a new instance of MockLockOrganizationMembersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLockOrganizationMembersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLockOrganizationMembersRepository {
+	mock := &MockLockOrganizationMembersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
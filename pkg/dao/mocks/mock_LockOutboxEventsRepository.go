@@ -0,0 +1,82 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLockOutboxEventsRepository is an autogenerated mock type for the LockOutboxEventsRepository type
+type MockLockOutboxEventsRepository struct {
+	mock.Mock
+}
+
+type MockLockOutboxEventsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLockOutboxEventsRepository) EXPECT() *MockLockOutboxEventsRepository_Expecter {
+	return &MockLockOutboxEventsRepository_Expecter{mock: &_m.Mock}
+}
+
+// LockOutboxEvents provides a mock function with given fields: ctx
+func (_m *MockLockOutboxEventsRepository) LockOutboxEvents(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockOutboxEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLockOutboxEventsRepository_LockOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockOutboxEvents'
+type MockLockOutboxEventsRepository_LockOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// LockOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockLockOutboxEventsRepository_Expecter) LockOutboxEvents(ctx interface{}) *MockLockOutboxEventsRepository_LockOutboxEvents_Call {
+	return &MockLockOutboxEventsRepository_LockOutboxEvents_Call{Call: _e.mock.On("LockOutboxEvents", ctx)}
+}
+
+func (_c *MockLockOutboxEventsRepository_LockOutboxEvents_Call) Run(run func(ctx context.Context)) *MockLockOutboxEventsRepository_LockOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockLockOutboxEventsRepository_LockOutboxEvents_Call) Return(_a0 error) *MockLockOutboxEventsRepository_LockOutboxEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLockOutboxEventsRepository_LockOutboxEvents_Call) RunAndReturn(run func(context.Context) error) *MockLockOutboxEventsRepository_LockOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLockOutboxEventsRepository creates a new instance of MockLockOutboxEventsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLockOutboxEventsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLockOutboxEventsRepository {
+	mock := &MockLockOutboxEventsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLockSubscriptionsByUserRepository is an autogenerated mock type for the LockSubscriptionsByUserRepository type
+type MockLockSubscriptionsByUserRepository struct {
+	mock.Mock
+}
+
+type MockLockSubscriptionsByUserRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLockSubscriptionsByUserRepository) EXPECT() *MockLockSubscriptionsByUserRepository_Expecter {
+	return &MockLockSubscriptionsByUserRepository_Expecter{mock: &_m.Mock}
+}
+
+// LockSubscriptionsByUser provides a mock function with given fields: ctx, user
+func (_m *MockLockSubscriptionsByUserRepository) LockSubscriptionsByUser(ctx context.Context, user string) error {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockSubscriptionsByUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLockSubscriptionsByUserRepository_LockSubscriptionsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockSubscriptionsByUser'
+type MockLockSubscriptionsByUserRepository_LockSubscriptionsByUser_Call struct {
+	*mock.Call
+}
+
+// LockSubscriptionsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+func (_e *MockLockSubscriptionsByUserRepository_Expecter) LockSubscriptionsByUser(ctx interface{}, user interface{}) *MockLockSubscriptionsByUserRepository_LockSubscriptionsByUser_Call {
+	return &MockLockSubscriptionsByUserRepository_LockSubscriptionsByUser_Call{Call: _e.mock.On("LockSubscriptionsByUser", ctx, user)}
+}
+
+func (_c *MockLockSubscriptionsByUserRepository_LockSubscriptionsByUser_Call) Run(run func(ctx context.Context, user string)) *MockLockSubscriptionsByUserRepository_LockSubscriptionsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLockSubscriptionsByUserRepository_LockSubscriptionsByUser_Call) Return(_a0 error) *MockLockSubscriptionsByUserRepository_LockSubscriptionsByUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLockSubscriptionsByUserRepository_LockSubscriptionsByUser_Call) RunAndReturn(run func(context.Context, string) error) *MockLockSubscriptionsByUserRepository_LockSubscriptionsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLockSubscriptionsByUserRepository creates a new instance of MockLockSubscriptionsByUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLockSubscriptionsByUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLockSubscriptionsByUserRepository {
+	mock := &MockLockSubscriptionsByUserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
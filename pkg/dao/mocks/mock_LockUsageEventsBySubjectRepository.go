@@ -0,0 +1,84 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLockUsageEventsBySubjectRepository is an autogenerated mock type for the LockUsageEventsBySubjectRepository type
+type MockLockUsageEventsBySubjectRepository struct {
+	mock.Mock
+}
+
+type MockLockUsageEventsBySubjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLockUsageEventsBySubjectRepository) EXPECT() *MockLockUsageEventsBySubjectRepository_Expecter {
+	return &MockLockUsageEventsBySubjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// LockUsageEventsBySubject provides a mock function with given fields: ctx, feature, subject
+func (_m *MockLockUsageEventsBySubjectRepository) LockUsageEventsBySubject(ctx context.Context, feature string, subject string) error {
+	ret := _m.Called(ctx, feature, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockUsageEventsBySubject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, feature, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLockUsageEventsBySubjectRepository_LockUsageEventsBySubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockUsageEventsBySubject'
+type MockLockUsageEventsBySubjectRepository_LockUsageEventsBySubject_Call struct {
+	*mock.Call
+}
+
+// LockUsageEventsBySubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - feature string
+//   - subject string
+func (_e *MockLockUsageEventsBySubjectRepository_Expecter) LockUsageEventsBySubject(ctx interface{}, feature interface{}, subject interface{}) *MockLockUsageEventsBySubjectRepository_LockUsageEventsBySubject_Call {
+	return &MockLockUsageEventsBySubjectRepository_LockUsageEventsBySubject_Call{Call: _e.mock.On("LockUsageEventsBySubject", ctx, feature, subject)}
+}
+
+func (_c *MockLockUsageEventsBySubjectRepository_LockUsageEventsBySubject_Call) Run(run func(ctx context.Context, feature string, subject string)) *MockLockUsageEventsBySubjectRepository_LockUsageEventsBySubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockLockUsageEventsBySubjectRepository_LockUsageEventsBySubject_Call) Return(_a0 error) *MockLockUsageEventsBySubjectRepository_LockUsageEventsBySubject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLockUsageEventsBySubjectRepository_LockUsageEventsBySubject_Call) RunAndReturn(run func(context.Context, string, string) error) *MockLockUsageEventsBySubjectRepository_LockUsageEventsBySubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLockUsageEventsBySubjectRepository creates a new instance of MockLockUsageEventsBySubjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLockUsageEventsBySubjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLockUsageEventsBySubjectRepository {
+	mock := &MockLockUsageEventsBySubjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
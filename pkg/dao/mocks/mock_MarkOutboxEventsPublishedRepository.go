@@ -0,0 +1,88 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockMarkOutboxEventsPublishedRepository is an autogenerated mock type for the MarkOutboxEventsPublishedRepository type
+type MockMarkOutboxEventsPublishedRepository struct {
+	mock.Mock
+}
+
+type MockMarkOutboxEventsPublishedRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMarkOutboxEventsPublishedRepository) EXPECT() *MockMarkOutboxEventsPublishedRepository_Expecter {
+	return &MockMarkOutboxEventsPublishedRepository_Expecter{mock: &_m.Mock}
+}
+
+// MarkOutboxEventsPublished provides a mock function with given fields: ctx, ids, at
+func (_m *MockMarkOutboxEventsPublishedRepository) MarkOutboxEventsPublished(ctx context.Context, ids []uuid.UUID, at time.Time) error {
+	ret := _m.Called(ctx, ids, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventsPublished")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, ids, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMarkOutboxEventsPublishedRepository_MarkOutboxEventsPublished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventsPublished'
+type MockMarkOutboxEventsPublishedRepository_MarkOutboxEventsPublished_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventsPublished is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+//   - at time.Time
+func (_e *MockMarkOutboxEventsPublishedRepository_Expecter) MarkOutboxEventsPublished(ctx interface{}, ids interface{}, at interface{}) *MockMarkOutboxEventsPublishedRepository_MarkOutboxEventsPublished_Call {
+	return &MockMarkOutboxEventsPublishedRepository_MarkOutboxEventsPublished_Call{Call: _e.mock.On("MarkOutboxEventsPublished", ctx, ids, at)}
+}
+
+func (_c *MockMarkOutboxEventsPublishedRepository_MarkOutboxEventsPublished_Call) Run(run func(ctx context.Context, ids []uuid.UUID, at time.Time)) *MockMarkOutboxEventsPublishedRepository_MarkOutboxEventsPublished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMarkOutboxEventsPublishedRepository_MarkOutboxEventsPublished_Call) Return(_a0 error) *MockMarkOutboxEventsPublishedRepository_MarkOutboxEventsPublished_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMarkOutboxEventsPublishedRepository_MarkOutboxEventsPublished_Call) RunAndReturn(run func(context.Context, []uuid.UUID, time.Time) error) *MockMarkOutboxEventsPublishedRepository_MarkOutboxEventsPublished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMarkOutboxEventsPublishedRepository creates a new instance of MockMarkOutboxEventsPublishedRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMarkOutboxEventsPublishedRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMarkOutboxEventsPublishedRepository {
+	mock := &MockMarkOutboxEventsPublishedRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRunInTransactionRepository is an autogenerated mock type for the RunInTransactionRepository type
+type MockRunInTransactionRepository struct {
+	mock.Mock
+}
+
+type MockRunInTransactionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRunInTransactionRepository) EXPECT() *MockRunInTransactionRepository_Expecter {
+	return &MockRunInTransactionRepository_Expecter{mock: &_m.Mock}
+}
+
+// RunInTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockRunInTransactionRepository) RunInTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunInTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRunInTransactionRepository_RunInTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunInTransaction'
+type MockRunInTransactionRepository_RunInTransaction_Call struct {
+	*mock.Call
+}
+
+// RunInTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockRunInTransactionRepository_Expecter) RunInTransaction(ctx interface{}, fn interface{}) *MockRunInTransactionRepository_RunInTransaction_Call {
+	return &MockRunInTransactionRepository_RunInTransaction_Call{Call: _e.mock.On("RunInTransaction", ctx, fn)}
+}
+
+func (_c *MockRunInTransactionRepository_RunInTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockRunInTransactionRepository_RunInTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockRunInTransactionRepository_RunInTransaction_Call) Return(_a0 error) *MockRunInTransactionRepository_RunInTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRunInTransactionRepository_RunInTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockRunInTransactionRepository_RunInTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRunInTransactionRepository creates a new instance of MockRunInTransactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRunInTransactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRunInTransactionRepository {
+	mock := &MockRunInTransactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
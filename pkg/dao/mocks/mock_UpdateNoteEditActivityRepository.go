@@ -0,0 +1,102 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockUpdateNoteEditActivityRepository is an autogenerated mock type for the UpdateNoteEditActivityRepository type
+type MockUpdateNoteEditActivityRepository struct {
+	mock.Mock
+}
+
+type MockUpdateNoteEditActivityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateNoteEditActivityRepository) EXPECT() *MockUpdateNoteEditActivityRepository_Expecter {
+	return &MockUpdateNoteEditActivityRepository_Expecter{mock: &_m.Mock}
+}
+
+// UpdateNoteEditActivity provides a mock function with given fields: ctx, id, at
+func (_m *MockUpdateNoteEditActivityRepository) UpdateNoteEditActivity(ctx context.Context, id uuid.UUID, at time.Time) (*entities.NoteEdit, error) {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNoteEditActivity")
+	}
+
+	var r0 *entities.NoteEdit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (*entities.NoteEdit, error)); ok {
+		return rf(ctx, id, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *entities.NoteEdit); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.NoteEdit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, id, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateNoteEditActivityRepository_UpdateNoteEditActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNoteEditActivity'
+type MockUpdateNoteEditActivityRepository_UpdateNoteEditActivity_Call struct {
+	*mock.Call
+}
+
+// UpdateNoteEditActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - at time.Time
+func (_e *MockUpdateNoteEditActivityRepository_Expecter) UpdateNoteEditActivity(ctx interface{}, id interface{}, at interface{}) *MockUpdateNoteEditActivityRepository_UpdateNoteEditActivity_Call {
+	return &MockUpdateNoteEditActivityRepository_UpdateNoteEditActivity_Call{Call: _e.mock.On("UpdateNoteEditActivity", ctx, id, at)}
+}
+
+func (_c *MockUpdateNoteEditActivityRepository_UpdateNoteEditActivity_Call) Run(run func(ctx context.Context, id uuid.UUID, at time.Time)) *MockUpdateNoteEditActivityRepository_UpdateNoteEditActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUpdateNoteEditActivityRepository_UpdateNoteEditActivity_Call) Return(_a0 *entities.NoteEdit, _a1 error) *MockUpdateNoteEditActivityRepository_UpdateNoteEditActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateNoteEditActivityRepository_UpdateNoteEditActivity_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) (*entities.NoteEdit, error)) *MockUpdateNoteEditActivityRepository_UpdateNoteEditActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateNoteEditActivityRepository creates a new instance of MockUpdateNoteEditActivityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateNoteEditActivityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateNoteEditActivityRepository {
+	mock := &MockUpdateNoteEditActivityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-subscription/pkg/dao"
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockUpdateSubscriptionRepository is an autogenerated mock type for the UpdateSubscriptionRepository type
+type MockUpdateSubscriptionRepository struct {
+	mock.Mock
+}
+
+type MockUpdateSubscriptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdateSubscriptionRepository) EXPECT() *MockUpdateSubscriptionRepository_Expecter {
+	return &MockUpdateSubscriptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// UpdateSubscription provides a mock function with given fields: ctx, id, data
+func (_m *MockUpdateSubscriptionRepository) UpdateSubscription(ctx context.Context, id uuid.UUID, data *dao.UpdateSubscriptionData) (*entities.Subscription, error) {
+	ret := _m.Called(ctx, id, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubscription")
+	}
+
+	var r0 *entities.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dao.UpdateSubscriptionData) (*entities.Subscription, error)); ok {
+		return rf(ctx, id, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *dao.UpdateSubscriptionData) *entities.Subscription); ok {
+		r0 = rf(ctx, id, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *dao.UpdateSubscriptionData) error); ok {
+		r1 = rf(ctx, id, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpdateSubscriptionRepository_UpdateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubscription'
+type MockUpdateSubscriptionRepository_UpdateSubscription_Call struct {
+	*mock.Call
+}
+
+// UpdateSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - data *dao.UpdateSubscriptionData
+func (_e *MockUpdateSubscriptionRepository_Expecter) UpdateSubscription(ctx interface{}, id interface{}, data interface{}) *MockUpdateSubscriptionRepository_UpdateSubscription_Call {
+	return &MockUpdateSubscriptionRepository_UpdateSubscription_Call{Call: _e.mock.On("UpdateSubscription", ctx, id, data)}
+}
+
+func (_c *MockUpdateSubscriptionRepository_UpdateSubscription_Call) Run(run func(ctx context.Context, id uuid.UUID, data *dao.UpdateSubscriptionData)) *MockUpdateSubscriptionRepository_UpdateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*dao.UpdateSubscriptionData))
+	})
+	return _c
+}
+
+func (_c *MockUpdateSubscriptionRepository_UpdateSubscription_Call) Return(_a0 *entities.Subscription, _a1 error) *MockUpdateSubscriptionRepository_UpdateSubscription_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpdateSubscriptionRepository_UpdateSubscription_Call) RunAndReturn(run func(context.Context, uuid.UUID, *dao.UpdateSubscriptionData) (*entities.Subscription, error)) *MockUpdateSubscriptionRepository_UpdateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdateSubscriptionRepository creates a new instance of MockUpdateSubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdateSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateSubscriptionRepository {
+	mock := &MockUpdateSubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-subscription/pkg/dao"
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUpsertEntitlementOverrideRepository is an autogenerated mock type for the UpsertEntitlementOverrideRepository type
+type MockUpsertEntitlementOverrideRepository struct {
+	mock.Mock
+}
+
+type MockUpsertEntitlementOverrideRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpsertEntitlementOverrideRepository) EXPECT() *MockUpsertEntitlementOverrideRepository_Expecter {
+	return &MockUpsertEntitlementOverrideRepository_Expecter{mock: &_m.Mock}
+}
+
+// UpsertEntitlementOverride provides a mock function with given fields: ctx, user, entitlement, data
+func (_m *MockUpsertEntitlementOverrideRepository) UpsertEntitlementOverride(ctx context.Context, user string, entitlement string, data *dao.UpsertEntitlementOverrideData) (*entities.EntitlementOverride, error) {
+	ret := _m.Called(ctx, user, entitlement, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertEntitlementOverride")
+	}
+
+	var r0 *entities.EntitlementOverride
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *dao.UpsertEntitlementOverrideData) (*entities.EntitlementOverride, error)); ok {
+		return rf(ctx, user, entitlement, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *dao.UpsertEntitlementOverrideData) *entities.EntitlementOverride); ok {
+		r0 = rf(ctx, user, entitlement, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.EntitlementOverride)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *dao.UpsertEntitlementOverrideData) error); ok {
+		r1 = rf(ctx, user, entitlement, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpsertEntitlementOverrideRepository_UpsertEntitlementOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertEntitlementOverride'
+type MockUpsertEntitlementOverrideRepository_UpsertEntitlementOverride_Call struct {
+	*mock.Call
+}
+
+// UpsertEntitlementOverride is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+//   - entitlement string
+//   - data *dao.UpsertEntitlementOverrideData
+func (_e *MockUpsertEntitlementOverrideRepository_Expecter) UpsertEntitlementOverride(ctx interface{}, user interface{}, entitlement interface{}, data interface{}) *MockUpsertEntitlementOverrideRepository_UpsertEntitlementOverride_Call {
+	return &MockUpsertEntitlementOverrideRepository_UpsertEntitlementOverride_Call{Call: _e.mock.On("UpsertEntitlementOverride", ctx, user, entitlement, data)}
+}
+
+func (_c *MockUpsertEntitlementOverrideRepository_UpsertEntitlementOverride_Call) Run(run func(ctx context.Context, user string, entitlement string, data *dao.UpsertEntitlementOverrideData)) *MockUpsertEntitlementOverrideRepository_UpsertEntitlementOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*dao.UpsertEntitlementOverrideData))
+	})
+	return _c
+}
+
+func (_c *MockUpsertEntitlementOverrideRepository_UpsertEntitlementOverride_Call) Return(_a0 *entities.EntitlementOverride, _a1 error) *MockUpsertEntitlementOverrideRepository_UpsertEntitlementOverride_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpsertEntitlementOverrideRepository_UpsertEntitlementOverride_Call) RunAndReturn(run func(context.Context, string, string, *dao.UpsertEntitlementOverrideData) (*entities.EntitlementOverride, error)) *MockUpsertEntitlementOverrideRepository_UpsertEntitlementOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpsertEntitlementOverrideRepository creates a new instance of MockUpsertEntitlementOverrideRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpsertEntitlementOverrideRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpsertEntitlementOverrideRepository {
+	mock := &MockUpsertEntitlementOverrideRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,102 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-subscription/pkg/dao"
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockUpsertOrganizationMemberRepository is an autogenerated mock type for the UpsertOrganizationMemberRepository type
+type MockUpsertOrganizationMemberRepository struct {
+	mock.Mock
+}
+
+type MockUpsertOrganizationMemberRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpsertOrganizationMemberRepository) EXPECT() *MockUpsertOrganizationMemberRepository_Expecter {
+	return &MockUpsertOrganizationMemberRepository_Expecter{mock: &_m.Mock}
+}
+
+// UpsertOrganizationMember provides a mock function with given fields: ctx, organization, user, data
+func (_m *MockUpsertOrganizationMemberRepository) UpsertOrganizationMember(ctx context.Context, organization uuid.UUID, user string, data *dao.UpsertOrganizationMemberData) (*entities.OrganizationMember, error) {
+	ret := _m.Called(ctx, organization, user, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertOrganizationMember")
+	}
+
+	var r0 *entities.OrganizationMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *dao.UpsertOrganizationMemberData) (*entities.OrganizationMember, error)); ok {
+		return rf(ctx, organization, user, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *dao.UpsertOrganizationMemberData) *entities.OrganizationMember); ok {
+		r0 = rf(ctx, organization, user, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrganizationMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, *dao.UpsertOrganizationMemberData) error); ok {
+		r1 = rf(ctx, organization, user, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpsertOrganizationMemberRepository_UpsertOrganizationMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertOrganizationMember'
+type MockUpsertOrganizationMemberRepository_UpsertOrganizationMember_Call struct {
+	*mock.Call
+}
+
+// UpsertOrganizationMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organization uuid.UUID
+//   - user string
+//   - data *dao.UpsertOrganizationMemberData
+func (_e *MockUpsertOrganizationMemberRepository_Expecter) UpsertOrganizationMember(ctx interface{}, organization interface{}, user interface{}, data interface{}) *MockUpsertOrganizationMemberRepository_UpsertOrganizationMember_Call {
+	return &MockUpsertOrganizationMemberRepository_UpsertOrganizationMember_Call{Call: _e.mock.On("UpsertOrganizationMember", ctx, organization, user, data)}
+}
+
+func (_c *MockUpsertOrganizationMemberRepository_UpsertOrganizationMember_Call) Run(run func(ctx context.Context, organization uuid.UUID, user string, data *dao.UpsertOrganizationMemberData)) *MockUpsertOrganizationMemberRepository_UpsertOrganizationMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(*dao.UpsertOrganizationMemberData))
+	})
+	return _c
+}
+
+func (_c *MockUpsertOrganizationMemberRepository_UpsertOrganizationMember_Call) Return(_a0 *entities.OrganizationMember, _a1 error) *MockUpsertOrganizationMemberRepository_UpsertOrganizationMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpsertOrganizationMemberRepository_UpsertOrganizationMember_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, *dao.UpsertOrganizationMemberData) (*entities.OrganizationMember, error)) *MockUpsertOrganizationMemberRepository_UpsertOrganizationMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpsertOrganizationMemberRepository creates a new instance of MockUpsertOrganizationMemberRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpsertOrganizationMemberRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpsertOrganizationMemberRepository {
+	mock := &MockUpsertOrganizationMemberRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
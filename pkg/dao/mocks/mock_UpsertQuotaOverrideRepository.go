@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	dao "github.com/in-rich/uservice-subscription/pkg/dao"
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUpsertQuotaOverrideRepository is an autogenerated mock type for the UpsertQuotaOverrideRepository type
+type MockUpsertQuotaOverrideRepository struct {
+	mock.Mock
+}
+
+type MockUpsertQuotaOverrideRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpsertQuotaOverrideRepository) EXPECT() *MockUpsertQuotaOverrideRepository_Expecter {
+	return &MockUpsertQuotaOverrideRepository_Expecter{mock: &_m.Mock}
+}
+
+// UpsertQuotaOverride provides a mock function with given fields: ctx, author, data
+func (_m *MockUpsertQuotaOverrideRepository) UpsertQuotaOverride(ctx context.Context, author string, data *dao.UpsertQuotaOverrideData) (*entities.QuotaOverride, error) {
+	ret := _m.Called(ctx, author, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertQuotaOverride")
+	}
+
+	var r0 *entities.QuotaOverride
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.UpsertQuotaOverrideData) (*entities.QuotaOverride, error)); ok {
+		return rf(ctx, author, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *dao.UpsertQuotaOverrideData) *entities.QuotaOverride); ok {
+		r0 = rf(ctx, author, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.QuotaOverride)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *dao.UpsertQuotaOverrideData) error); ok {
+		r1 = rf(ctx, author, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUpsertQuotaOverrideRepository_UpsertQuotaOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertQuotaOverride'
+type MockUpsertQuotaOverrideRepository_UpsertQuotaOverride_Call struct {
+	*mock.Call
+}
+
+// UpsertQuotaOverride is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+//   - data *dao.UpsertQuotaOverrideData
+func (_e *MockUpsertQuotaOverrideRepository_Expecter) UpsertQuotaOverride(ctx interface{}, author interface{}, data interface{}) *MockUpsertQuotaOverrideRepository_UpsertQuotaOverride_Call {
+	return &MockUpsertQuotaOverrideRepository_UpsertQuotaOverride_Call{Call: _e.mock.On("UpsertQuotaOverride", ctx, author, data)}
+}
+
+func (_c *MockUpsertQuotaOverrideRepository_UpsertQuotaOverride_Call) Run(run func(ctx context.Context, author string, data *dao.UpsertQuotaOverrideData)) *MockUpsertQuotaOverrideRepository_UpsertQuotaOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*dao.UpsertQuotaOverrideData))
+	})
+	return _c
+}
+
+func (_c *MockUpsertQuotaOverrideRepository_UpsertQuotaOverride_Call) Return(_a0 *entities.QuotaOverride, _a1 error) *MockUpsertQuotaOverrideRepository_UpsertQuotaOverride_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUpsertQuotaOverrideRepository_UpsertQuotaOverride_Call) RunAndReturn(run func(context.Context, string, *dao.UpsertQuotaOverrideData) (*entities.QuotaOverride, error)) *MockUpsertQuotaOverrideRepository_UpsertQuotaOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpsertQuotaOverrideRepository creates a new instance of MockUpsertQuotaOverrideRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpsertQuotaOverrideRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpsertQuotaOverrideRepository {
+	mock := &MockUpsertQuotaOverrideRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,103 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package daomocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockVoidNoteEditRepository is an autogenerated mock type for the VoidNoteEditRepository type
+type MockVoidNoteEditRepository struct {
+	mock.Mock
+}
+
+type MockVoidNoteEditRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVoidNoteEditRepository) EXPECT() *MockVoidNoteEditRepository_Expecter {
+	return &MockVoidNoteEditRepository_Expecter{mock: &_m.Mock}
+}
+
+// VoidNoteEdit provides a mock function with given fields: ctx, id, author, now
+func (_m *MockVoidNoteEditRepository) VoidNoteEdit(ctx context.Context, id uuid.UUID, author string, now time.Time) (*entities.NoteEdit, error) {
+	ret := _m.Called(ctx, id, author, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VoidNoteEdit")
+	}
+
+	var r0 *entities.NoteEdit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) (*entities.NoteEdit, error)); ok {
+		return rf(ctx, id, author, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) *entities.NoteEdit); ok {
+		r0 = rf(ctx, id, author, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.NoteEdit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Time) error); ok {
+		r1 = rf(ctx, id, author, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVoidNoteEditRepository_VoidNoteEdit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VoidNoteEdit'
+type MockVoidNoteEditRepository_VoidNoteEdit_Call struct {
+	*mock.Call
+}
+
+// VoidNoteEdit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - author string
+//   - now time.Time
+func (_e *MockVoidNoteEditRepository_Expecter) VoidNoteEdit(ctx interface{}, id interface{}, author interface{}, now interface{}) *MockVoidNoteEditRepository_VoidNoteEdit_Call {
+	return &MockVoidNoteEditRepository_VoidNoteEdit_Call{Call: _e.mock.On("VoidNoteEdit", ctx, id, author, now)}
+}
+
+func (_c *MockVoidNoteEditRepository_VoidNoteEdit_Call) Run(run func(ctx context.Context, id uuid.UUID, author string, now time.Time)) *MockVoidNoteEditRepository_VoidNoteEdit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockVoidNoteEditRepository_VoidNoteEdit_Call) Return(_a0 *entities.NoteEdit, _a1 error) *MockVoidNoteEditRepository_VoidNoteEdit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVoidNoteEditRepository_VoidNoteEdit_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, time.Time) (*entities.NoteEdit, error)) *MockVoidNoteEditRepository_VoidNoteEdit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVoidNoteEditRepository creates a new instance of MockVoidNoteEditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVoidNoteEditRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVoidNoteEditRepository {
+	mock := &MockVoidNoteEditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,25 @@
+package dao_test
+
+import (
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/dao/daotest"
+	"testing"
+)
+
+func TestNoteEditsContract(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	daotest.RunNoteEditsContract(t, func(t *testing.T) *daotest.NoteEditRepositories {
+		tx := BeginTX[interface{}](db, nil)
+		t.Cleanup(func() {
+			RollbackTX(tx)
+		})
+
+		return &daotest.NoteEditRepositories{
+			CountNoteEditsByAuthor:    dao.NewCountNoteEditsByAuthorRepository(tx),
+			CreateNoteEdit:            dao.NewCreateNoteEditRepository(tx),
+			GetLatestNoteEditByAuthor: dao.NewGetLatestNoteEditByAuthorRepository(tx),
+		}
+	})
+}
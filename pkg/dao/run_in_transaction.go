@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"context"
+	"github.com/uptrace/bun"
+)
+
+type transactionContextKey struct{}
+
+// RunInTransactionRepository runs a set of repository calls atomically. Repositories called with the context
+// passed to fn share the same transaction.
+type RunInTransactionRepository interface {
+	RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type runInTransactionRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *runInTransactionRepositoryImpl) RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, span := startSpan(ctx, "RunInTransaction")
+	defer span.End()
+
+	return getDB(ctx, r.db).RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return fn(context.WithValue(ctx, transactionContextKey{}, tx))
+	})
+}
+
+func NewRunInTransactionRepository(db bun.IDB) RunInTransactionRepository {
+	return &runInTransactionRepositoryImpl{
+		db: db,
+	}
+}
+
+// getDB returns the transaction attached to the context, if any. Otherwise, it returns the default database.
+func getDB(ctx context.Context, db bun.IDB) bun.IDB {
+	if tx, ok := ctx.Value(transactionContextKey{}).(bun.Tx); ok {
+		return tx
+	}
+
+	return db
+}
@@ -0,0 +1,66 @@
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRunInTransaction(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		fnErr       error
+		expectCount int
+		expectErr   error
+	}{
+		{
+			name:        "RunInTransaction/Commit",
+			expectCount: 1,
+		},
+		{
+			name:        "RunInTransaction/Rollback",
+			fnErr:       errors.New("foo error"),
+			expectCount: 0,
+		},
+	}
+
+	stx := BeginTX[interface{}](db, nil)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewRunInTransactionRepository(tx)
+			createRepo := dao.NewCreateNoteEditRepository(tx)
+			countRepo := dao.NewCountNoteEditsByAuthorRepository(tx)
+
+			err := repo.RunInTransaction(context.TODO(), func(ctx context.Context) error {
+				_, err := createRepo.CreateNoteEdit(ctx, "author-id-1", &dao.CreateNoteEditData{
+					Target:           entities.TargetUser,
+					PublicIdentifier: "public-identifier-1",
+				})
+				require.NoError(t, err)
+
+				return tt.fnErr
+			})
+
+			require.ErrorIs(t, err, tt.fnErr)
+
+			count, err := countRepo.CountNoteEditsByAuthor(
+				context.TODO(), "author-id-1", lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)), time.Now(),
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectCount, count)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"sync/atomic"
+	"time"
+)
+
+// tracer opens a span around each repository call, under which the queries sent by the repository are recorded.
+var tracer = otel.Tracer("github.com/in-rich/uservice-subscription/pkg/dao")
+
+// repositoryMetrics records the duration of each repository call, once set by SetMetrics.
+var repositoryMetrics atomic.Pointer[metrics.Metrics]
+
+// SetMetrics records the duration of every repository call in the given metrics.
+func SetMetrics(m *metrics.Metrics) {
+	repositoryMetrics.Store(m)
+}
+
+// repositorySpan times the repository call it spans, from its start until it ends, whatever the number of queries
+// the call sends.
+type repositorySpan struct {
+	trace.Span
+
+	method    string
+	startedAt time.Time
+}
+
+func (span *repositorySpan) End(options ...trace.SpanEndOption) {
+	if m := repositoryMetrics.Load(); m != nil {
+		m.ObserveRepositoryCall(span.method, time.Since(span.startedAt))
+	}
+
+	span.Span.End(options...)
+}
+
+// startSpan opens the span of a repository method. Ending the span records the duration of the call for metrics.
+func startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	ctx, span := tracer.Start(ctx, "dao."+method)
+
+	return ctx, &repositorySpan{Span: span, method: method, startedAt: time.Now()}
+}
@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStartSpan(t *testing.T) {
+	m := metrics.NewMetrics(prometheus.NewRegistry())
+
+	SetMetrics(m)
+	defer SetMetrics(nil)
+
+	// The call is recorded once, when its span ends.
+	_, span := startSpan(context.TODO(), "CountNoteEditsByAuthor")
+	span.End()
+
+	recorder := httptest.NewRecorder()
+	m.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `subscription_dao_call_duration_seconds_count{repository="CountNoteEditsByAuthor"} 1`)
+}
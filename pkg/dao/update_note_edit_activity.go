@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// UpdateNoteEditActivityRepository records activity on the edit session started by an edit, so sliding sessions are
+// extended.
+type UpdateNoteEditActivityRepository interface {
+	UpdateNoteEditActivity(ctx context.Context, id uuid.UUID, at time.Time) (*entities.NoteEdit, error)
+}
+
+type updateNoteEditActivityRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *updateNoteEditActivityRepositoryImpl) UpdateNoteEditActivity(
+	ctx context.Context, id uuid.UUID, at time.Time,
+) (*entities.NoteEdit, error) {
+	ctx, span := startSpan(ctx, "UpdateNoteEditActivity")
+	defer span.End()
+
+	noteEdit := new(entities.NoteEdit)
+
+	res, err := getDB(ctx, r.db).NewUpdate().
+		Model(noteEdit).
+		Set("last_activity_at = ?", at).
+		Where("id = ?", id).
+		Returning("*").
+		Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNoNoteEditFound
+	}
+
+	return noteEdit, nil
+}
+
+func NewUpdateNoteEditActivityRepository(db bun.IDB) UpdateNoteEditActivityRepository {
+	return &updateNoteEditActivityRepositoryImpl{
+		db: db,
+	}
+}
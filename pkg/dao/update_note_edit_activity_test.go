@@ -0,0 +1,62 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestUpdateNoteEditActivity(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		id        uuid.UUID
+		at        time.Time
+		expect    *entities.NoteEdit
+		expectErr error
+	}{
+		{
+			name: "UpdateNoteEditActivity",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			at:   time.Date(2021, 1, 3, 0, 30, 0, 0, time.UTC),
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID:         "author-id-1",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+				LastActivityAt:   lo.ToPtr(time.Date(2021, 1, 3, 0, 30, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "UpdateNoteEditActivity/NotFound",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000099"),
+			at:        time.Date(2021, 1, 3, 0, 30, 0, 0, time.UTC),
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+	}
+
+	stx := BeginTX(db, getLatestNoteEditByAuthorFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewUpdateNoteEditActivityRepository(tx)
+			noteEdit, err := repo.UpdateNoteEditActivity(context.TODO(), tt.id, tt.at)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, noteEdit)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type UpdateSubscriptionData struct {
+	Tier   string
+	Status entities.SubscriptionStatus
+	EndsAt *time.Time
+
+	CurrentPeriodStart *time.Time
+	CurrentPeriodEnd   *time.Time
+	CancelAtPeriodEnd  bool
+	CanceledAt         *time.Time
+
+	TrialEndsAt *time.Time
+
+	PaymentFailedAt      *time.Time
+	StripeEventCreatedAt *time.Time
+	StripeEventID        *string
+}
+
+type UpdateSubscriptionRepository interface {
+	UpdateSubscription(ctx context.Context, id uuid.UUID, data *UpdateSubscriptionData) (*entities.Subscription, error)
+}
+
+type updateSubscriptionRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *updateSubscriptionRepositoryImpl) UpdateSubscription(
+	ctx context.Context, id uuid.UUID, data *UpdateSubscriptionData,
+) (*entities.Subscription, error) {
+	ctx, span := startSpan(ctx, "UpdateSubscription")
+	defer span.End()
+
+	subscription := &entities.Subscription{
+		ID:     &id,
+		Tier:   data.Tier,
+		Status: data.Status,
+		EndsAt: data.EndsAt,
+
+		CurrentPeriodStart: data.CurrentPeriodStart,
+		CurrentPeriodEnd:   data.CurrentPeriodEnd,
+		CancelAtPeriodEnd:  data.CancelAtPeriodEnd,
+		CanceledAt:         data.CanceledAt,
+
+		TrialEndsAt: data.TrialEndsAt,
+
+		PaymentFailedAt:      data.PaymentFailedAt,
+		StripeEventCreatedAt: data.StripeEventCreatedAt,
+		StripeEventID:        data.StripeEventID,
+	}
+
+	res, err := getDB(ctx, r.db).NewUpdate().
+		Model(subscription).
+		Column(
+			"tier",
+			"status",
+			"ends_at",
+			"current_period_start",
+			"current_period_end",
+			"cancel_at_period_end",
+			"canceled_at",
+			"trial_ends_at",
+			"payment_failed_at",
+			"stripe_event_created_at",
+		).
+		Set("updated_at = NOW()").
+		WherePK().
+		Returning("*").
+		Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	return subscription, nil
+}
+
+func NewUpdateSubscriptionRepository(db bun.IDB) UpdateSubscriptionRepository {
+	return &updateSubscriptionRepositoryImpl{
+		db: db,
+	}
+}
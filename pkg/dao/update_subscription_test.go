@@ -0,0 +1,100 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var updateSubscriptionFixtures = []*entities.Subscription{
+	{
+		ID:                 lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:             "user-id-1",
+		Tier:               "pro",
+		Status:             entities.SubscriptionStatusActive,
+		StartedAt:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CurrentPeriodStart: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CurrentPeriodEnd:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestUpdateSubscription(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		id        uuid.UUID
+		data      *dao.UpdateSubscriptionData
+		expect    *entities.Subscription
+		expectErr error
+	}{
+		{
+			name: "UpdateSubscription",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			data: &dao.UpdateSubscriptionData{
+				Tier:                 "pro",
+				Status:               entities.SubscriptionStatusActive,
+				EndsAt:               lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodStart:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:     lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CancelAtPeriodEnd:    true,
+				CanceledAt:           lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1"),
+			},
+			expect: &entities.Subscription{
+				ID:                   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:               "user-id-1",
+				Tier:                 "pro",
+				Status:               entities.SubscriptionStatusActive,
+				StartedAt:            lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				EndsAt:               lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodStart:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:     lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CancelAtPeriodEnd:    true,
+				CanceledAt:           lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1"),
+				CreatedAt:            lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "UpdateSubscription/NotFound",
+			id:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			data: &dao.UpdateSubscriptionData{
+				Tier:   "team",
+				Status: entities.SubscriptionStatusActive,
+			},
+			expectErr: dao.ErrSubscriptionNotFound,
+		},
+	}
+
+	stx := BeginTX(db, updateSubscriptionFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewUpdateSubscriptionRepository(tx)
+			subscription, err := repo.UpdateSubscription(context.TODO(), tt.id, tt.data)
+
+			if subscription != nil {
+				// UpdatedAt is set by the database, nullify it for comparison.
+				subscription.UpdatedAt = nil
+			}
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, subscription)
+		})
+	}
+}
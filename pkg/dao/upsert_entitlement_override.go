@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type UpsertEntitlementOverrideData struct {
+	Enabled   bool
+	Limit     *int
+	ExpiresAt *time.Time
+}
+
+type UpsertEntitlementOverrideRepository interface {
+	UpsertEntitlementOverride(
+		ctx context.Context, user string, entitlement string, data *UpsertEntitlementOverrideData,
+	) (*entities.EntitlementOverride, error)
+}
+
+type upsertEntitlementOverrideRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *upsertEntitlementOverrideRepositoryImpl) UpsertEntitlementOverride(
+	ctx context.Context, user string, entitlement string, data *UpsertEntitlementOverrideData,
+) (*entities.EntitlementOverride, error) {
+	ctx, span := startSpan(ctx, "UpsertEntitlementOverride")
+	defer span.End()
+
+	override := &entities.EntitlementOverride{
+		UserID:      user,
+		Entitlement: entitlement,
+		Enabled:     data.Enabled,
+		Limit:       data.Limit,
+		ExpiresAt:   data.ExpiresAt,
+	}
+
+	_, err := getDB(ctx, r.db).NewInsert().
+		Model(override).
+		On("CONFLICT (user_id, entitlement) DO UPDATE").
+		Set("enabled = EXCLUDED.enabled").
+		Set("limit_value = EXCLUDED.limit_value").
+		Set("expires_at = EXCLUDED.expires_at").
+		Set("updated_at = NOW()").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return override, nil
+}
+
+func NewUpsertEntitlementOverrideRepository(db bun.IDB) UpsertEntitlementOverrideRepository {
+	return &upsertEntitlementOverrideRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,95 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var upsertEntitlementOverrideFixtures = []*entities.EntitlementOverride{
+	{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:      "user-id-1",
+		Entitlement: "max-team-seats",
+		Enabled:     true,
+		Limit:       lo.ToPtr(10),
+		ExpiresAt:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestUpsertEntitlementOverride(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name        string
+		userID      string
+		entitlement string
+		data        *dao.UpsertEntitlementOverrideData
+		expect      *entities.EntitlementOverride
+		expectErr   error
+	}{
+		{
+			name:        "UpsertEntitlementOverride/Create",
+			userID:      "user-id-1",
+			entitlement: "can-export-csv",
+			data: &dao.UpsertEntitlementOverrideData{
+				Enabled: true,
+			},
+			expect: &entities.EntitlementOverride{
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Enabled:     true,
+			},
+		},
+		{
+			name:        "UpsertEntitlementOverride/Replace",
+			userID:      "user-id-1",
+			entitlement: "max-team-seats",
+			data: &dao.UpsertEntitlementOverrideData{
+				Enabled: true,
+				Limit:   lo.ToPtr(25),
+			},
+			expect: &entities.EntitlementOverride{
+				// The existing override is updated in place.
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+				Enabled:     true,
+				Limit:       lo.ToPtr(25),
+			},
+		},
+	}
+
+	stx := BeginTX(db, upsertEntitlementOverrideFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewUpsertEntitlementOverrideRepository(tx)
+			override, err := repo.UpsertEntitlementOverride(context.TODO(), tt.userID, tt.entitlement, tt.data)
+
+			if override != nil {
+				// Since new IDs and timestamps are random, nullify them for comparison.
+				if tt.expect != nil && tt.expect.ID == nil {
+					override.ID = nil
+				}
+				override.CreatedAt = nil
+				override.UpdatedAt = nil
+			}
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, override)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+)
+
+type UpsertOrganizationMemberData struct {
+	MaxEdits *int
+}
+
+type UpsertOrganizationMemberRepository interface {
+	// UpsertOrganizationMember adds a user to an organization, or updates their membership if they already belong to
+	// it. It returns ErrMemberOfAnotherOrganization if the user belongs to a different organization.
+	UpsertOrganizationMember(
+		ctx context.Context, organization uuid.UUID, user string, data *UpsertOrganizationMemberData,
+	) (*entities.OrganizationMember, error)
+}
+
+type upsertOrganizationMemberRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *upsertOrganizationMemberRepositoryImpl) UpsertOrganizationMember(
+	ctx context.Context, organization uuid.UUID, user string, data *UpsertOrganizationMemberData,
+) (*entities.OrganizationMember, error) {
+	ctx, span := startSpan(ctx, "UpsertOrganizationMember")
+	defer span.End()
+
+	member := &entities.OrganizationMember{
+		OrganizationID: &organization,
+		UserID:         user,
+		MaxEdits:       data.MaxEdits,
+	}
+
+	res, err := getDB(ctx, r.db).NewInsert().
+		Model(member).
+		On("CONFLICT (user_id) DO UPDATE").
+		Set("max_edits = EXCLUDED.max_edits").
+		Set("updated_at = NOW()").
+		// Leave memberships of other organizations untouched.
+		Where("organization_member.organization_id = EXCLUDED.organization_id").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrMemberOfAnotherOrganization
+	}
+
+	return member, nil
+}
+
+func NewUpsertOrganizationMemberRepository(db bun.IDB) UpsertOrganizationMemberRepository {
+	return &upsertOrganizationMemberRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,83 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestUpsertOrganizationMember(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name           string
+		organizationID uuid.UUID
+		userID         string
+		data           *dao.UpsertOrganizationMemberData
+		expect         *entities.OrganizationMember
+		expectErr      error
+	}{
+		{
+			name:           "UpsertOrganizationMember/Create",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			userID:         "user-id-4",
+			data: &dao.UpsertOrganizationMemberData{
+				MaxEdits: lo.ToPtr(10),
+			},
+			expect: &entities.OrganizationMember{
+				OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				UserID:         "user-id-4",
+				MaxEdits:       lo.ToPtr(10),
+			},
+		},
+		{
+			name:           "UpsertOrganizationMember/Update",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			userID:         "user-id-2",
+			data:           &dao.UpsertOrganizationMemberData{},
+			expect: &entities.OrganizationMember{
+				// The existing membership is updated in place.
+				ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				UserID:         "user-id-2",
+			},
+		},
+		{
+			name:           "UpsertOrganizationMember/OtherOrganization",
+			organizationID: uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			userID:         "user-id-3",
+			data:           &dao.UpsertOrganizationMemberData{},
+			expectErr:      dao.ErrMemberOfAnotherOrganization,
+		},
+	}
+
+	stx := BeginTX(db, organizationMembersFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewUpsertOrganizationMemberRepository(tx)
+			member, err := repo.UpsertOrganizationMember(context.TODO(), tt.organizationID, tt.userID, tt.data)
+
+			if member != nil {
+				// Since new IDs and timestamps are random, nullify them for comparison.
+				if tt.expect != nil && tt.expect.ID == nil {
+					member.ID = nil
+				}
+				member.CreatedAt = nil
+				member.UpdatedAt = nil
+			}
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, member)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type UpsertQuotaOverrideData struct {
+	Kind      entities.QuotaOverrideKind
+	MaxEdits  int
+	ExpiresAt *time.Time
+}
+
+type UpsertQuotaOverrideRepository interface {
+	UpsertQuotaOverride(ctx context.Context, author string, data *UpsertQuotaOverrideData) (*entities.QuotaOverride, error)
+}
+
+type upsertQuotaOverrideRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *upsertQuotaOverrideRepositoryImpl) UpsertQuotaOverride(
+	ctx context.Context, author string, data *UpsertQuotaOverrideData,
+) (*entities.QuotaOverride, error) {
+	ctx, span := startSpan(ctx, "UpsertQuotaOverride")
+	defer span.End()
+
+	override := &entities.QuotaOverride{
+		AuthorID:  author,
+		Kind:      data.Kind,
+		MaxEdits:  data.MaxEdits,
+		ExpiresAt: data.ExpiresAt,
+	}
+
+	_, err := getDB(ctx, r.db).NewInsert().
+		Model(override).
+		On("CONFLICT (author_id) DO UPDATE").
+		Set("kind = EXCLUDED.kind").
+		Set("max_edits = EXCLUDED.max_edits").
+		Set("expires_at = EXCLUDED.expires_at").
+		Set("updated_at = NOW()").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return override, nil
+}
+
+func NewUpsertQuotaOverrideRepository(db bun.IDB) UpsertQuotaOverrideRepository {
+	return &upsertQuotaOverrideRepositoryImpl{
+		db: db,
+	}
+}
@@ -0,0 +1,89 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var upsertQuotaOverrideFixtures = []*entities.QuotaOverride{
+	{
+		ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		AuthorID:  "author-id-1",
+		Kind:      entities.QuotaOverrideKindAdd,
+		MaxEdits:  10,
+		ExpiresAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestUpsertQuotaOverride(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	testData := []struct {
+		name      string
+		authorID  string
+		data      *dao.UpsertQuotaOverrideData
+		expect    *entities.QuotaOverride
+		expectErr error
+	}{
+		{
+			name:     "UpsertQuotaOverride/Create",
+			authorID: "author-id-2",
+			data: &dao.UpsertQuotaOverrideData{
+				Kind:     entities.QuotaOverrideKindReplace,
+				MaxEdits: 20,
+			},
+			expect: &entities.QuotaOverride{
+				AuthorID: "author-id-2",
+				Kind:     entities.QuotaOverrideKindReplace,
+				MaxEdits: 20,
+			},
+		},
+		{
+			name:     "UpsertQuotaOverride/Replace",
+			authorID: "author-id-1",
+			data: &dao.UpsertQuotaOverrideData{
+				Kind: entities.QuotaOverrideKindDisable,
+			},
+			expect: &entities.QuotaOverride{
+				// The existing override is updated in place.
+				ID:       lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID: "author-id-1",
+				Kind:     entities.QuotaOverrideKindDisable,
+			},
+		},
+	}
+
+	stx := BeginTX(db, upsertQuotaOverrideFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewUpsertQuotaOverrideRepository(tx)
+			override, err := repo.UpsertQuotaOverride(context.TODO(), tt.authorID, tt.data)
+
+			if override != nil {
+				// Since new IDs and timestamps are random, nullify them for comparison.
+				if tt.expect != nil && tt.expect.ID == nil {
+					override.ID = nil
+				}
+				override.CreatedAt = nil
+				override.UpdatedAt = nil
+			}
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, override)
+		})
+	}
+}
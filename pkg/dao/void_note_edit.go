@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// VoidNoteEditRepository releases an edit of an author, so it stops counting against their quota. Only edits that
+// still count at now are found: edits that were already voided, or expired, were already released.
+type VoidNoteEditRepository interface {
+	VoidNoteEdit(ctx context.Context, id uuid.UUID, author string, now time.Time) (*entities.NoteEdit, error)
+}
+
+type voidNoteEditRepositoryImpl struct {
+	db bun.IDB
+}
+
+func (r *voidNoteEditRepositoryImpl) VoidNoteEdit(
+	ctx context.Context, id uuid.UUID, author string, now time.Time,
+) (*entities.NoteEdit, error) {
+	ctx, span := startSpan(ctx, "VoidNoteEdit")
+	defer span.End()
+
+	noteEdit := new(entities.NoteEdit)
+
+	res, err := getDB(ctx, r.db).NewUpdate().
+		Model(noteEdit).
+		Set("voided_at = ?", now).
+		Where("id = ?", id).
+		Where("author_id = ?", author).
+		Where("voided_at IS NULL").
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("status = ?", entities.NoteEditStatusCommitted).
+				WhereGroup(" OR ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+					return q.
+						Where("status = ?", entities.NoteEditStatusPending).
+						Where("expires_at > ?", now)
+				})
+		}).
+		Returning("*").
+		Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNoNoteEditFound
+	}
+
+	return noteEdit, nil
+}
+
+func NewVoidNoteEditRepository(db bun.IDB) VoidNoteEditRepository {
+	return &voidNoteEditRepositoryImpl{
+		db: db,
+	}
+}
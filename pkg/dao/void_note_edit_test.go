@@ -0,0 +1,104 @@
+package dao_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestVoidNoteEdit(t *testing.T) {
+	db := OpenDB()
+	defer CloseDB(db)
+
+	now := time.Date(2021, 1, 3, 0, 10, 0, 0, time.UTC)
+
+	testData := []struct {
+		name      string
+		id        uuid.UUID
+		authorID  string
+		expect    *entities.NoteEdit
+		expectErr error
+	}{
+		{
+			name:     "VoidNoteEdit",
+			id:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			authorID: "author-id-1",
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID:         "author-id-1",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusCommitted,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+				VoidedAt:         &now,
+			},
+		},
+		{
+			name:     "VoidNoteEdit/Pending",
+			id:       uuid.MustParse("00000000-0000-0000-0000-000000000008"),
+			authorID: "author-id-4",
+			expect: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000008")),
+				AuthorID:         "author-id-4",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				Status:           entities.NoteEditStatusPending,
+				ExpiresAt:        lo.ToPtr(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				VoidedAt:         &now,
+			},
+		},
+		{
+			// The edit stopped counting when it expired, even if the expiration job did not release it yet.
+			name:      "VoidNoteEdit/PastExpiration",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+			authorID:  "author-id-4",
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+		{
+			name:      "VoidNoteEdit/Expired",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			authorID:  "author-id-4",
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+		{
+			name:      "VoidNoteEdit/DifferentAuthor",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			authorID:  "author-id-2",
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+		{
+			name:      "VoidNoteEdit/AlreadyVoided",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+			authorID:  "author-id-3",
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+		{
+			name:      "VoidNoteEdit/NotFound",
+			id:        uuid.MustParse("00000000-0000-0000-0000-000000000099"),
+			authorID:  "author-id-1",
+			expectErr: dao.ErrNoNoteEditFound,
+		},
+	}
+
+	stx := BeginTX(db, getLatestNoteEditByAuthorFixtures)
+	defer RollbackTX(stx)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := BeginTX[interface{}](stx, nil)
+			defer RollbackTX(tx)
+
+			repo := dao.NewVoidNoteEditRepository(tx)
+			noteEdit, err := repo.VoidNoteEdit(context.TODO(), tt.id, tt.authorID, now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, noteEdit)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// EntitlementOverride replaces the value of an entitlement for a single user, regardless of their tier.
+type EntitlementOverride struct {
+	bun.BaseModel `bun:"table:entitlement_overrides"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	UserID      string `bun:"user_id,notnull"`
+	Entitlement string `bun:"entitlement,notnull"`
+
+	Enabled bool `bun:"enabled,notnull"`
+	// Limit is the allowance of numeric entitlements. It is nil for on/off entitlements.
+	Limit *int `bun:"limit_value"`
+
+	ExpiresAt *time.Time `bun:"expires_at"`
+
+	CreatedAt *time.Time `bun:"created_at,notnull"`
+	UpdatedAt *time.Time `bun:"updated_at,notnull"`
+}
+
+// IsActive returns true if the override applies at the given time.
+func (override *EntitlementOverride) IsActive(now time.Time) bool {
+	return override.ExpiresAt == nil || override.ExpiresAt.After(now)
+}
@@ -12,9 +12,45 @@ type NoteEdit struct {
 	ID *uuid.UUID `bun:"id,pk,type:uuid"`
 
 	AuthorID string `bun:"author_id,notnull"`
+	// OrganizationID is set when the edit was drawn from the pool of an organization.
+	OrganizationID *uuid.UUID `bun:"organization_id,type:uuid"`
 
 	PublicIdentifier string `bun:"public_identifier,notnull"`
 	Target           Target `bun:"target,notnull"`
 
+	// Status defaults to committed when the edit is created without one.
+	Status NoteEditStatus `bun:"status,nullzero,notnull"`
+	// ExpiresAt is the time at which a pending edit expires, unless committed before.
+	ExpiresAt *time.Time `bun:"expires_at"`
+
+	// IdempotencyKey is set by clients that retry CanUpdateNote, so a retry does not count a second edit.
+	IdempotencyKey *string `bun:"idempotency_key"`
+	// RemainingEdits is the number of edits that remained once this edit was counted. It is returned when a request
+	// with the same idempotency key is replayed.
+	RemainingEdits *int `bun:"remaining_edits"`
+
 	CreatedAt *time.Time `bun:"created_at,notnull"`
+	// VoidedAt is set when the edit was released, because the note it was counted for failed to update. Voided edits
+	// no longer count against any quota.
+	VoidedAt *time.Time `bun:"voided_at"`
+	// LastActivityAt is the last time the note was edited again within the edit session of this edit, when sessions
+	// slide on activity.
+	LastActivityAt *time.Time `bun:"last_activity_at"`
+}
+
+// IsCounted returns true if the edit counts against a quota at the given time: committed edits, and pending edits that
+// did not expire yet. Voided edits never count.
+func (noteEdit *NoteEdit) IsCounted(now time.Time) bool {
+	if noteEdit.VoidedAt != nil {
+		return false
+	}
+
+	switch noteEdit.Status {
+	case NoteEditStatusCommitted:
+		return true
+	case NoteEditStatusPending:
+		return noteEdit.ExpiresAt != nil && noteEdit.ExpiresAt.After(now)
+	default:
+		return false
+	}
 }
@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+type NoteEditStatus string
+
+const (
+	// NoteEditStatusPending edits are reserved until their ExpiresAt, waiting for the note to be durably updated.
+	NoteEditStatusPending   NoteEditStatus = "pending"
+	NoteEditStatusCommitted NoteEditStatus = "committed"
+	// NoteEditStatusExpired edits were never committed, and no longer count against any quota.
+	NoteEditStatusExpired NoteEditStatus = "expired"
+)
+
+var _ sql.Scanner = (*NoteEditStatus)(nil)
+var _ driver.Valuer = (*NoteEditStatus)(nil)
+
+func (status NoteEditStatus) Valid() bool {
+	switch status {
+	case NoteEditStatusPending, NoteEditStatusCommitted, NoteEditStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+func (status *NoteEditStatus) Scan(src interface{}) error {
+	switch tsrc := src.(type) {
+	case string:
+		*status = NoteEditStatus(tsrc)
+		if !status.Valid() {
+			return fmt.Errorf("invalid note edit status: %q", tsrc)
+		}
+		return nil
+	case []byte:
+		*status = NoteEditStatus(tsrc)
+		if !status.Valid() {
+			return fmt.Errorf("invalid note edit status: %q", tsrc)
+		}
+		return nil
+	case nil:
+		return fmt.Errorf("scanning nil into NoteEditStatus")
+	default:
+		return fmt.Errorf("unsupported data type for NoteEditStatus: %T", src)
+	}
+}
+
+func (status NoteEditStatus) Value() (driver.Value, error) {
+	if !status.Valid() {
+		return nil, fmt.Errorf("invalid note edit status: %q", status)
+	}
+	return string(status), nil
+}
@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Organization groups users under a single subscription, whose quotas are shared by every member.
+type Organization struct {
+	bun.BaseModel `bun:"table:organizations"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	Name string `bun:"name,notnull"`
+
+	CreatedAt *time.Time `bun:"created_at,notnull"`
+	UpdatedAt *time.Time `bun:"updated_at,notnull"`
+}
+
+// OrganizationSubscriberID returns the identifier the subscription of an organization is stored under. Subscriptions of
+// organizations live alongside the ones of users, so the same flows apply to both.
+func OrganizationSubscriberID(organizationID uuid.UUID) string {
+	return "organization:" + organizationID.String()
+}
@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// OrganizationMember links a user to an organization. A user belongs to at most one organization.
+type OrganizationMember struct {
+	bun.BaseModel `bun:"table:organization_members"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	OrganizationID *uuid.UUID `bun:"organization_id,notnull,type:uuid"`
+	UserID         string     `bun:"user_id,notnull"`
+
+	// MaxEdits caps the share of the organization pool the member can use. It is nil when the member can use the whole
+	// pool.
+	MaxEdits *int `bun:"max_edits"`
+
+	CreatedAt *time.Time `bun:"created_at,notnull"`
+	UpdatedAt *time.Time `bun:"updated_at,notnull"`
+}
@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// OutboxEvent is a domain event waiting to be published. It is written in the same transaction as the change it
+// describes, so events are neither lost when the transaction commits, nor sent when it rolls back.
+type OutboxEvent struct {
+	bun.BaseModel `bun:"table:outbox_events"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+	// Sequence orders events in the order they were written, including within a single transaction.
+	Sequence int64 `bun:"sequence,nullzero,notnull"`
+
+	Name    string          `bun:"name,notnull"`
+	Payload json.RawMessage `bun:"payload,type:jsonb,notnull"`
+
+	CreatedAt *time.Time `bun:"created_at,notnull"`
+	// PublishedAt is set once the relay has handed the event to the publisher.
+	PublishedAt *time.Time `bun:"published_at"`
+}
@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type QuotaOverride struct {
+	bun.BaseModel `bun:"table:quota_overrides"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	AuthorID string `bun:"author_id,notnull"`
+
+	Kind     QuotaOverrideKind `bun:"kind,notnull"`
+	MaxEdits int               `bun:"max_edits,notnull"`
+
+	ExpiresAt *time.Time `bun:"expires_at"`
+
+	CreatedAt *time.Time `bun:"created_at,notnull"`
+	UpdatedAt *time.Time `bun:"updated_at,notnull"`
+}
+
+// IsActive returns true if the override applies at the given time.
+func (override *QuotaOverride) IsActive(now time.Time) bool {
+	return override.ExpiresAt == nil || override.ExpiresAt.After(now)
+}
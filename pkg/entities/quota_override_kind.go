@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+type QuotaOverrideKind string
+
+const (
+	// QuotaOverrideKindAdd grants extra edits on top of the tier limit.
+	QuotaOverrideKindAdd QuotaOverrideKind = "add"
+	// QuotaOverrideKindReplace sets the limit regardless of the tier.
+	QuotaOverrideKindReplace QuotaOverrideKind = "replace"
+	// QuotaOverrideKindDisable lifts the limit entirely.
+	QuotaOverrideKindDisable QuotaOverrideKind = "disable"
+)
+
+var _ sql.Scanner = (*QuotaOverrideKind)(nil)
+var _ driver.Valuer = (*QuotaOverrideKind)(nil)
+
+func (kind QuotaOverrideKind) Valid() bool {
+	switch kind {
+	case QuotaOverrideKindAdd, QuotaOverrideKindReplace, QuotaOverrideKindDisable:
+		return true
+	default:
+		return false
+	}
+}
+
+func (kind *QuotaOverrideKind) Scan(src interface{}) error {
+	switch tsrc := src.(type) {
+	case string:
+		*kind = QuotaOverrideKind(tsrc)
+		if !kind.Valid() {
+			return fmt.Errorf("invalid quota override kind: %q", tsrc)
+		}
+		return nil
+	case []byte:
+		*kind = QuotaOverrideKind(tsrc)
+		if !kind.Valid() {
+			return fmt.Errorf("invalid quota override kind: %q", tsrc)
+		}
+		return nil
+	case nil:
+		return fmt.Errorf("scanning nil into QuotaOverrideKind")
+	default:
+		return fmt.Errorf("unsupported data type for QuotaOverrideKind: %T", src)
+	}
+}
+
+func (kind QuotaOverrideKind) Value() (driver.Value, error) {
+	if !kind.Valid() {
+		return nil, fmt.Errorf("invalid quota override kind: %q", kind)
+	}
+	return string(kind), nil
+}
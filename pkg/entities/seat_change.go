@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// SeatChange records a member joining or leaving an organization, so billing can prorate seats over a period.
+type SeatChange struct {
+	bun.BaseModel `bun:"table:seat_changes"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	OrganizationID *uuid.UUID `bun:"organization_id,notnull,type:uuid"`
+	UserID         string     `bun:"user_id,notnull"`
+
+	Kind SeatChangeKind `bun:"kind,notnull"`
+	// SeatsUsed is the number of seats taken in the organization once the change applied.
+	SeatsUsed int `bun:"seats_used,notnull"`
+
+	CreatedAt *time.Time `bun:"created_at,notnull"`
+}
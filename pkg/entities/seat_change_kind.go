@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+type SeatChangeKind string
+
+const (
+	// SeatChangeKindAdded is recorded when a member takes a seat.
+	SeatChangeKindAdded SeatChangeKind = "added"
+	// SeatChangeKindRemoved is recorded when a member frees their seat.
+	SeatChangeKindRemoved SeatChangeKind = "removed"
+)
+
+var _ sql.Scanner = (*SeatChangeKind)(nil)
+var _ driver.Valuer = (*SeatChangeKind)(nil)
+
+func (kind SeatChangeKind) Valid() bool {
+	switch kind {
+	case SeatChangeKindAdded, SeatChangeKindRemoved:
+		return true
+	default:
+		return false
+	}
+}
+
+func (kind *SeatChangeKind) Scan(src interface{}) error {
+	switch tsrc := src.(type) {
+	case string:
+		*kind = SeatChangeKind(tsrc)
+		if !kind.Valid() {
+			return fmt.Errorf("invalid seat change kind: %q", tsrc)
+		}
+		return nil
+	case []byte:
+		*kind = SeatChangeKind(tsrc)
+		if !kind.Valid() {
+			return fmt.Errorf("invalid seat change kind: %q", tsrc)
+		}
+		return nil
+	case nil:
+		return fmt.Errorf("scanning nil into SeatChangeKind")
+	default:
+		return fmt.Errorf("unsupported data type for SeatChangeKind: %T", src)
+	}
+}
+
+func (kind SeatChangeKind) Value() (driver.Value, error) {
+	if !kind.Valid() {
+		return nil, fmt.Errorf("invalid seat change kind: %q", kind)
+	}
+	return string(kind), nil
+}
@@ -0,0 +1,16 @@
+package entities
+
+import (
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// StripeEvent records a Stripe webhook event that has already been processed.
+type StripeEvent struct {
+	bun.BaseModel `bun:"table:stripe_events"`
+
+	ID   string `bun:"id,pk"`
+	Type string `bun:"type,notnull"`
+
+	ProcessedAt *time.Time `bun:"processed_at,notnull"`
+}
@@ -0,0 +1,75 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+type Subscription struct {
+	bun.BaseModel `bun:"table:subscriptions"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	UserID string `bun:"user_id,notnull"`
+
+	Tier   string             `bun:"tier,notnull"`
+	Status SubscriptionStatus `bun:"status,notnull"`
+
+	StartedAt *time.Time `bun:"started_at,notnull"`
+	EndsAt    *time.Time `bun:"ends_at"`
+
+	CurrentPeriodStart *time.Time `bun:"current_period_start"`
+	CurrentPeriodEnd   *time.Time `bun:"current_period_end"`
+	CancelAtPeriodEnd  bool       `bun:"cancel_at_period_end,notnull"`
+	CanceledAt         *time.Time `bun:"canceled_at"`
+
+	TrialEndsAt *time.Time `bun:"trial_ends_at"`
+
+	StripeSubscriptionID *string    `bun:"stripe_subscription_id"`
+	PaymentFailedAt      *time.Time `bun:"payment_failed_at"`
+	// StripeEventCreatedAt is the creation time of the last Stripe event applied to the subscription. Stripe does not
+	// deliver events in order, so older events must not overwrite it.
+	StripeEventCreatedAt *time.Time `bun:"stripe_event_created_at"`
+	// StripeEventID is the id of the last Stripe event applied to the subscription. It orders the events created in
+	// the same second.
+	StripeEventID *string `bun:"stripe_event_id"`
+
+	CreatedAt *time.Time `bun:"created_at,notnull"`
+	UpdatedAt *time.Time `bun:"updated_at,notnull"`
+}
+
+// IsRunning returns true if the subscription applies at the given time.
+func (subscription *Subscription) IsRunning(now time.Time) bool {
+	switch subscription.Status {
+	// Overdue subscriptions keep running, but only grant their tier during the grace period. See InGracePeriod.
+	case SubscriptionStatusActive, SubscriptionStatusPastDue, SubscriptionStatusUnpaid:
+	case SubscriptionStatusTrialing:
+		// Trials stop applying as soon as they expire, even if they were not marked as expired yet.
+		if subscription.TrialEndsAt == nil || !subscription.TrialEndsAt.After(now) {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if subscription.StartedAt != nil && subscription.StartedAt.After(now) {
+		return false
+	}
+
+	return subscription.EndsAt == nil || subscription.EndsAt.After(now)
+}
+
+// IsPaymentOverdue returns true if the subscription failed to renew.
+func (subscription *Subscription) IsPaymentOverdue() bool {
+	return subscription.Status == SubscriptionStatusPastDue || subscription.Status == SubscriptionStatusUnpaid
+}
+
+// InGracePeriod returns true if the payment of an overdue subscription failed less than grace before now.
+func (subscription *Subscription) InGracePeriod(now time.Time, grace time.Duration) bool {
+	if !subscription.IsPaymentOverdue() || subscription.PaymentFailedAt == nil {
+		return false
+	}
+
+	return subscription.PaymentFailedAt.Add(grace).After(now)
+}
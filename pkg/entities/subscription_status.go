@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+type SubscriptionStatus string
+
+const (
+	SubscriptionStatusActive   SubscriptionStatus = "active"
+	SubscriptionStatusCanceled SubscriptionStatus = "canceled"
+	// SubscriptionStatusTrialing subscriptions grant their tier until TrialEndsAt.
+	SubscriptionStatusTrialing SubscriptionStatus = "trialing"
+	// SubscriptionStatusPastDue subscriptions failed to renew, and the payment is being retried.
+	SubscriptionStatusPastDue SubscriptionStatus = "past_due"
+	// SubscriptionStatusUnpaid subscriptions failed to renew, and the payment is no longer retried.
+	SubscriptionStatusUnpaid SubscriptionStatus = "unpaid"
+)
+
+var _ sql.Scanner = (*SubscriptionStatus)(nil)
+var _ driver.Valuer = (*SubscriptionStatus)(nil)
+
+func (status SubscriptionStatus) Valid() bool {
+	switch status {
+	case SubscriptionStatusActive, SubscriptionStatusCanceled, SubscriptionStatusTrialing,
+		SubscriptionStatusPastDue, SubscriptionStatusUnpaid:
+		return true
+	default:
+		return false
+	}
+}
+
+func (status *SubscriptionStatus) Scan(src interface{}) error {
+	switch tsrc := src.(type) {
+	case string:
+		*status = SubscriptionStatus(tsrc)
+		if !status.Valid() {
+			return fmt.Errorf("invalid subscription status: %q", tsrc)
+		}
+		return nil
+	case []byte:
+		*status = SubscriptionStatus(tsrc)
+		if !status.Valid() {
+			return fmt.Errorf("invalid subscription status: %q", tsrc)
+		}
+		return nil
+	case nil:
+		return fmt.Errorf("scanning nil into SubscriptionStatus")
+	default:
+		return fmt.Errorf("unsupported data type for SubscriptionStatus: %T", src)
+	}
+}
+
+func (status SubscriptionStatus) Value() (driver.Value, error) {
+	if !status.Valid() {
+		return nil, fmt.Errorf("invalid subscription status: %q", status)
+	}
+	return string(status), nil
+}
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// UsageEvent records a single use of a metered feature.
+type UsageEvent struct {
+	bun.BaseModel `bun:"table:usage_events"`
+
+	ID *uuid.UUID `bun:"id,pk,type:uuid"`
+
+	// Feature is the name of the metered feature, as configured in the tiers.
+	Feature string `bun:"feature,notnull"`
+	// Subject is the user the use is counted against.
+	Subject string `bun:"subject,notnull"`
+	// Key identifies the resource the feature was used on. Repeated uses of the same key may count only once.
+	Key string `bun:"key,notnull"`
+
+	CreatedAt *time.Time `bun:"created_at,notnull"`
+}
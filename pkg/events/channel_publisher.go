@@ -0,0 +1,26 @@
+package events
+
+import (
+	"context"
+)
+
+type channelPublisherImpl struct {
+	messages chan<- *Message
+}
+
+func (p *channelPublisherImpl) Publish(ctx context.Context, message *Message) error {
+	select {
+	case p.messages <- message:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// NewChannelPublisher returns a Publisher that sends messages to consumers in the same process. Publish blocks until
+// the message is received, or the context is canceled.
+func NewChannelPublisher(messages chan<- *Message) Publisher {
+	return &channelPublisherImpl{
+		messages: messages,
+	}
+}
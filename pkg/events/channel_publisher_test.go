@@ -0,0 +1,39 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestChannelPublisher(t *testing.T) {
+	message := &events.Message{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Name:      events.SubscriptionChangedEventName,
+		Payload:   json.RawMessage(`{}`),
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("Publish", func(t *testing.T) {
+		messages := make(chan *events.Message, 1)
+		publisher := events.NewChannelPublisher(messages)
+
+		require.NoError(t, publisher.Publish(context.TODO(), message))
+		require.Equal(t, message, <-messages)
+	})
+
+	t.Run("ContextCanceled", func(t *testing.T) {
+		// Nobody receives from the channel, so the publisher waits until the context is canceled.
+		messages := make(chan *events.Message)
+		publisher := events.NewChannelPublisher(messages)
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		require.ErrorIs(t, publisher.Publish(ctx, message), context.Canceled)
+	})
+}
@@ -0,0 +1,16 @@
+package events
+
+import (
+	"context"
+)
+
+// Event is a domain event, emitted for other services to react to.
+type Event interface {
+	// EventName identifies the kind of event, for consumers to route it.
+	EventName() string
+}
+
+// Emitter sends domain events out of the service.
+type Emitter interface {
+	Emit(ctx context.Context, event Event) error
+}
@@ -0,0 +1,23 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"github.com/in-rich/lib-go/monitor"
+)
+
+type logPublisherImpl struct {
+	logger monitor.Logger
+}
+
+func (p *logPublisherImpl) Publish(_ context.Context, message *Message) error {
+	p.logger.Info(fmt.Sprintf("%s (%s): %s", message.Name, message.ID, message.Payload))
+	return nil
+}
+
+// NewLogPublisher returns a Publisher that writes messages to the logs.
+func NewLogPublisher(logger monitor.Logger) Publisher {
+	return &logPublisherImpl{
+		logger: logger,
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package eventsmocks
+
+import (
+	context "context"
+
+	events "github.com/in-rich/uservice-subscription/pkg/events"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEmitter is an autogenerated mock type for the Emitter type
+type MockEmitter struct {
+	mock.Mock
+}
+
+type MockEmitter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEmitter) EXPECT() *MockEmitter_Expecter {
+	return &MockEmitter_Expecter{mock: &_m.Mock}
+}
+
+// Emit provides a mock function with given fields: ctx, event
+func (_m *MockEmitter) Emit(ctx context.Context, event events.Event) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Emit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, events.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmitter_Emit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Emit'
+type MockEmitter_Emit_Call struct {
+	*mock.Call
+}
+
+// Emit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event events.Event
+func (_e *MockEmitter_Expecter) Emit(ctx interface{}, event interface{}) *MockEmitter_Emit_Call {
+	return &MockEmitter_Emit_Call{Call: _e.mock.On("Emit", ctx, event)}
+}
+
+func (_c *MockEmitter_Emit_Call) Run(run func(ctx context.Context, event events.Event)) *MockEmitter_Emit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(events.Event))
+	})
+	return _c
+}
+
+func (_c *MockEmitter_Emit_Call) Return(_a0 error) *MockEmitter_Emit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmitter_Emit_Call) RunAndReturn(run func(context.Context, events.Event) error) *MockEmitter_Emit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEmitter creates a new instance of MockEmitter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEmitter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEmitter {
+	mock := &MockEmitter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const NoteEditConsumedEventName = "subscription.note_edit_consumed"
+
+// NoteEditConsumed is emitted when a new edit is counted against the quota of an author. Edits of a note within its
+// edit session are free, and do not emit this event. A NoteEditReleased event follows if the edit is later voided or
+// expires.
+type NoteEditConsumed struct {
+	NoteEditID uuid.UUID `json:"noteEditID"`
+	AuthorID   string    `json:"authorID"`
+	// OrganizationID is set when the edit was drawn from the pool of an organization.
+	OrganizationID   *uuid.UUID `json:"organizationID,omitempty"`
+	Target           string     `json:"target"`
+	PublicIdentifier string     `json:"publicIdentifier"`
+	Tier             string     `json:"tier"`
+	// RemainingEdits is the number of edits left to the author once this edit was counted.
+	RemainingEdits int       `json:"remainingEdits"`
+	ConsumedAt     time.Time `json:"consumedAt"`
+}
+
+func (event *NoteEditConsumed) EventName() string {
+	return NoteEditConsumedEventName
+}
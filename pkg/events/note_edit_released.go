@@ -0,0 +1,30 @@
+package events
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const NoteEditReleasedEventName = "subscription.note_edit_released"
+
+const (
+	// NoteEditReleasedReasonVoided is set when the edit was given back, because the note failed to update.
+	NoteEditReleasedReasonVoided = "voided"
+	// NoteEditReleasedReasonExpired is set when a pending edit was not committed before its reservation expired.
+	NoteEditReleasedReasonExpired = "expired"
+)
+
+// NoteEditReleased is emitted when an edit announced by NoteEditConsumed stops counting against the quota of its
+// author, so consumers can give the edit back.
+type NoteEditReleased struct {
+	NoteEditID uuid.UUID `json:"noteEditID"`
+	AuthorID   string    `json:"authorID"`
+	// OrganizationID is set when the edit was drawn from the pool of an organization.
+	OrganizationID *uuid.UUID `json:"organizationID,omitempty"`
+	Reason         string     `json:"reason"`
+	ReleasedAt     time.Time  `json:"releasedAt"`
+}
+
+func (event *NoteEditReleased) EventName() string {
+	return NoteEditReleasedEventName
+}
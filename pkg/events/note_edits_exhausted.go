@@ -0,0 +1,21 @@
+package events
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const NoteEditsExhaustedEventName = "subscription.note_edits_exhausted"
+
+// NoteEditsExhausted is emitted when an author consumes their last note edit of the current window.
+type NoteEditsExhausted struct {
+	AuthorID string `json:"authorID"`
+	// OrganizationID is set when the author draws their edits from the pool of an organization.
+	OrganizationID *uuid.UUID `json:"organizationID,omitempty"`
+	Tier           string     `json:"tier"`
+	ExhaustedAt    time.Time  `json:"exhaustedAt"`
+}
+
+func (event *NoteEditsExhausted) EventName() string {
+	return NoteEditsExhaustedEventName
+}
@@ -0,0 +1,37 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+)
+
+type outboxEmitterImpl struct {
+	createOutboxEventRepository dao.CreateOutboxEventRepository
+}
+
+func (e *outboxEmitterImpl) Emit(ctx context.Context, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal %s event: %w", event.EventName(), err)
+	}
+
+	_, err = e.createOutboxEventRepository.CreateOutboxEvent(ctx, &dao.CreateOutboxEventData{
+		Name:    event.EventName(),
+		Payload: payload,
+	})
+	if err != nil {
+		return fmt.Errorf("create outbox event: %w", err)
+	}
+
+	return nil
+}
+
+// NewOutboxEmitter returns an Emitter that writes events to the outbox, in the transaction of the context if any. The
+// events are published later, by the outbox relay.
+func NewOutboxEmitter(createOutboxEventRepository dao.CreateOutboxEventRepository) Emitter {
+	return &outboxEmitterImpl{
+		createOutboxEventRepository: createOutboxEventRepository,
+	}
+}
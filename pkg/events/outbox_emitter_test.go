@@ -0,0 +1,70 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var FooErr = errors.New("foo")
+
+func TestOutboxEmitter(t *testing.T) {
+	event := &events.SubscriptionChanged{
+		SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:         "user-id-1",
+		Tier:           "pro",
+		PreviousTier:   "free",
+		Status:         "active",
+		ChangedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testData := []struct {
+		name string
+
+		createOutboxEventErr error
+
+		expectErr error
+	}{
+		// Success cases.
+		{
+			name: "Emit",
+		},
+
+		// Dependency error cases.
+		{
+			name:                 "CreateOutboxEventError",
+			createOutboxEventErr: FooErr,
+			expectErr:            FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			createOutboxEventRepository := daomocks.NewMockCreateOutboxEventRepository(t)
+
+			createOutboxEventRepository.
+				On("CreateOutboxEvent", context.TODO(), &dao.CreateOutboxEventData{
+					Name: events.SubscriptionChangedEventName,
+					Payload: []byte(
+						`{"subscriptionID":"00000000-0000-0000-0000-000000000001","userID":"user-id-1","tier":"pro",` +
+							`"previousTier":"free","status":"active","changedAt":"2021-01-01T00:00:00Z"}`,
+					),
+				}).
+				Return(nil, tt.createOutboxEventErr)
+
+			emitter := events.NewOutboxEmitter(createOutboxEventRepository)
+
+			err := emitter.Emit(context.TODO(), event)
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			createOutboxEventRepository.AssertExpectations(t)
+		})
+	}
+}
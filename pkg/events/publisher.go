@@ -0,0 +1,23 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Message is an event relayed from the outbox.
+type Message struct {
+	// ID is unique per event. Consumers use it to discard events delivered more than once.
+	ID        uuid.UUID
+	Name      string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+// Publisher sends the events relayed from the outbox to other services. A message is delivered at least once: it is
+// published again if the relay fails to record it as published.
+type Publisher interface {
+	Publish(ctx context.Context, message *Message) error
+}
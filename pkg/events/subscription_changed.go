@@ -0,0 +1,24 @@
+package events
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const SubscriptionChangedEventName = "subscription.changed"
+
+// SubscriptionChanged is emitted when a subscription is created, or when its tier, status or end date changes.
+type SubscriptionChanged struct {
+	SubscriptionID uuid.UUID `json:"subscriptionID"`
+	UserID         string    `json:"userID"`
+	Tier           string    `json:"tier"`
+	// PreviousTier is empty when the subscription was just created.
+	PreviousTier string     `json:"previousTier,omitempty"`
+	Status       string     `json:"status"`
+	EndsAt       *time.Time `json:"endsAt,omitempty"`
+	ChangedAt    time.Time  `json:"changedAt"`
+}
+
+func (event *SubscriptionChanged) EventName() string {
+	return SubscriptionChangedEventName
+}
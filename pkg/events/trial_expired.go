@@ -0,0 +1,20 @@
+package events
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const TrialExpiredEventName = "subscription.trial_expired"
+
+// TrialExpired is emitted when a trial ends without being converted to a paid subscription.
+type TrialExpired struct {
+	SubscriptionID uuid.UUID `json:"subscriptionID"`
+	UserID         string    `json:"userID"`
+	Tier           string    `json:"tier"`
+	TrialEndsAt    time.Time `json:"trialEndsAt"`
+}
+
+func (event *TrialExpired) EventName() string {
+	return TrialExpiredEventName
+}
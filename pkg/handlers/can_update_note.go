@@ -5,9 +5,10 @@ import (
 	"errors"
 	"github.com/in-rich/lib-go/monitor"
 	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
-	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/metrics"
 	"github.com/in-rich/uservice-subscription/pkg/models"
 	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"time"
@@ -15,22 +16,37 @@ import (
 
 type CanUpdateNoteHandler struct {
 	subscription_pb.CanUpdateNoteServer
-	service services.CanUpdateNoteService
-	logger  monitor.GRPCLogger
+	service            services.CanUpdateNoteService
+	resolveTierService services.ResolveTierService
+	metrics            *metrics.Metrics
+	logger             monitor.GRPCLogger
 }
 
 func (h *CanUpdateNoteHandler) canUpdateNote(ctx context.Context, in *subscription_pb.CanUpdateNoteRequest) (*subscription_pb.CanUpdateNoteResponse, error) {
-	remainingEdits, err := h.service.Exec(ctx, &models.CanUpdateNoteRequest{
+	now := time.Now()
+
+	tier, err := h.resolveTierService.Exec(ctx, in.GetAuthorId(), now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve tier: %v", err)
+	}
+
+	result, err := h.service.Exec(ctx, &models.CanUpdateNoteRequest{
 		Target:           in.GetTarget(),
 		PublicIdentifier: in.GetPublicIdentifier(),
 		AuthorID:         in.GetAuthorId(),
 		ReadOnly:         in.GetReadOnly(),
-	}, config.App.FreeTier, time.Now())
+		IdempotencyKey:   in.GetIdempotencyKey(),
+	}, tier, now)
 
 	if err != nil {
 		if errors.Is(err, services.ErrNoteEditsExhausted) {
+			h.metrics.RecordNoteEditDecision(tier.Name, in.GetTarget(), metrics.NoteEditDeniedExhausted)
 			return nil, status.Error(codes.ResourceExhausted, "note edits exhausted")
 		}
+		if errors.Is(err, services.ErrNotesExhausted) {
+			h.metrics.RecordNoteEditDecision(tier.Name, in.GetTarget(), metrics.NoteEditDeniedNotesExhausted)
+			return nil, status.Error(codes.ResourceExhausted, "distinct notes exhausted")
+		}
 		if errors.Is(err, services.ErrInvalidRequest) {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
 		}
@@ -38,9 +54,34 @@ func (h *CanUpdateNoteHandler) canUpdateNote(ctx context.Context, in *subscripti
 		return nil, status.Errorf(codes.Internal, "failed to check if note can be updated: %v", err)
 	}
 
-	return &subscription_pb.CanUpdateNoteResponse{
-		RemainingEdits: int32(remainingEdits),
-	}, nil
+	h.metrics.RecordNoteEditDecision(tier.Name, in.GetTarget(), noteEditDecision(in, result))
+
+	res := &subscription_pb.CanUpdateNoteResponse{
+		RemainingEdits:       int32(result.RemainingEdits),
+		BillingWarning:       tier.BillingWarning,
+		ReservationExpiresAt: timeToProto(result.ExpiresAt),
+	}
+	if result.NoteEditID != nil {
+		res.NoteEditId = lo.ToPtr(result.NoteEditID.String())
+	}
+
+	return res, nil
+}
+
+// noteEditDecision tells whether a successful check counted a new edit, replayed an earlier one, or let the author edit
+// the note for free.
+func noteEditDecision(in *subscription_pb.CanUpdateNoteRequest, result *models.CanUpdateNoteResult) metrics.NoteEditDecision {
+	if in.GetReadOnly() {
+		return metrics.NoteEditReadOnly
+	}
+	if result.Replayed {
+		return metrics.NoteEditReplayed
+	}
+	if result.NoteEditID == nil {
+		return metrics.NoteEditBuffered
+	}
+
+	return metrics.NoteEditAllowed
 }
 
 func (h *CanUpdateNoteHandler) CanUpdateNote(ctx context.Context, in *subscription_pb.CanUpdateNoteRequest) (*subscription_pb.CanUpdateNoteResponse, error) {
@@ -49,9 +90,16 @@ func (h *CanUpdateNoteHandler) CanUpdateNote(ctx context.Context, in *subscripti
 	return res, err
 }
 
-func NewCanUpdateNoteHandler(service services.CanUpdateNoteService, logger monitor.GRPCLogger) *CanUpdateNoteHandler {
+func NewCanUpdateNoteHandler(
+	service services.CanUpdateNoteService,
+	resolveTierService services.ResolveTierService,
+	metrics *metrics.Metrics,
+	logger monitor.GRPCLogger,
+) *CanUpdateNoteHandler {
 	return &CanUpdateNoteHandler{
-		service: service,
-		logger:  logger,
+		service:            service,
+		resolveTierService: resolveTierService,
+		metrics:            metrics,
+		logger:             logger,
 	}
 }
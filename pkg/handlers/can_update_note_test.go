@@ -3,28 +3,52 @@ package handlers_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/google/uuid"
 	"github.com/in-rich/lib-go/monitor"
 	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/config"
 	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/metrics"
+	"github.com/in-rich/uservice-subscription/pkg/models"
 	"github.com/in-rich/uservice-subscription/pkg/services"
 	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestCanUpdateNote(t *testing.T) {
+	tier := config.TierInformation{
+		Features: map[string]config.FeatureTierInformation{
+			config.NoteEditsFeature: {
+				CountUsesOver: lo.ToPtr(24 * time.Hour),
+				MaxUses:       5,
+			},
+		},
+	}
+
 	testData := []struct {
 		name string
 
 		in *subscription_pb.CanUpdateNoteRequest
 
-		serviceResp int
+		billingWarning bool
+		resolveTierErr error
+
+		serviceResp *models.CanUpdateNoteResult
 		serviceErr  error
 
-		expect     *subscription_pb.CanUpdateNoteResponse
-		expectCode codes.Code
+		expect         *subscription_pb.CanUpdateNoteResponse
+		expectCode     codes.Code
+		expectDecision metrics.NoteEditDecision
 	}{
 		{
 			name: "CanUpdateNote",
@@ -33,10 +57,96 @@ func TestCanUpdateNote(t *testing.T) {
 				PublicIdentifier: "public-identifier-1",
 				AuthorId:         "author-id-1",
 			},
-			serviceResp: 1,
+			serviceResp: &models.CanUpdateNoteResult{
+				RemainingEdits: 1,
+				NoteEditID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
 			expect: &subscription_pb.CanUpdateNoteResponse{
 				RemainingEdits: 1,
+				NoteEditId:     lo.ToPtr("00000000-0000-0000-0000-000000000001"),
+			},
+			expectDecision: metrics.NoteEditAllowed,
+		},
+		{
+			name: "CanUpdateNote/Reservation",
+			in: &subscription_pb.CanUpdateNoteRequest{
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				AuthorId:         "author-id-1",
+			},
+			serviceResp: &models.CanUpdateNoteResult{
+				RemainingEdits: 1,
+				NoteEditID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				ExpiresAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 5, 0, 0, time.UTC)),
+			},
+			expect: &subscription_pb.CanUpdateNoteResponse{
+				RemainingEdits:       1,
+				NoteEditId:           lo.ToPtr("00000000-0000-0000-0000-000000000001"),
+				ReservationExpiresAt: timestamppb.New(time.Date(2021, 1, 1, 0, 5, 0, 0, time.UTC)),
 			},
+			expectDecision: metrics.NoteEditAllowed,
+		},
+		{
+			// Retries with the same idempotency key don't count a new edit.
+			name: "CanUpdateNote/Replayed",
+			in: &subscription_pb.CanUpdateNoteRequest{
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				AuthorId:         "author-id-1",
+				IdempotencyKey:   "idempotency-key-1",
+			},
+			serviceResp: &models.CanUpdateNoteResult{
+				RemainingEdits: 1,
+				NoteEditID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Replayed:       true,
+			},
+			expect: &subscription_pb.CanUpdateNoteResponse{
+				RemainingEdits: 1,
+				NoteEditId:     lo.ToPtr("00000000-0000-0000-0000-000000000001"),
+			},
+			expectDecision: metrics.NoteEditReplayed,
+		},
+		{
+			name: "CanUpdateNote/NoNewEdit",
+			in: &subscription_pb.CanUpdateNoteRequest{
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				AuthorId:         "author-id-1",
+			},
+			serviceResp: &models.CanUpdateNoteResult{RemainingEdits: 1},
+			expect: &subscription_pb.CanUpdateNoteResponse{
+				RemainingEdits: 1,
+			},
+			expectDecision: metrics.NoteEditBuffered,
+		},
+		{
+			name: "CanUpdateNote/BillingWarning",
+			in: &subscription_pb.CanUpdateNoteRequest{
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				AuthorId:         "author-id-1",
+			},
+			billingWarning: true,
+			serviceResp:    &models.CanUpdateNoteResult{RemainingEdits: 1},
+			expect: &subscription_pb.CanUpdateNoteResponse{
+				RemainingEdits: 1,
+				BillingWarning: true,
+			},
+			expectDecision: metrics.NoteEditBuffered,
+		},
+		{
+			name: "CanUpdateNote/ReadOnly",
+			in: &subscription_pb.CanUpdateNoteRequest{
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				AuthorId:         "author-id-1",
+				ReadOnly:         true,
+			},
+			serviceResp: &models.CanUpdateNoteResult{RemainingEdits: 1},
+			expect: &subscription_pb.CanUpdateNoteResponse{
+				RemainingEdits: 1,
+			},
+			expectDecision: metrics.NoteEditReadOnly,
 		},
 		{
 			name: "NoteEditsExhausted",
@@ -45,8 +155,20 @@ func TestCanUpdateNote(t *testing.T) {
 				PublicIdentifier: "public-identifier-1",
 				AuthorId:         "author-id-1",
 			},
-			serviceErr: services.ErrNoteEditsExhausted,
-			expectCode: codes.ResourceExhausted,
+			serviceErr:     services.ErrNoteEditsExhausted,
+			expectCode:     codes.ResourceExhausted,
+			expectDecision: metrics.NoteEditDeniedExhausted,
+		},
+		{
+			name: "NotesExhausted",
+			in: &subscription_pb.CanUpdateNoteRequest{
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				AuthorId:         "author-id-1",
+			},
+			serviceErr:     services.ErrNotesExhausted,
+			expectCode:     codes.ResourceExhausted,
+			expectDecision: metrics.NoteEditDeniedNotesExhausted,
 		},
 		{
 			name: "InvalidRequest",
@@ -58,6 +180,16 @@ func TestCanUpdateNote(t *testing.T) {
 			serviceErr: services.ErrInvalidRequest,
 			expectCode: codes.InvalidArgument,
 		},
+		{
+			name: "ResolveTierError",
+			in: &subscription_pb.CanUpdateNoteRequest{
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				AuthorId:         "author-id-1",
+			},
+			resolveTierErr: errors.New("internal error"),
+			expectCode:     codes.Internal,
+		},
 		{
 			name: "InternalError",
 			in: &subscription_pb.CanUpdateNoteRequest{
@@ -72,15 +204,40 @@ func TestCanUpdateNote(t *testing.T) {
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
+			resolvedTier := &models.ResolvedTier{Name: "free", TierInformation: tier, BillingWarning: tt.billingWarning}
+
+			resolveTierService := servicesmocks.NewMockResolveTierService(t)
+			resolveTierService.
+				On("Exec", context.TODO(), tt.in.GetAuthorId(), mock.Anything).
+				Return(resolvedTier, tt.resolveTierErr)
+
 			service := servicesmocks.NewMockCanUpdateNoteService(t)
-			service.On("Exec", context.TODO(), mock.Anything, mock.Anything, mock.Anything).Return(tt.serviceResp, tt.serviceErr)
+			if tt.resolveTierErr == nil {
+				service.On("Exec", context.TODO(), mock.Anything, resolvedTier, mock.Anything).Return(tt.serviceResp, tt.serviceErr)
+			}
 
-			handler := handlers.NewCanUpdateNoteHandler(service, monitor.NewDummyGRPCLogger())
+			registry := prometheus.NewRegistry()
+			handler := handlers.NewCanUpdateNoteHandler(
+				service, resolveTierService, metrics.NewMetrics(registry), monitor.NewDummyGRPCLogger(),
+			)
 
 			resp, err := handler.CanUpdateNote(context.TODO(), tt.in)
 
 			RequireGRPCCodesEqual(t, err, tt.expectCode)
 			require.Equal(t, tt.expect, resp)
+
+			expectMetrics := ""
+			if tt.expectDecision != "" {
+				expectMetrics = fmt.Sprintf(
+					"# HELP subscription_note_edit_decisions_total Outcomes of the checks to update a note.\n"+
+						"# TYPE subscription_note_edit_decisions_total counter\n"+
+						"subscription_note_edit_decisions_total{decision=%q,target=%q,tier=\"free\"} 1\n",
+					tt.expectDecision, tt.in.GetTarget(),
+				)
+			}
+			require.NoError(t, testutil.GatherAndCompare(
+				registry, strings.NewReader(expectMetrics), "subscription_note_edit_decisions_total",
+			))
 		})
 	}
 }
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+type CanUpdateNotesHandler struct {
+	subscription_pb.CanUpdateNotesServer
+	service            services.CanUpdateNotesService
+	resolveTierService services.ResolveTierService
+	logger             monitor.GRPCLogger
+}
+
+func (h *CanUpdateNotesHandler) canUpdateNotes(
+	ctx context.Context, in *subscription_pb.CanUpdateNotesRequest,
+) (*subscription_pb.CanUpdateNotesResponse, error) {
+	now := time.Now()
+
+	tier, err := h.resolveTierService.Exec(ctx, in.GetAuthorId(), now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve tier: %v", err)
+	}
+
+	result, err := h.service.Exec(ctx, &models.CanUpdateNotesRequest{
+		AuthorID: in.GetAuthorId(),
+		Notes: lo.Map(in.GetNotes(), func(note *subscription_pb.NoteKey, _ int) *models.NoteKey {
+			return &models.NoteKey{
+				Target:           note.GetTarget(),
+				PublicIdentifier: note.GetPublicIdentifier(),
+			}
+		}),
+	}, tier, now)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to check if notes can be updated: %v", err)
+	}
+
+	return &subscription_pb.CanUpdateNotesResponse{
+		RemainingEdits: int32(result.RemainingEdits),
+		BillingWarning: tier.BillingWarning,
+		Notes: lo.Map(result.Notes, func(session *models.NoteEditSession, _ int) *subscription_pb.NoteEditSession {
+			return &subscription_pb.NoteEditSession{
+				Note: &subscription_pb.NoteKey{
+					Target:           session.Target,
+					PublicIdentifier: session.PublicIdentifier,
+				},
+				InSession: session.InSession,
+			}
+		}),
+	}, nil
+}
+
+func (h *CanUpdateNotesHandler) CanUpdateNotes(
+	ctx context.Context, in *subscription_pb.CanUpdateNotesRequest,
+) (*subscription_pb.CanUpdateNotesResponse, error) {
+	res, err := h.canUpdateNotes(ctx, in)
+	h.logger.Report(ctx, "CanUpdateNotes", err)
+	return res, err
+}
+
+func NewCanUpdateNotesHandler(
+	service services.CanUpdateNotesService,
+	resolveTierService services.ResolveTierService,
+	logger monitor.GRPCLogger,
+) *CanUpdateNotesHandler {
+	return &CanUpdateNotesHandler{
+		service:            service,
+		resolveTierService: resolveTierService,
+		logger:             logger,
+	}
+}
@@ -0,0 +1,143 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"testing"
+	"time"
+)
+
+func TestCanUpdateNotes(t *testing.T) {
+	tier := config.TierInformation{
+		Features: map[string]config.FeatureTierInformation{
+			config.NoteEditsFeature: {
+				CountUsesOver: lo.ToPtr(24 * time.Hour),
+				MaxUses:       5,
+				BufferTime:    time.Hour,
+			},
+		},
+	}
+
+	testData := []struct {
+		name string
+
+		in *subscription_pb.CanUpdateNotesRequest
+
+		billingWarning bool
+		resolveTierErr error
+
+		expectRequest *models.CanUpdateNotesRequest
+		serviceResp   *models.CanUpdateNotesResult
+		serviceErr    error
+
+		expect     *subscription_pb.CanUpdateNotesResponse
+		expectCode codes.Code
+	}{
+		{
+			name: "CanUpdateNotes",
+			in: &subscription_pb.CanUpdateNotesRequest{
+				AuthorId: "author-id-1",
+				Notes: []*subscription_pb.NoteKey{
+					{Target: "company", PublicIdentifier: "public-identifier-1"},
+					{Target: "user", PublicIdentifier: "public-identifier-2"},
+				},
+			},
+			billingWarning: true,
+			expectRequest: &models.CanUpdateNotesRequest{
+				AuthorID: "author-id-1",
+				Notes: []*models.NoteKey{
+					{Target: "company", PublicIdentifier: "public-identifier-1"},
+					{Target: "user", PublicIdentifier: "public-identifier-2"},
+				},
+			},
+			serviceResp: &models.CanUpdateNotesResult{
+				RemainingEdits: 2,
+				Notes: []*models.NoteEditSession{
+					{Target: "company", PublicIdentifier: "public-identifier-1", InSession: true},
+					{Target: "user", PublicIdentifier: "public-identifier-2"},
+				},
+			},
+			expect: &subscription_pb.CanUpdateNotesResponse{
+				RemainingEdits: 2,
+				BillingWarning: true,
+				Notes: []*subscription_pb.NoteEditSession{
+					{
+						Note:      &subscription_pb.NoteKey{Target: "company", PublicIdentifier: "public-identifier-1"},
+						InSession: true,
+					},
+					{
+						Note: &subscription_pb.NoteKey{Target: "user", PublicIdentifier: "public-identifier-2"},
+					},
+				},
+			},
+		},
+		{
+			name: "InvalidArgument",
+			in: &subscription_pb.CanUpdateNotesRequest{
+				AuthorId: "author-id-1",
+			},
+			expectRequest: &models.CanUpdateNotesRequest{
+				AuthorID: "author-id-1",
+				Notes:    []*models.NoteKey{},
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "ResolveTierError",
+			in: &subscription_pb.CanUpdateNotesRequest{
+				AuthorId: "author-id-1",
+			},
+			resolveTierErr: errors.New("internal error"),
+			expectCode:     codes.Internal,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.CanUpdateNotesRequest{
+				AuthorId: "author-id-1",
+			},
+			expectRequest: &models.CanUpdateNotesRequest{
+				AuthorID: "author-id-1",
+				Notes:    []*models.NoteKey{},
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			resolvedTier := &models.ResolvedTier{Name: "free", TierInformation: tier, BillingWarning: tt.billingWarning}
+
+			resolveTierService := servicesmocks.NewMockResolveTierService(t)
+			resolveTierService.
+				On("Exec", context.TODO(), tt.in.GetAuthorId(), mock.Anything).
+				Return(resolvedTier, tt.resolveTierErr)
+
+			service := servicesmocks.NewMockCanUpdateNotesService(t)
+			if tt.expectRequest != nil {
+				service.
+					On("Exec", context.TODO(), tt.expectRequest, resolvedTier, mock.Anything).
+					Return(tt.serviceResp, tt.serviceErr)
+			}
+
+			handler := handlers.NewCanUpdateNotesHandler(service, resolveTierService, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.CanUpdateNotes(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
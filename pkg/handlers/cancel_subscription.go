@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"context"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"time"
+)
+
+type CancelSubscriptionHandler struct {
+	subscription_pb.CancelSubscriptionServer
+	service services.CancelSubscriptionService
+	logger  monitor.GRPCLogger
+}
+
+func (h *CancelSubscriptionHandler) cancelSubscription(ctx context.Context, in *subscription_pb.CancelSubscriptionRequest) (*subscription_pb.Subscription, error) {
+	subscription, err := h.service.Exec(ctx, &models.CancelSubscriptionRequest{
+		UserID:      in.GetUserId(),
+		AtPeriodEnd: in.GetAtPeriodEnd(),
+	}, time.Now())
+	if err != nil {
+		return nil, subscriptionErrorToStatus(err, "cancel subscription")
+	}
+
+	return subscriptionToProto(subscription), nil
+}
+
+func (h *CancelSubscriptionHandler) CancelSubscription(ctx context.Context, in *subscription_pb.CancelSubscriptionRequest) (*subscription_pb.Subscription, error) {
+	res, err := h.cancelSubscription(ctx, in)
+	h.logger.Report(ctx, "CancelSubscription", err)
+	return res, err
+}
+
+func NewCancelSubscriptionHandler(service services.CancelSubscriptionService, logger monitor.GRPCLogger) *CancelSubscriptionHandler {
+	return &CancelSubscriptionHandler{
+		service: service,
+		logger:  logger,
+	}
+}
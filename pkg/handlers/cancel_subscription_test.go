@@ -0,0 +1,114 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestCancelSubscription(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.CancelSubscriptionRequest
+
+		serviceResp *entities.Subscription
+		serviceErr  error
+
+		expect     *subscription_pb.Subscription
+		expectCode codes.Code
+	}{
+		{
+			name: "CancelSubscription",
+			in: &subscription_pb.CancelSubscriptionRequest{
+				UserId:      "user-id-1",
+				AtPeriodEnd: true,
+			},
+			serviceResp: &entities.Subscription{
+				ID:                lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:            "user-id-1",
+				Tier:              "pro",
+				Status:            entities.SubscriptionStatusActive,
+				StartedAt:         lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				EndsAt:            lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:  lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CancelAtPeriodEnd: true,
+				CanceledAt:        lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &subscription_pb.Subscription{
+				SubscriptionId:    "00000000-0000-0000-0000-000000000001",
+				UserId:            "user-id-1",
+				Tier:              "pro",
+				Status:            "active",
+				StartedAt:         timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				EndsAt:            timestamppb.New(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:  timestamppb.New(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CancelAtPeriodEnd: true,
+				CanceledAt:        timestamppb.New(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "NoBillingPeriod",
+			in: &subscription_pb.CancelSubscriptionRequest{
+				UserId:      "user-id-1",
+				AtPeriodEnd: true,
+			},
+			serviceErr: services.ErrNoBillingPeriod,
+			expectCode: codes.FailedPrecondition,
+		},
+		{
+			name: "SubscriptionNotFound",
+			in: &subscription_pb.CancelSubscriptionRequest{
+				UserId: "user-id-1",
+			},
+			serviceErr: services.ErrSubscriptionNotFound,
+			expectCode: codes.NotFound,
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.CancelSubscriptionRequest{
+				UserId: "user-id-1",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.CancelSubscriptionRequest{
+				UserId: "user-id-1",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockCancelSubscriptionService(t)
+			service.
+				On("Exec", context.TODO(), &models.CancelSubscriptionRequest{UserID: tt.in.GetUserId(), AtPeriodEnd: tt.in.GetAtPeriodEnd()}, mock.Anything).
+				Return(tt.serviceResp, tt.serviceErr)
+
+			handler := handlers.NewCancelSubscriptionHandler(service, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.CancelSubscription(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
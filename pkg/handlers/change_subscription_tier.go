@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"context"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"time"
+)
+
+type ChangeSubscriptionTierHandler struct {
+	subscription_pb.ChangeSubscriptionTierServer
+	service services.ChangeSubscriptionTierService
+	logger  monitor.GRPCLogger
+}
+
+func (h *ChangeSubscriptionTierHandler) changeSubscriptionTier(ctx context.Context, in *subscription_pb.ChangeSubscriptionTierRequest) (*subscription_pb.Subscription, error) {
+	subscription, err := h.service.Exec(ctx, &models.ChangeSubscriptionTierRequest{
+		UserID: in.GetUserId(),
+		Tier:   in.GetTier(),
+	}, time.Now())
+	if err != nil {
+		return nil, subscriptionErrorToStatus(err, "change subscription tier")
+	}
+
+	return subscriptionToProto(subscription), nil
+}
+
+func (h *ChangeSubscriptionTierHandler) ChangeSubscriptionTier(ctx context.Context, in *subscription_pb.ChangeSubscriptionTierRequest) (*subscription_pb.Subscription, error) {
+	res, err := h.changeSubscriptionTier(ctx, in)
+	h.logger.Report(ctx, "ChangeSubscriptionTier", err)
+	return res, err
+}
+
+func NewChangeSubscriptionTierHandler(service services.ChangeSubscriptionTierService, logger monitor.GRPCLogger) *ChangeSubscriptionTierHandler {
+	return &ChangeSubscriptionTierHandler{
+		service: service,
+		logger:  logger,
+	}
+}
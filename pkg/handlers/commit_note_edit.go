@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
+)
+
+type CommitNoteEditHandler struct {
+	subscription_pb.CommitNoteEditServer
+	service services.CommitNoteEditService
+	logger  monitor.GRPCLogger
+}
+
+func (h *CommitNoteEditHandler) commitNoteEdit(ctx context.Context, in *subscription_pb.CommitNoteEditRequest) (*emptypb.Empty, error) {
+	err := h.service.Exec(ctx, &models.CommitNoteEditRequest{
+		NoteEditID: in.GetNoteEditId(),
+		AuthorID:   in.GetAuthorId(),
+	}, time.Now())
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		if errors.Is(err, services.ErrNoteEditNotFound) {
+			return nil, status.Error(codes.NotFound, "note edit not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to commit note edit: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CommitNoteEditHandler) CommitNoteEdit(ctx context.Context, in *subscription_pb.CommitNoteEditRequest) (*emptypb.Empty, error) {
+	res, err := h.commitNoteEdit(ctx, in)
+	h.logger.Report(ctx, "CommitNoteEdit", err)
+	return res, err
+}
+
+func NewCommitNoteEditHandler(service services.CommitNoteEditService, logger monitor.GRPCLogger) *CommitNoteEditHandler {
+	return &CommitNoteEditHandler{
+		service: service,
+		logger:  logger,
+	}
+}
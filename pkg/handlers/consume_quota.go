@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+type ConsumeQuotaHandler struct {
+	subscription_pb.ConsumeQuotaServer
+	service            services.ConsumeQuotaService
+	resolveTierService services.ResolveTierService
+	logger             monitor.GRPCLogger
+}
+
+func (h *ConsumeQuotaHandler) consumeQuota(ctx context.Context, in *subscription_pb.ConsumeQuotaRequest) (*subscription_pb.ConsumeQuotaResponse, error) {
+	now := time.Now()
+
+	tier, err := h.resolveTierService.Exec(ctx, in.GetSubjectId(), now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve tier: %v", err)
+	}
+
+	remainingUses, err := h.service.Exec(ctx, &models.ConsumeQuotaRequest{
+		Feature:  in.GetFeature(),
+		Subject:  in.GetSubjectId(),
+		Key:      in.GetKey(),
+		ReadOnly: in.GetReadOnly(),
+	}, tier, now)
+
+	if err != nil {
+		if errors.Is(err, services.ErrQuotaExhausted) {
+			return nil, status.Error(codes.ResourceExhausted, "quota exhausted")
+		}
+		if errors.Is(err, services.ErrUnknownFeature) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown feature: %v", err)
+		}
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to consume quota: %v", err)
+	}
+
+	return &subscription_pb.ConsumeQuotaResponse{
+		RemainingUses:  int32(remainingUses),
+		BillingWarning: tier.BillingWarning,
+	}, nil
+}
+
+func (h *ConsumeQuotaHandler) ConsumeQuota(ctx context.Context, in *subscription_pb.ConsumeQuotaRequest) (*subscription_pb.ConsumeQuotaResponse, error) {
+	res, err := h.consumeQuota(ctx, in)
+	h.logger.Report(ctx, "ConsumeQuota", err)
+	return res, err
+}
+
+func NewConsumeQuotaHandler(
+	service services.ConsumeQuotaService,
+	resolveTierService services.ResolveTierService,
+	logger monitor.GRPCLogger,
+) *ConsumeQuotaHandler {
+	return &ConsumeQuotaHandler{
+		service:            service,
+		resolveTierService: resolveTierService,
+		logger:             logger,
+	}
+}
@@ -0,0 +1,151 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"testing"
+	"time"
+)
+
+func TestConsumeQuota(t *testing.T) {
+	tier := config.TierInformation{
+		Features: map[string]config.FeatureTierInformation{
+			"exports": {
+				MaxUses:       5,
+				CountUsesOver: lo.ToPtr(24 * time.Hour),
+			},
+		},
+	}
+
+	testData := []struct {
+		name string
+
+		in *subscription_pb.ConsumeQuotaRequest
+
+		billingWarning bool
+		resolveTierErr error
+
+		serviceResp int
+		serviceErr  error
+
+		expect     *subscription_pb.ConsumeQuotaResponse
+		expectCode codes.Code
+	}{
+		{
+			name: "ConsumeQuota",
+			in: &subscription_pb.ConsumeQuotaRequest{
+				Feature:   "exports",
+				SubjectId: "user-id-1",
+				Key:       "key-1",
+			},
+			serviceResp: 1,
+			expect: &subscription_pb.ConsumeQuotaResponse{
+				RemainingUses: 1,
+			},
+		},
+		{
+			name: "ConsumeQuota/BillingWarning",
+			in: &subscription_pb.ConsumeQuotaRequest{
+				Feature:   "exports",
+				SubjectId: "user-id-1",
+				Key:       "key-1",
+			},
+			billingWarning: true,
+			serviceResp:    1,
+			expect: &subscription_pb.ConsumeQuotaResponse{
+				RemainingUses:  1,
+				BillingWarning: true,
+			},
+		},
+		{
+			name: "QuotaExhausted",
+			in: &subscription_pb.ConsumeQuotaRequest{
+				Feature:   "exports",
+				SubjectId: "user-id-1",
+				Key:       "key-1",
+			},
+			serviceErr: services.ErrQuotaExhausted,
+			expectCode: codes.ResourceExhausted,
+		},
+		{
+			name: "UnknownFeature",
+			in: &subscription_pb.ConsumeQuotaRequest{
+				Feature:   "enrichments",
+				SubjectId: "user-id-1",
+				Key:       "key-1",
+			},
+			serviceErr: services.ErrUnknownFeature,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.ConsumeQuotaRequest{
+				Feature:   "exports",
+				SubjectId: "user-id-1",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "ResolveTierError",
+			in: &subscription_pb.ConsumeQuotaRequest{
+				Feature:   "exports",
+				SubjectId: "user-id-1",
+				Key:       "key-1",
+			},
+			resolveTierErr: errors.New("internal error"),
+			expectCode:     codes.Internal,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.ConsumeQuotaRequest{
+				Feature:   "exports",
+				SubjectId: "user-id-1",
+				Key:       "key-1",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			resolvedTier := &models.ResolvedTier{Name: "free", TierInformation: tier, BillingWarning: tt.billingWarning}
+
+			resolveTierService := servicesmocks.NewMockResolveTierService(t)
+			resolveTierService.
+				On("Exec", context.TODO(), tt.in.GetSubjectId(), mock.Anything).
+				Return(resolvedTier, tt.resolveTierErr)
+
+			service := servicesmocks.NewMockConsumeQuotaService(t)
+			if tt.resolveTierErr == nil {
+				service.
+					On("Exec", context.TODO(), &models.ConsumeQuotaRequest{
+						Feature:  tt.in.GetFeature(),
+						Subject:  tt.in.GetSubjectId(),
+						Key:      tt.in.GetKey(),
+						ReadOnly: tt.in.GetReadOnly(),
+					}, resolvedTier, mock.Anything).
+					Return(tt.serviceResp, tt.serviceErr)
+			}
+
+			handler := handlers.NewConsumeQuotaHandler(service, resolveTierService, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.ConsumeQuota(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
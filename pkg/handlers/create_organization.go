@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreateOrganizationHandler struct {
+	subscription_pb.CreateOrganizationServer
+	service services.CreateOrganizationService
+	logger  monitor.GRPCLogger
+}
+
+func (h *CreateOrganizationHandler) createOrganization(
+	ctx context.Context, in *subscription_pb.CreateOrganizationRequest,
+) (*subscription_pb.Organization, error) {
+	organization, err := h.service.Exec(ctx, &models.CreateOrganizationRequest{
+		Name: in.GetName(),
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create organization: %v", err)
+	}
+
+	return organizationToProto(organization), nil
+}
+
+func (h *CreateOrganizationHandler) CreateOrganization(
+	ctx context.Context, in *subscription_pb.CreateOrganizationRequest,
+) (*subscription_pb.Organization, error) {
+	res, err := h.createOrganization(ctx, in)
+	h.logger.Report(ctx, "CreateOrganization", err)
+	return res, err
+}
+
+func NewCreateOrganizationHandler(service services.CreateOrganizationService, logger monitor.GRPCLogger) *CreateOrganizationHandler {
+	return &CreateOrganizationHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,83 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestCreateOrganization(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.CreateOrganizationRequest
+
+		serviceResp *entities.Organization
+		serviceErr  error
+
+		expect     *subscription_pb.Organization
+		expectCode codes.Code
+	}{
+		{
+			name: "CreateOrganization",
+			in: &subscription_pb.CreateOrganizationRequest{
+				Name: "Acme",
+			},
+			serviceResp: &entities.Organization{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				Name:      "Acme",
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &subscription_pb.Organization{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				Name:           "Acme",
+				SubscriberId:   "organization:00000000-0000-0000-0000-000000000101",
+				CreatedAt:      timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:       "InvalidRequest",
+			in:         &subscription_pb.CreateOrganizationRequest{},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.CreateOrganizationRequest{
+				Name: "Acme",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockCreateOrganizationService(t)
+			service.
+				On("Exec", context.TODO(), &models.CreateOrganizationRequest{Name: tt.in.GetName()}).
+				Return(tt.serviceResp, tt.serviceErr)
+
+			handler := handlers.NewCreateOrganizationHandler(service, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.CreateOrganization(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
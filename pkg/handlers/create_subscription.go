@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"time"
+)
+
+type CreateSubscriptionHandler struct {
+	subscription_pb.CreateSubscriptionServer
+	service services.CreateSubscriptionService
+	logger  monitor.GRPCLogger
+}
+
+func (h *CreateSubscriptionHandler) createSubscription(ctx context.Context, in *subscription_pb.CreateSubscriptionRequest) (*subscription_pb.Subscription, error) {
+	request := &models.CreateSubscriptionRequest{
+		UserID: in.GetUserId(),
+		Tier:   in.GetTier(),
+	}
+	if in.GetCurrentPeriodEnd() != nil {
+		currentPeriodEnd := in.GetCurrentPeriodEnd().AsTime()
+		request.CurrentPeriodEnd = &currentPeriodEnd
+	}
+
+	subscription, err := h.service.Exec(ctx, request, time.Now())
+	if err != nil {
+		return nil, subscriptionErrorToStatus(err, "create subscription")
+	}
+
+	return subscriptionToProto(subscription), nil
+}
+
+func (h *CreateSubscriptionHandler) CreateSubscription(ctx context.Context, in *subscription_pb.CreateSubscriptionRequest) (*subscription_pb.Subscription, error) {
+	res, err := h.createSubscription(ctx, in)
+	h.logger.Report(ctx, "CreateSubscription", err)
+	return res, err
+}
+
+func NewCreateSubscriptionHandler(service services.CreateSubscriptionService, logger monitor.GRPCLogger) *CreateSubscriptionHandler {
+	return &CreateSubscriptionHandler{
+		service: service,
+		logger:  logger,
+	}
+}
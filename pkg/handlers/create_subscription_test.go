@@ -0,0 +1,121 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestCreateSubscription(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.CreateSubscriptionRequest
+
+		expectRequest *models.CreateSubscriptionRequest
+		serviceResp   *entities.Subscription
+		serviceErr    error
+
+		expect     *subscription_pb.Subscription
+		expectCode codes.Code
+	}{
+		{
+			name: "CreateSubscription",
+			in: &subscription_pb.CreateSubscriptionRequest{
+				UserId:           "user-id-1",
+				Tier:             "pro",
+				CurrentPeriodEnd: timestamppb.New(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expectRequest: &models.CreateSubscriptionRequest{
+				UserID:           "user-id-1",
+				Tier:             "pro",
+				CurrentPeriodEnd: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			serviceResp: &entities.Subscription{
+				ID:                 lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:             "user-id-1",
+				Tier:               "pro",
+				Status:             entities.SubscriptionStatusActive,
+				StartedAt:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodStart: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &subscription_pb.Subscription{
+				SubscriptionId:     "00000000-0000-0000-0000-000000000001",
+				UserId:             "user-id-1",
+				Tier:               "pro",
+				Status:             "active",
+				StartedAt:          timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodStart: timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:   timestamppb.New(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "AlreadyExists",
+			in: &subscription_pb.CreateSubscriptionRequest{
+				UserId: "user-id-1",
+				Tier:   "pro",
+			},
+			expectRequest: &models.CreateSubscriptionRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			serviceErr: services.ErrSubscriptionAlreadyExists,
+			expectCode: codes.AlreadyExists,
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.CreateSubscriptionRequest{
+				UserId: "user-id-1",
+				Tier:   "legacy",
+			},
+			expectRequest: &models.CreateSubscriptionRequest{
+				UserID: "user-id-1",
+				Tier:   "legacy",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.CreateSubscriptionRequest{
+				UserId: "user-id-1",
+				Tier:   "pro",
+			},
+			expectRequest: &models.CreateSubscriptionRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockCreateSubscriptionService(t)
+			service.On("Exec", context.TODO(), tt.expectRequest, mock.Anything).Return(tt.serviceResp, tt.serviceErr)
+
+			handler := handlers.NewCreateSubscriptionHandler(service, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.CreateSubscription(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type DeleteEntitlementOverrideHandler struct {
+	subscription_pb.DeleteEntitlementOverrideServer
+	service services.DeleteEntitlementOverrideService
+	logger  monitor.GRPCLogger
+}
+
+func (h *DeleteEntitlementOverrideHandler) deleteEntitlementOverride(
+	ctx context.Context, in *subscription_pb.DeleteEntitlementOverrideRequest,
+) (*emptypb.Empty, error) {
+	err := h.service.Exec(ctx, &models.DeleteEntitlementOverrideRequest{
+		UserID:      in.GetUserId(),
+		Entitlement: in.GetEntitlement(),
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		if errors.Is(err, services.ErrEntitlementOverrideNotFound) {
+			return nil, status.Error(codes.NotFound, "entitlement override not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to delete entitlement override: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *DeleteEntitlementOverrideHandler) DeleteEntitlementOverride(
+	ctx context.Context, in *subscription_pb.DeleteEntitlementOverrideRequest,
+) (*emptypb.Empty, error) {
+	res, err := h.deleteEntitlementOverride(ctx, in)
+	h.logger.Report(ctx, "DeleteEntitlementOverride", err)
+	return res, err
+}
+
+func NewDeleteEntitlementOverrideHandler(
+	service services.DeleteEntitlementOverrideService, logger monitor.GRPCLogger,
+) *DeleteEntitlementOverrideHandler {
+	return &DeleteEntitlementOverrideHandler{
+		service: service,
+		logger:  logger,
+	}
+}
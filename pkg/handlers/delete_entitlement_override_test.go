@@ -0,0 +1,83 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"testing"
+)
+
+func TestDeleteEntitlementOverride(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.DeleteEntitlementOverrideRequest
+
+		serviceErr error
+
+		expect     *emptypb.Empty
+		expectCode codes.Code
+	}{
+		{
+			name: "DeleteEntitlementOverride",
+			in: &subscription_pb.DeleteEntitlementOverrideRequest{
+				UserId:      "user-id-1",
+				Entitlement: "can-export-csv",
+			},
+			expect: &emptypb.Empty{},
+		},
+		{
+			name: "EntitlementOverrideNotFound",
+			in: &subscription_pb.DeleteEntitlementOverrideRequest{
+				UserId:      "user-id-1",
+				Entitlement: "can-export-csv",
+			},
+			serviceErr: services.ErrEntitlementOverrideNotFound,
+			expectCode: codes.NotFound,
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.DeleteEntitlementOverrideRequest{
+				UserId: "user-id-1",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.DeleteEntitlementOverrideRequest{
+				UserId:      "user-id-1",
+				Entitlement: "can-export-csv",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockDeleteEntitlementOverrideService(t)
+			service.
+				On("Exec", context.TODO(), &models.DeleteEntitlementOverrideRequest{
+					UserID:      tt.in.GetUserId(),
+					Entitlement: tt.in.GetEntitlement(),
+				}).
+				Return(tt.serviceErr)
+
+			handler := handlers.NewDeleteEntitlementOverrideHandler(service, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.DeleteEntitlementOverride(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
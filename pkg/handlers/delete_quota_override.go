@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type DeleteQuotaOverrideHandler struct {
+	subscription_pb.DeleteQuotaOverrideServer
+	service services.DeleteQuotaOverrideService
+	logger  monitor.GRPCLogger
+}
+
+func (h *DeleteQuotaOverrideHandler) deleteQuotaOverride(ctx context.Context, in *subscription_pb.DeleteQuotaOverrideRequest) (*emptypb.Empty, error) {
+	err := h.service.Exec(ctx, &models.DeleteQuotaOverrideRequest{
+		AuthorID: in.GetAuthorId(),
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		if errors.Is(err, services.ErrQuotaOverrideNotFound) {
+			return nil, status.Error(codes.NotFound, "quota override not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to delete quota override: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *DeleteQuotaOverrideHandler) DeleteQuotaOverride(ctx context.Context, in *subscription_pb.DeleteQuotaOverrideRequest) (*emptypb.Empty, error) {
+	res, err := h.deleteQuotaOverride(ctx, in)
+	h.logger.Report(ctx, "DeleteQuotaOverride", err)
+	return res, err
+}
+
+func NewDeleteQuotaOverrideHandler(service services.DeleteQuotaOverrideService, logger monitor.GRPCLogger) *DeleteQuotaOverrideHandler {
+	return &DeleteQuotaOverrideHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,77 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"testing"
+)
+
+func TestDeleteQuotaOverride(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.DeleteQuotaOverrideRequest
+
+		serviceErr error
+
+		expect     *emptypb.Empty
+		expectCode codes.Code
+	}{
+		{
+			name: "DeleteQuotaOverride",
+			in: &subscription_pb.DeleteQuotaOverrideRequest{
+				AuthorId: "author-id-1",
+			},
+			expect: &emptypb.Empty{},
+		},
+		{
+			name: "QuotaOverrideNotFound",
+			in: &subscription_pb.DeleteQuotaOverrideRequest{
+				AuthorId: "author-id-1",
+			},
+			serviceErr: services.ErrQuotaOverrideNotFound,
+			expectCode: codes.NotFound,
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.DeleteQuotaOverrideRequest{
+				AuthorId: "",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.DeleteQuotaOverrideRequest{
+				AuthorId: "author-id-1",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockDeleteQuotaOverrideService(t)
+			service.
+				On("Exec", context.TODO(), &models.DeleteQuotaOverrideRequest{AuthorID: tt.in.GetAuthorId()}).
+				Return(tt.serviceErr)
+
+			handler := handlers.NewDeleteQuotaOverrideHandler(service, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.DeleteQuotaOverride(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+type GetEntitlementsHandler struct {
+	subscription_pb.GetEntitlementsServer
+	service            services.GetEntitlementsService
+	resolveTierService services.ResolveTierService
+	logger             monitor.GRPCLogger
+}
+
+func (h *GetEntitlementsHandler) getEntitlements(ctx context.Context, in *subscription_pb.GetEntitlementsRequest) (*subscription_pb.GetEntitlementsResponse, error) {
+	now := time.Now()
+
+	tier, err := h.resolveTierService.Exec(ctx, in.GetUserId(), now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve tier: %v", err)
+	}
+
+	entitlements, err := h.service.Exec(ctx, &models.GetEntitlementsRequest{
+		UserID: in.GetUserId(),
+	}, tier, now)
+
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get entitlements: %v", err)
+	}
+
+	res := &subscription_pb.GetEntitlementsResponse{
+		Tier:         tier.Name,
+		Entitlements: make(map[string]*subscription_pb.Entitlement, len(entitlements)),
+	}
+	for name, entitlement := range entitlements {
+		res.Entitlements[name] = &subscription_pb.Entitlement{
+			Enabled: entitlement.Enabled,
+			Limit:   intToProto(entitlement.Limit),
+		}
+	}
+
+	return res, nil
+}
+
+func (h *GetEntitlementsHandler) GetEntitlements(ctx context.Context, in *subscription_pb.GetEntitlementsRequest) (*subscription_pb.GetEntitlementsResponse, error) {
+	res, err := h.getEntitlements(ctx, in)
+	h.logger.Report(ctx, "GetEntitlements", err)
+	return res, err
+}
+
+func NewGetEntitlementsHandler(
+	service services.GetEntitlementsService,
+	resolveTierService services.ResolveTierService,
+	logger monitor.GRPCLogger,
+) *GetEntitlementsHandler {
+	return &GetEntitlementsHandler{
+		service:            service,
+		resolveTierService: resolveTierService,
+		logger:             logger,
+	}
+}
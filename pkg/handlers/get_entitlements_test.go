@@ -0,0 +1,108 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+func TestGetEntitlements(t *testing.T) {
+	tier := config.TierInformation{
+		Entitlements: map[string]config.Entitlement{
+			"can-export-csv": {Enabled: true},
+			"max-team-seats": config.NumericEntitlement(10),
+		},
+	}
+
+	testData := []struct {
+		name string
+
+		in *subscription_pb.GetEntitlementsRequest
+
+		resolveTierErr error
+
+		serviceResp map[string]config.Entitlement
+		serviceErr  error
+
+		expect     *subscription_pb.GetEntitlementsResponse
+		expectCode codes.Code
+	}{
+		{
+			name: "GetEntitlements",
+			in: &subscription_pb.GetEntitlementsRequest{
+				UserId: "user-id-1",
+			},
+			serviceResp: map[string]config.Entitlement{
+				"can-export-csv": {Enabled: true},
+				"max-team-seats": config.NumericEntitlement(25),
+			},
+			expect: &subscription_pb.GetEntitlementsResponse{
+				Tier: "team",
+				Entitlements: map[string]*subscription_pb.Entitlement{
+					"can-export-csv": {Enabled: true},
+					"max-team-seats": {Enabled: true, Limit: lo.ToPtr(int32(25))},
+				},
+			},
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.GetEntitlementsRequest{
+				UserId: "user-id-1",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "ResolveTierError",
+			in: &subscription_pb.GetEntitlementsRequest{
+				UserId: "user-id-1",
+			},
+			resolveTierErr: errors.New("internal error"),
+			expectCode:     codes.Internal,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.GetEntitlementsRequest{
+				UserId: "user-id-1",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			resolvedTier := &models.ResolvedTier{Name: "team", TierInformation: tier}
+
+			resolveTierService := servicesmocks.NewMockResolveTierService(t)
+			resolveTierService.
+				On("Exec", context.TODO(), tt.in.GetUserId(), mock.Anything).
+				Return(resolvedTier, tt.resolveTierErr)
+
+			service := servicesmocks.NewMockGetEntitlementsService(t)
+			if tt.resolveTierErr == nil {
+				service.
+					On("Exec", context.TODO(), &models.GetEntitlementsRequest{UserID: tt.in.GetUserId()}, resolvedTier, mock.Anything).
+					Return(tt.serviceResp, tt.serviceErr)
+			}
+
+			handler := handlers.NewGetEntitlementsHandler(service, resolveTierService, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.GetEntitlements(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+)
+
+type GetSubscriptionHandler struct {
+	subscription_pb.GetSubscriptionServer
+	service services.GetSubscriptionService
+	logger  monitor.GRPCLogger
+}
+
+func (h *GetSubscriptionHandler) getSubscription(ctx context.Context, in *subscription_pb.GetSubscriptionRequest) (*subscription_pb.Subscription, error) {
+	subscription, err := h.service.Exec(ctx, &models.GetSubscriptionRequest{
+		UserID: in.GetUserId(),
+	})
+	if err != nil {
+		return nil, subscriptionErrorToStatus(err, "get subscription")
+	}
+
+	return subscriptionToProto(subscription), nil
+}
+
+func (h *GetSubscriptionHandler) GetSubscription(ctx context.Context, in *subscription_pb.GetSubscriptionRequest) (*subscription_pb.Subscription, error) {
+	res, err := h.getSubscription(ctx, in)
+	h.logger.Report(ctx, "GetSubscription", err)
+	return res, err
+}
+
+func NewGetSubscriptionHandler(service services.GetSubscriptionService, logger monitor.GRPCLogger) *GetSubscriptionHandler {
+	return &GetSubscriptionHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type GetUsageHandler struct {
+	subscription_pb.GetUsageServer
+	service            services.GetUsageService
+	resolveTierService services.ResolveTierService
+	logger             monitor.GRPCLogger
+}
+
+func (h *GetUsageHandler) getUsage(ctx context.Context, in *subscription_pb.GetUsageRequest) (*subscription_pb.GetUsageResponse, error) {
+	now := time.Now()
+
+	tier, err := h.resolveTierService.Exec(ctx, in.GetUserId(), now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve tier: %v", err)
+	}
+
+	usage, err := h.service.Exec(ctx, &models.GetUsageRequest{
+		UserID: in.GetUserId(),
+	}, tier, now)
+
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get usage: %v", err)
+	}
+
+	res := &subscription_pb.GetUsageResponse{
+		Tier:        tier.Name,
+		Used:        int32(usage.Used),
+		Limit:       int32(usage.Limit),
+		Remaining:   int32(usage.Remaining),
+		WindowStart: timestamppb.New(usage.WindowStart),
+		WindowEnd:   timeToProto(usage.WindowEnd),
+	}
+	if usage.OldestEditExpiresIn != nil {
+		res.OldestEditExpiresIn = durationpb.New(*usage.OldestEditExpiresIn)
+	}
+	if usage.OrganizationID != nil {
+		res.OrganizationId = lo.ToPtr(usage.OrganizationID.String())
+		res.MemberUsed = int32(usage.MemberUsed)
+		res.MemberLimit = intToProto(usage.MemberLimit)
+	}
+
+	return res, nil
+}
+
+func (h *GetUsageHandler) GetUsage(ctx context.Context, in *subscription_pb.GetUsageRequest) (*subscription_pb.GetUsageResponse, error) {
+	res, err := h.getUsage(ctx, in)
+	h.logger.Report(ctx, "GetUsage", err)
+	return res, err
+}
+
+func NewGetUsageHandler(
+	service services.GetUsageService,
+	resolveTierService services.ResolveTierService,
+	logger monitor.GRPCLogger,
+) *GetUsageHandler {
+	return &GetUsageHandler{
+		service:            service,
+		resolveTierService: resolveTierService,
+		logger:             logger,
+	}
+}
@@ -0,0 +1,183 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestGetUsage(t *testing.T) {
+	tier := config.TierInformation{
+		Features: map[string]config.FeatureTierInformation{
+			config.NoteEditsFeature: {
+				CountUsesOver: lo.ToPtr(24 * time.Hour),
+				MaxUses:       5,
+			},
+		},
+	}
+
+	testData := []struct {
+		name string
+
+		in *subscription_pb.GetUsageRequest
+
+		resolveTierErr error
+
+		serviceResp *models.Usage
+		serviceErr  error
+
+		expect     *subscription_pb.GetUsageResponse
+		expectCode codes.Code
+	}{
+		{
+			name: "GetUsage",
+			in: &subscription_pb.GetUsageRequest{
+				UserId: "user-id-1",
+			},
+			serviceResp: &models.Usage{
+				Used:                2,
+				Limit:               5,
+				Remaining:           3,
+				WindowStart:         time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC),
+				OldestEditExpiresIn: lo.ToPtr(6 * time.Hour),
+			},
+			expect: &subscription_pb.GetUsageResponse{
+				Tier:                "pro",
+				Used:                2,
+				Limit:               5,
+				Remaining:           3,
+				WindowStart:         timestamppb.New(time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC)),
+				OldestEditExpiresIn: durationpb.New(6 * time.Hour),
+			},
+		},
+		{
+			name: "GetUsage/NoEdits",
+			in: &subscription_pb.GetUsageRequest{
+				UserId: "user-id-1",
+			},
+			serviceResp: &models.Usage{
+				Limit:       5,
+				Remaining:   5,
+				WindowStart: time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC),
+			},
+			expect: &subscription_pb.GetUsageResponse{
+				Tier:        "pro",
+				Limit:       5,
+				Remaining:   5,
+				WindowStart: timestamppb.New(time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "GetUsage/CalendarWindow",
+			in: &subscription_pb.GetUsageRequest{
+				UserId: "user-id-1",
+			},
+			serviceResp: &models.Usage{
+				Used:                40,
+				Limit:               100,
+				Remaining:           60,
+				WindowStart:         time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				WindowEnd:           lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				OldestEditExpiresIn: lo.ToPtr(12 * time.Hour),
+			},
+			expect: &subscription_pb.GetUsageResponse{
+				Tier:                "pro",
+				Used:                40,
+				Limit:               100,
+				Remaining:           60,
+				WindowStart:         timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				WindowEnd:           timestamppb.New(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				OldestEditExpiresIn: durationpb.New(12 * time.Hour),
+			},
+		},
+		{
+			name: "GetUsage/Organization",
+			in: &subscription_pb.GetUsageRequest{
+				UserId: "user-id-1",
+			},
+			serviceResp: &models.Usage{
+				Used:           30,
+				Limit:          50,
+				Remaining:      2,
+				WindowStart:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				MemberUsed:     8,
+				MemberLimit:    lo.ToPtr(10),
+			},
+			expect: &subscription_pb.GetUsageResponse{
+				Tier:           "pro",
+				Used:           30,
+				Limit:          50,
+				Remaining:      2,
+				WindowStart:    timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				OrganizationId: lo.ToPtr("00000000-0000-0000-0000-000000000101"),
+				MemberUsed:     8,
+				MemberLimit:    lo.ToPtr(int32(10)),
+			},
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.GetUsageRequest{
+				UserId: "user-id-1",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "ResolveTierError",
+			in: &subscription_pb.GetUsageRequest{
+				UserId: "user-id-1",
+			},
+			resolveTierErr: errors.New("internal error"),
+			expectCode:     codes.Internal,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.GetUsageRequest{
+				UserId: "user-id-1",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			resolvedTier := &models.ResolvedTier{Name: "pro", TierInformation: tier}
+
+			resolveTierService := servicesmocks.NewMockResolveTierService(t)
+			resolveTierService.
+				On("Exec", context.TODO(), tt.in.GetUserId(), mock.Anything).
+				Return(resolvedTier, tt.resolveTierErr)
+
+			service := servicesmocks.NewMockGetUsageService(t)
+			if tt.resolveTierErr == nil {
+				service.
+					On("Exec", context.TODO(), &models.GetUsageRequest{UserID: tt.in.GetUserId()}, resolvedTier, mock.Anything).
+					Return(tt.serviceResp, tt.serviceErr)
+			}
+
+			handler := handlers.NewGetUsageHandler(service, resolveTierService, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.GetUsage(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListOrganizationMembersHandler struct {
+	subscription_pb.ListOrganizationMembersServer
+	service services.ListOrganizationMembersService
+	logger  monitor.GRPCLogger
+}
+
+func (h *ListOrganizationMembersHandler) listOrganizationMembers(
+	ctx context.Context, in *subscription_pb.ListOrganizationMembersRequest,
+) (*subscription_pb.ListOrganizationMembersResponse, error) {
+	members, err := h.service.Exec(ctx, &models.ListOrganizationMembersRequest{
+		OrganizationID: in.GetOrganizationId(),
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		if errors.Is(err, services.ErrOrganizationNotFound) {
+			return nil, status.Error(codes.NotFound, "organization not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to list organization members: %v", err)
+	}
+
+	return &subscription_pb.ListOrganizationMembersResponse{
+		Members: lo.Map(members, func(member *entities.OrganizationMember, _ int) *subscription_pb.OrganizationMember {
+			return organizationMemberToProto(member)
+		}),
+	}, nil
+}
+
+func (h *ListOrganizationMembersHandler) ListOrganizationMembers(
+	ctx context.Context, in *subscription_pb.ListOrganizationMembersRequest,
+) (*subscription_pb.ListOrganizationMembersResponse, error) {
+	res, err := h.listOrganizationMembers(ctx, in)
+	h.logger.Report(ctx, "ListOrganizationMembers", err)
+	return res, err
+}
+
+func NewListOrganizationMembersHandler(
+	service services.ListOrganizationMembersService, logger monitor.GRPCLogger,
+) *ListOrganizationMembersHandler {
+	return &ListOrganizationMembersHandler{
+		service: service,
+		logger:  logger,
+	}
+}
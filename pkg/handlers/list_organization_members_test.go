@@ -0,0 +1,113 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestListOrganizationMembers(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.ListOrganizationMembersRequest
+
+		serviceResp []*entities.OrganizationMember
+		serviceErr  error
+
+		expect     *subscription_pb.ListOrganizationMembersResponse
+		expectCode codes.Code
+	}{
+		{
+			name: "ListOrganizationMembers",
+			in: &subscription_pb.ListOrganizationMembersRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+			},
+			serviceResp: []*entities.OrganizationMember{
+				{
+					OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+					UserID:         "user-id-1",
+					CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+					UpdatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				{
+					OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+					UserID:         "user-id-2",
+					MaxEdits:       lo.ToPtr(5),
+					CreatedAt:      lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+					UpdatedAt:      lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			expect: &subscription_pb.ListOrganizationMembersResponse{
+				Members: []*subscription_pb.OrganizationMember{
+					{
+						OrganizationId: "00000000-0000-0000-0000-000000000101",
+						UserId:         "user-id-1",
+						CreatedAt:      timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+						UpdatedAt:      timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+					},
+					{
+						OrganizationId: "00000000-0000-0000-0000-000000000101",
+						UserId:         "user-id-2",
+						MaxEdits:       lo.ToPtr(int32(5)),
+						CreatedAt:      timestamppb.New(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+						UpdatedAt:      timestamppb.New(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+					},
+				},
+			},
+		},
+		{
+			name: "OrganizationNotFound",
+			in: &subscription_pb.ListOrganizationMembersRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+			},
+			serviceErr: services.ErrOrganizationNotFound,
+			expectCode: codes.NotFound,
+		},
+		{
+			name:       "InvalidRequest",
+			in:         &subscription_pb.ListOrganizationMembersRequest{},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.ListOrganizationMembersRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockListOrganizationMembersService(t)
+			service.
+				On("Exec", context.TODO(), &models.ListOrganizationMembersRequest{
+					OrganizationID: tt.in.GetOrganizationId(),
+				}).
+				Return(tt.serviceResp, tt.serviceErr)
+
+			handler := handlers.NewListOrganizationMembersHandler(service, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.ListOrganizationMembers(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+)
+
+func organizationToProto(organization *entities.Organization) *subscription_pb.Organization {
+	return &subscription_pb.Organization{
+		OrganizationId: organization.ID.String(),
+		Name:           organization.Name,
+		SubscriberId:   entities.OrganizationSubscriberID(*organization.ID),
+		CreatedAt:      timeToProto(organization.CreatedAt),
+	}
+}
+
+func organizationMemberToProto(member *entities.OrganizationMember) *subscription_pb.OrganizationMember {
+	return &subscription_pb.OrganizationMember{
+		OrganizationId: member.OrganizationID.String(),
+		UserId:         member.UserID,
+		MaxEdits:       intToProto(member.MaxEdits),
+		CreatedAt:      timeToProto(member.CreatedAt),
+		UpdatedAt:      timeToProto(member.UpdatedAt),
+	}
+}
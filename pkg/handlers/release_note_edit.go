@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
+)
+
+type ReleaseNoteEditHandler struct {
+	subscription_pb.ReleaseNoteEditServer
+	service services.ReleaseNoteEditService
+	logger  monitor.GRPCLogger
+}
+
+func (h *ReleaseNoteEditHandler) releaseNoteEdit(ctx context.Context, in *subscription_pb.ReleaseNoteEditRequest) (*emptypb.Empty, error) {
+	err := h.service.Exec(ctx, &models.ReleaseNoteEditRequest{
+		NoteEditID: in.GetNoteEditId(),
+		AuthorID:   in.GetAuthorId(),
+	}, time.Now())
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		if errors.Is(err, services.ErrNoteEditNotFound) {
+			return nil, status.Error(codes.NotFound, "note edit not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to release note edit: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ReleaseNoteEditHandler) ReleaseNoteEdit(ctx context.Context, in *subscription_pb.ReleaseNoteEditRequest) (*emptypb.Empty, error) {
+	res, err := h.releaseNoteEdit(ctx, in)
+	h.logger.Report(ctx, "ReleaseNoteEdit", err)
+	return res, err
+}
+
+func NewReleaseNoteEditHandler(service services.ReleaseNoteEditService, logger monitor.GRPCLogger) *ReleaseNoteEditHandler {
+	return &ReleaseNoteEditHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,85 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"testing"
+)
+
+func TestReleaseNoteEdit(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.ReleaseNoteEditRequest
+
+		serviceErr error
+
+		expect     *emptypb.Empty
+		expectCode codes.Code
+	}{
+		{
+			name: "ReleaseNoteEdit",
+			in: &subscription_pb.ReleaseNoteEditRequest{
+				NoteEditId: "00000000-0000-0000-0000-000000000001",
+				AuthorId:   "author-id-1",
+			},
+			expect: &emptypb.Empty{},
+		},
+		{
+			name: "NoteEditNotFound",
+			in: &subscription_pb.ReleaseNoteEditRequest{
+				NoteEditId: "00000000-0000-0000-0000-000000000001",
+				AuthorId:   "author-id-1",
+			},
+			serviceErr: services.ErrNoteEditNotFound,
+			expectCode: codes.NotFound,
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.ReleaseNoteEditRequest{
+				NoteEditId: "note-edit-id-1",
+				AuthorId:   "author-id-1",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.ReleaseNoteEditRequest{
+				NoteEditId: "00000000-0000-0000-0000-000000000001",
+				AuthorId:   "author-id-1",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockReleaseNoteEditService(t)
+			service.
+				On("Exec", context.TODO(), &models.ReleaseNoteEditRequest{
+					NoteEditID: tt.in.GetNoteEditId(),
+					AuthorID:   tt.in.GetAuthorId(),
+				}, mock.Anything).
+				Return(tt.serviceErr)
+
+			handler := handlers.NewReleaseNoteEditHandler(service, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.ReleaseNoteEdit(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
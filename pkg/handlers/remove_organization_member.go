@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type RemoveOrganizationMemberHandler struct {
+	subscription_pb.RemoveOrganizationMemberServer
+	service services.RemoveOrganizationMemberService
+	logger  monitor.GRPCLogger
+}
+
+func (h *RemoveOrganizationMemberHandler) removeOrganizationMember(
+	ctx context.Context, in *subscription_pb.RemoveOrganizationMemberRequest,
+) (*emptypb.Empty, error) {
+	err := h.service.Exec(ctx, &models.RemoveOrganizationMemberRequest{
+		OrganizationID: in.GetOrganizationId(),
+		UserID:         in.GetUserId(),
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		if errors.Is(err, services.ErrOrganizationMemberNotFound) {
+			return nil, status.Error(codes.NotFound, "organization member not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to remove organization member: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *RemoveOrganizationMemberHandler) RemoveOrganizationMember(
+	ctx context.Context, in *subscription_pb.RemoveOrganizationMemberRequest,
+) (*emptypb.Empty, error) {
+	res, err := h.removeOrganizationMember(ctx, in)
+	h.logger.Report(ctx, "RemoveOrganizationMember", err)
+	return res, err
+}
+
+func NewRemoveOrganizationMemberHandler(
+	service services.RemoveOrganizationMemberService, logger monitor.GRPCLogger,
+) *RemoveOrganizationMemberHandler {
+	return &RemoveOrganizationMemberHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,83 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"testing"
+)
+
+func TestRemoveOrganizationMember(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.RemoveOrganizationMemberRequest
+
+		serviceErr error
+
+		expect     *emptypb.Empty
+		expectCode codes.Code
+	}{
+		{
+			name: "RemoveOrganizationMember",
+			in: &subscription_pb.RemoveOrganizationMemberRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				UserId:         "user-id-1",
+			},
+			expect: &emptypb.Empty{},
+		},
+		{
+			name: "OrganizationMemberNotFound",
+			in: &subscription_pb.RemoveOrganizationMemberRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				UserId:         "user-id-1",
+			},
+			serviceErr: services.ErrOrganizationMemberNotFound,
+			expectCode: codes.NotFound,
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.RemoveOrganizationMemberRequest{
+				UserId: "user-id-1",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.RemoveOrganizationMemberRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				UserId:         "user-id-1",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockRemoveOrganizationMemberService(t)
+			service.
+				On("Exec", context.TODO(), &models.RemoveOrganizationMemberRequest{
+					OrganizationID: tt.in.GetOrganizationId(),
+					UserID:         tt.in.GetUserId(),
+				}).
+				Return(tt.serviceErr)
+
+			handler := handlers.NewRemoveOrganizationMemberHandler(service, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.RemoveOrganizationMember(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
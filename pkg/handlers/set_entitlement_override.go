@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+type SetEntitlementOverrideHandler struct {
+	subscription_pb.SetEntitlementOverrideServer
+	service services.SetEntitlementOverrideService
+	logger  monitor.GRPCLogger
+}
+
+func (h *SetEntitlementOverrideHandler) setEntitlementOverride(
+	ctx context.Context, in *subscription_pb.SetEntitlementOverrideRequest,
+) (*subscription_pb.EntitlementOverride, error) {
+	request := &models.SetEntitlementOverrideRequest{
+		UserID:      in.GetUserId(),
+		Entitlement: in.GetEntitlement(),
+		Enabled:     in.GetEnabled(),
+	}
+	if in.Limit != nil {
+		request.Limit = lo.ToPtr(int(in.GetLimit()))
+	}
+	if in.GetExpiresAt() != nil {
+		expiresAt := in.GetExpiresAt().AsTime()
+		request.ExpiresAt = &expiresAt
+	}
+
+	override, err := h.service.Exec(ctx, request, time.Now())
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		if errors.Is(err, services.ErrUnknownEntitlement) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown entitlement: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set entitlement override: %v", err)
+	}
+
+	return &subscription_pb.EntitlementOverride{
+		EntitlementOverrideId: override.ID.String(),
+		UserId:                override.UserID,
+		Entitlement:           override.Entitlement,
+		Enabled:               override.Enabled,
+		Limit:                 intToProto(override.Limit),
+		ExpiresAt:             timeToProto(override.ExpiresAt),
+		CreatedAt:             timeToProto(override.CreatedAt),
+		UpdatedAt:             timeToProto(override.UpdatedAt),
+	}, nil
+}
+
+func (h *SetEntitlementOverrideHandler) SetEntitlementOverride(
+	ctx context.Context, in *subscription_pb.SetEntitlementOverrideRequest,
+) (*subscription_pb.EntitlementOverride, error) {
+	res, err := h.setEntitlementOverride(ctx, in)
+	h.logger.Report(ctx, "SetEntitlementOverride", err)
+	return res, err
+}
+
+func NewSetEntitlementOverrideHandler(service services.SetEntitlementOverrideService, logger monitor.GRPCLogger) *SetEntitlementOverrideHandler {
+	return &SetEntitlementOverrideHandler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,158 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestSetEntitlementOverride(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.SetEntitlementOverrideRequest
+
+		expectRequest *models.SetEntitlementOverrideRequest
+		serviceResp   *entities.EntitlementOverride
+		serviceErr    error
+
+		expect     *subscription_pb.EntitlementOverride
+		expectCode codes.Code
+	}{
+		{
+			name: "SetEntitlementOverride",
+			in: &subscription_pb.SetEntitlementOverrideRequest{
+				UserId:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Enabled:     true,
+				ExpiresAt:   timestamppb.New(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expectRequest: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Enabled:     true,
+				ExpiresAt:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			serviceResp: &entities.EntitlementOverride{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Enabled:     true,
+				ExpiresAt:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &subscription_pb.EntitlementOverride{
+				EntitlementOverrideId: "00000000-0000-0000-0000-000000000001",
+				UserId:                "user-id-1",
+				Entitlement:           "can-export-csv",
+				Enabled:               true,
+				ExpiresAt:             timestamppb.New(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAt:             timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt:             timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "SetEntitlementOverride/Numeric",
+			in: &subscription_pb.SetEntitlementOverrideRequest{
+				UserId:      "user-id-1",
+				Entitlement: "max-team-seats",
+				Limit:       lo.ToPtr(int32(25)),
+			},
+			expectRequest: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+				Limit:       lo.ToPtr(25),
+			},
+			serviceResp: &entities.EntitlementOverride{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+				Enabled:     true,
+				Limit:       lo.ToPtr(25),
+				CreatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &subscription_pb.EntitlementOverride{
+				EntitlementOverrideId: "00000000-0000-0000-0000-000000000001",
+				UserId:                "user-id-1",
+				Entitlement:           "max-team-seats",
+				Enabled:               true,
+				Limit:                 lo.ToPtr(int32(25)),
+				CreatedAt:             timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt:             timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "UnknownEntitlement",
+			in: &subscription_pb.SetEntitlementOverrideRequest{
+				UserId:      "user-id-1",
+				Entitlement: "can-fly",
+				Enabled:     true,
+			},
+			expectRequest: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "can-fly",
+				Enabled:     true,
+			},
+			serviceErr: services.ErrUnknownEntitlement,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.SetEntitlementOverrideRequest{
+				UserId:      "user-id-1",
+				Entitlement: "max-team-seats",
+			},
+			expectRequest: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.SetEntitlementOverrideRequest{
+				UserId:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Enabled:     true,
+			},
+			expectRequest: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Enabled:     true,
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockSetEntitlementOverrideService(t)
+			service.On("Exec", context.TODO(), tt.expectRequest, mock.Anything).Return(tt.serviceResp, tt.serviceErr)
+
+			handler := handlers.NewSetEntitlementOverrideHandler(service, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.SetEntitlementOverride(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+type SetOrganizationMemberHandler struct {
+	subscription_pb.SetOrganizationMemberServer
+	service            services.SetOrganizationMemberService
+	resolveTierService services.ResolveTierService
+	logger             monitor.GRPCLogger
+}
+
+func (h *SetOrganizationMemberHandler) setOrganizationMember(
+	ctx context.Context, in *subscription_pb.SetOrganizationMemberRequest,
+) (*subscription_pb.OrganizationMember, error) {
+	organizationID, err := uuid.Parse(in.GetOrganizationId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization id: %v", err)
+	}
+
+	// Seats come from the tier the organization is subscribed to.
+	organizationTier, err := h.resolveTierService.Exec(ctx, entities.OrganizationSubscriberID(organizationID), time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve tier: %v", err)
+	}
+
+	request := &models.SetOrganizationMemberRequest{
+		OrganizationID: in.GetOrganizationId(),
+		UserID:         in.GetUserId(),
+	}
+	if in.MaxEdits != nil {
+		request.MaxEdits = lo.ToPtr(int(in.GetMaxEdits()))
+	}
+
+	member, err := h.service.Exec(ctx, request, organizationTier)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		if errors.Is(err, services.ErrOrganizationNotFound) {
+			return nil, status.Error(codes.NotFound, "organization not found")
+		}
+		if errors.Is(err, services.ErrMemberOfAnotherOrganization) {
+			return nil, status.Error(codes.FailedPrecondition, "user is a member of another organization")
+		}
+		if errors.Is(err, services.ErrNoSeatsAvailable) {
+			return nil, status.Error(codes.ResourceExhausted, "no seats available in organization")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set organization member: %v", err)
+	}
+
+	return organizationMemberToProto(member), nil
+}
+
+func (h *SetOrganizationMemberHandler) SetOrganizationMember(
+	ctx context.Context, in *subscription_pb.SetOrganizationMemberRequest,
+) (*subscription_pb.OrganizationMember, error) {
+	res, err := h.setOrganizationMember(ctx, in)
+	h.logger.Report(ctx, "SetOrganizationMember", err)
+	return res, err
+}
+
+func NewSetOrganizationMemberHandler(
+	service services.SetOrganizationMemberService,
+	resolveTierService services.ResolveTierService,
+	logger monitor.GRPCLogger,
+) *SetOrganizationMemberHandler {
+	return &SetOrganizationMemberHandler{
+		service:            service,
+		resolveTierService: resolveTierService,
+		logger:             logger,
+	}
+}
@@ -0,0 +1,179 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestSetOrganizationMember(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.SetOrganizationMemberRequest
+
+		resolveTierErr error
+
+		expectRequest *models.SetOrganizationMemberRequest
+		serviceResp   *entities.OrganizationMember
+		serviceErr    error
+
+		expect     *subscription_pb.OrganizationMember
+		expectCode codes.Code
+	}{
+		{
+			name: "SetOrganizationMember",
+			in: &subscription_pb.SetOrganizationMemberRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				UserId:         "user-id-1",
+				MaxEdits:       lo.ToPtr(int32(10)),
+			},
+			expectRequest: &models.SetOrganizationMemberRequest{
+				OrganizationID: "00000000-0000-0000-0000-000000000101",
+				UserID:         "user-id-1",
+				MaxEdits:       lo.ToPtr(10),
+			},
+			serviceResp: &entities.OrganizationMember{
+				ID:             lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				OrganizationID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				UserID:         "user-id-1",
+				MaxEdits:       lo.ToPtr(10),
+				CreatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &subscription_pb.OrganizationMember{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				UserId:         "user-id-1",
+				MaxEdits:       lo.ToPtr(int32(10)),
+				CreatedAt:      timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt:      timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "OrganizationNotFound",
+			in: &subscription_pb.SetOrganizationMemberRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				UserId:         "user-id-1",
+			},
+			expectRequest: &models.SetOrganizationMemberRequest{
+				OrganizationID: "00000000-0000-0000-0000-000000000101",
+				UserID:         "user-id-1",
+			},
+			serviceErr: services.ErrOrganizationNotFound,
+			expectCode: codes.NotFound,
+		},
+		{
+			name: "MemberOfAnotherOrganization",
+			in: &subscription_pb.SetOrganizationMemberRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				UserId:         "user-id-1",
+			},
+			expectRequest: &models.SetOrganizationMemberRequest{
+				OrganizationID: "00000000-0000-0000-0000-000000000101",
+				UserID:         "user-id-1",
+			},
+			serviceErr: services.ErrMemberOfAnotherOrganization,
+			expectCode: codes.FailedPrecondition,
+		},
+		{
+			name: "NoSeatsAvailable",
+			in: &subscription_pb.SetOrganizationMemberRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				UserId:         "user-id-1",
+			},
+			expectRequest: &models.SetOrganizationMemberRequest{
+				OrganizationID: "00000000-0000-0000-0000-000000000101",
+				UserID:         "user-id-1",
+			},
+			serviceErr: services.ErrNoSeatsAvailable,
+			expectCode: codes.ResourceExhausted,
+		},
+		{
+			name: "InvalidOrganizationID",
+			in: &subscription_pb.SetOrganizationMemberRequest{
+				OrganizationId: "acme",
+				UserId:         "user-id-1",
+			},
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.SetOrganizationMemberRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				UserId:         "user-id-1",
+				MaxEdits:       lo.ToPtr(int32(-1)),
+			},
+			expectRequest: &models.SetOrganizationMemberRequest{
+				OrganizationID: "00000000-0000-0000-0000-000000000101",
+				UserID:         "user-id-1",
+				MaxEdits:       lo.ToPtr(-1),
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "ResolveTierError",
+			in: &subscription_pb.SetOrganizationMemberRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				UserId:         "user-id-1",
+			},
+			resolveTierErr: errors.New("internal error"),
+			expectCode:     codes.Internal,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.SetOrganizationMemberRequest{
+				OrganizationId: "00000000-0000-0000-0000-000000000101",
+				UserId:         "user-id-1",
+			},
+			expectRequest: &models.SetOrganizationMemberRequest{
+				OrganizationID: "00000000-0000-0000-0000-000000000101",
+				UserID:         "user-id-1",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationTier := &models.ResolvedTier{Name: "team", TierInformation: config.TierInformation{Seats: 10}}
+
+			resolveTierService := servicesmocks.NewMockResolveTierService(t)
+			if tt.expectRequest != nil || tt.resolveTierErr != nil {
+				resolveTierService.
+					On("Exec", context.TODO(), "organization:"+tt.in.GetOrganizationId(), mock.Anything).
+					Return(organizationTier, tt.resolveTierErr)
+			}
+
+			service := servicesmocks.NewMockSetOrganizationMemberService(t)
+			if tt.expectRequest != nil {
+				service.
+					On("Exec", context.TODO(), tt.expectRequest, organizationTier).
+					Return(tt.serviceResp, tt.serviceErr)
+			}
+
+			handler := handlers.NewSetOrganizationMemberHandler(service, resolveTierService, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.SetOrganizationMember(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
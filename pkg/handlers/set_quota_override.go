@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+type SetQuotaOverrideHandler struct {
+	subscription_pb.SetQuotaOverrideServer
+	service services.SetQuotaOverrideService
+	logger  monitor.GRPCLogger
+}
+
+func (h *SetQuotaOverrideHandler) setQuotaOverride(ctx context.Context, in *subscription_pb.SetQuotaOverrideRequest) (*subscription_pb.QuotaOverride, error) {
+	request := &models.SetQuotaOverrideRequest{
+		AuthorID: in.GetAuthorId(),
+		Kind:     in.GetKind(),
+		MaxEdits: int(in.GetMaxEdits()),
+	}
+	if in.GetExpiresAt() != nil {
+		expiresAt := in.GetExpiresAt().AsTime()
+		request.ExpiresAt = &expiresAt
+	}
+
+	override, err := h.service.Exec(ctx, request, time.Now())
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRequest) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set quota override: %v", err)
+	}
+
+	return &subscription_pb.QuotaOverride{
+		QuotaOverrideId: override.ID.String(),
+		AuthorId:        override.AuthorID,
+		Kind:            string(override.Kind),
+		MaxEdits:        int32(override.MaxEdits),
+		ExpiresAt:       timeToProto(override.ExpiresAt),
+		CreatedAt:       timeToProto(override.CreatedAt),
+		UpdatedAt:       timeToProto(override.UpdatedAt),
+	}, nil
+}
+
+func (h *SetQuotaOverrideHandler) SetQuotaOverride(ctx context.Context, in *subscription_pb.SetQuotaOverrideRequest) (*subscription_pb.QuotaOverride, error) {
+	res, err := h.setQuotaOverride(ctx, in)
+	h.logger.Report(ctx, "SetQuotaOverride", err)
+	return res, err
+}
+
+func NewSetQuotaOverrideHandler(service services.SetQuotaOverrideService, logger monitor.GRPCLogger) *SetQuotaOverrideHandler {
+	return &SetQuotaOverrideHandler{
+		service: service,
+		logger:  logger,
+	}
+}
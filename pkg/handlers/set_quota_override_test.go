@@ -0,0 +1,110 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestSetQuotaOverride(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.SetQuotaOverrideRequest
+
+		expectRequest *models.SetQuotaOverrideRequest
+		serviceResp   *entities.QuotaOverride
+		serviceErr    error
+
+		expect     *subscription_pb.QuotaOverride
+		expectCode codes.Code
+	}{
+		{
+			name: "SetQuotaOverride",
+			in: &subscription_pb.SetQuotaOverrideRequest{
+				AuthorId:  "author-id-1",
+				Kind:      "add",
+				MaxEdits:  10,
+				ExpiresAt: timestamppb.New(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expectRequest: &models.SetQuotaOverrideRequest{
+				AuthorID:  "author-id-1",
+				Kind:      "add",
+				MaxEdits:  10,
+				ExpiresAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			serviceResp: &entities.QuotaOverride{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID:  "author-id-1",
+				Kind:      entities.QuotaOverrideKindAdd,
+				MaxEdits:  10,
+				ExpiresAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &subscription_pb.QuotaOverride{
+				QuotaOverrideId: "00000000-0000-0000-0000-000000000001",
+				AuthorId:        "author-id-1",
+				Kind:            "add",
+				MaxEdits:        10,
+				ExpiresAt:       timestamppb.New(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAt:       timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt:       timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "InvalidRequest",
+			in: &subscription_pb.SetQuotaOverrideRequest{
+				AuthorId: "author-id-1",
+				Kind:     "multiply",
+			},
+			expectRequest: &models.SetQuotaOverrideRequest{
+				AuthorID: "author-id-1",
+				Kind:     "multiply",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.SetQuotaOverrideRequest{
+				AuthorId: "author-id-1",
+				Kind:     "disable",
+			},
+			expectRequest: &models.SetQuotaOverrideRequest{
+				AuthorID: "author-id-1",
+				Kind:     "disable",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockSetQuotaOverrideService(t)
+			service.On("Exec", context.TODO(), tt.expectRequest, mock.Anything).Return(tt.serviceResp, tt.serviceErr)
+
+			handler := handlers.NewSetQuotaOverrideHandler(service, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.SetQuotaOverride(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
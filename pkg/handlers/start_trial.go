@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"time"
+)
+
+type StartTrialHandler struct {
+	subscription_pb.StartTrialServer
+	service services.StartTrialService
+	logger  monitor.GRPCLogger
+}
+
+func (h *StartTrialHandler) startTrial(ctx context.Context, in *subscription_pb.StartTrialRequest) (*subscription_pb.Subscription, error) {
+	subscription, err := h.service.Exec(ctx, &models.StartTrialRequest{UserID: in.GetUserId()}, time.Now())
+	if err != nil {
+		return nil, subscriptionErrorToStatus(err, "start trial")
+	}
+
+	return subscriptionToProto(subscription), nil
+}
+
+func (h *StartTrialHandler) StartTrial(ctx context.Context, in *subscription_pb.StartTrialRequest) (*subscription_pb.Subscription, error) {
+	res, err := h.startTrial(ctx, in)
+	h.logger.Report(ctx, "StartTrial", err)
+	return res, err
+}
+
+func NewStartTrialHandler(service services.StartTrialService, logger monitor.GRPCLogger) *StartTrialHandler {
+	return &StartTrialHandler{
+		service: service,
+		logger:  logger,
+	}
+}
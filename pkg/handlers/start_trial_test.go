@@ -0,0 +1,129 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/in-rich/lib-go/monitor"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestStartTrial(t *testing.T) {
+	testData := []struct {
+		name string
+
+		in *subscription_pb.StartTrialRequest
+
+		expectRequest *models.StartTrialRequest
+		serviceResp   *entities.Subscription
+		serviceErr    error
+
+		expect     *subscription_pb.Subscription
+		expectCode codes.Code
+	}{
+		{
+			name: "StartTrial",
+			in: &subscription_pb.StartTrialRequest{
+				UserId: "user-id-1",
+			},
+			expectRequest: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			serviceResp: &entities.Subscription{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      "user-id-1",
+				Tier:        "pro",
+				Status:      entities.SubscriptionStatusTrialing,
+				StartedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &subscription_pb.Subscription{
+				SubscriptionId: "00000000-0000-0000-0000-000000000001",
+				UserId:         "user-id-1",
+				Tier:           "pro",
+				Status:         "trialing",
+				StartedAt:      timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				TrialEndsAt:    timestamppb.New(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "AlreadyUsed",
+			in: &subscription_pb.StartTrialRequest{
+				UserId: "user-id-1",
+			},
+			expectRequest: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			serviceErr: services.ErrTrialAlreadyUsed,
+			expectCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Disabled",
+			in: &subscription_pb.StartTrialRequest{
+				UserId: "user-id-1",
+			},
+			expectRequest: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			serviceErr: services.ErrTrialsDisabled,
+			expectCode: codes.FailedPrecondition,
+		},
+		{
+			name: "AlreadyExists",
+			in: &subscription_pb.StartTrialRequest{
+				UserId: "user-id-1",
+			},
+			expectRequest: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			serviceErr: services.ErrSubscriptionAlreadyExists,
+			expectCode: codes.AlreadyExists,
+		},
+		{
+			name: "InvalidRequest",
+			in:   &subscription_pb.StartTrialRequest{},
+			expectRequest: &models.StartTrialRequest{
+				UserID: "",
+			},
+			serviceErr: services.ErrInvalidRequest,
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			in: &subscription_pb.StartTrialRequest{
+				UserId: "user-id-1",
+			},
+			expectRequest: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			serviceErr: errors.New("internal error"),
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockStartTrialService(t)
+			service.On("Exec", context.TODO(), tt.expectRequest, mock.Anything).Return(tt.serviceResp, tt.serviceErr)
+
+			handler := handlers.NewStartTrialHandler(service, monitor.NewDummyGRPCLogger())
+
+			resp, err := handler.StartTrial(context.TODO(), tt.in)
+
+			RequireGRPCCodesEqual(t, err, tt.expectCode)
+			require.Equal(t, tt.expect, resp)
+		})
+	}
+}
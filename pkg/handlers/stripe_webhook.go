@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxStripePayloadSize bounds the size of webhook payloads. Stripe events are well under this limit.
+const maxStripePayloadSize = 1 << 20
+
+type StripeWebhookHandler struct {
+	service services.HandleStripeEventService
+}
+
+func (h *StripeWebhookHandler) HandleStripeWebhook(c *gin.Context) {
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxStripePayloadSize))
+	if err != nil {
+		_ = c.Error(err)
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "failed to read payload"})
+		return
+	}
+
+	err = h.service.Exec(c.Request.Context(), payload, c.GetHeader("Stripe-Signature"), time.Now())
+	if err != nil {
+		_ = c.Error(err)
+
+		// Stripe retries any event that does not receive a 2xx response.
+		if errors.Is(err, services.ErrInvalidStripeSignature) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid signature"})
+			return
+		}
+		if errors.Is(err, services.ErrInvalidRequest) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid event"})
+			return
+		}
+		// Retrying cannot succeed until the price is configured. Acknowledge the event, the error is still logged.
+		if errors.Is(err, services.ErrUnknownStripePrice) {
+			c.Status(http.StatusOK)
+			return
+		}
+
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to process event"})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func NewStripeWebhookHandler(service services.HandleStripeEventService) *StripeWebhookHandler {
+	return &StripeWebhookHandler{
+		service: service,
+	}
+}
@@ -0,0 +1,89 @@
+package handlers_test
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/in-rich/uservice-subscription/pkg/handlers"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleStripeWebhook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testData := []struct {
+		name string
+
+		payload   string
+		signature string
+
+		serviceErr error
+
+		expectStatus int
+	}{
+		{
+			name:         "HandleStripeWebhook",
+			payload:      `{"id": "evt_1"}`,
+			signature:    "t=1,v1=abc",
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "InvalidSignature",
+			payload:      `{"id": "evt_1"}`,
+			signature:    "t=1,v1=abc",
+			serviceErr:   services.ErrInvalidStripeSignature,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "InvalidRequest",
+			payload:      `{"id": "evt_1"}`,
+			signature:    "t=1,v1=abc",
+			serviceErr:   services.ErrInvalidRequest,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "UnknownPrice",
+			payload:      `{"id": "evt_1"}`,
+			signature:    "t=1,v1=abc",
+			serviceErr:   services.ErrUnknownStripePrice,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "Internal",
+			payload:      `{"id": "evt_1"}`,
+			signature:    "t=1,v1=abc",
+			serviceErr:   errors.New("internal error"),
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servicesmocks.NewMockHandleStripeEventService(t)
+			service.
+				On("Exec", mock.Anything, []byte(tt.payload), tt.signature, mock.Anything).
+				Return(tt.serviceErr)
+
+			handler := handlers.NewStripeWebhookHandler(service)
+
+			router := gin.New()
+			router.POST("/webhooks/stripe", handler.HandleStripeWebhook)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/stripe", strings.NewReader(tt.payload))
+			req.Header.Set("Stripe-Signature", tt.signature)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.expectStatus, rec.Code)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"errors"
+	subscription_pb "github.com/in-rich/proto/proto-go/subscription"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func intToProto(i *int) *int32 {
+	if i == nil {
+		return nil
+	}
+
+	return lo.ToPtr(int32(*i))
+}
+
+func subscriptionToProto(subscription *entities.Subscription) *subscription_pb.Subscription {
+	return &subscription_pb.Subscription{
+		SubscriptionId:     subscription.ID.String(),
+		UserId:             subscription.UserID,
+		Tier:               subscription.Tier,
+		Status:             string(subscription.Status),
+		StartedAt:          timeToProto(subscription.StartedAt),
+		EndsAt:             timeToProto(subscription.EndsAt),
+		CurrentPeriodStart: timeToProto(subscription.CurrentPeriodStart),
+		CurrentPeriodEnd:   timeToProto(subscription.CurrentPeriodEnd),
+		CancelAtPeriodEnd:  subscription.CancelAtPeriodEnd,
+		CanceledAt:         timeToProto(subscription.CanceledAt),
+		TrialEndsAt:        timeToProto(subscription.TrialEndsAt),
+	}
+}
+
+// subscriptionErrorToStatus converts the errors shared by the subscription management services to a GRPC status.
+func subscriptionErrorToStatus(err error, action string) error {
+	if errors.Is(err, services.ErrInvalidRequest) {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+	if errors.Is(err, services.ErrSubscriptionNotFound) {
+		return status.Error(codes.NotFound, "subscription not found")
+	}
+	if errors.Is(err, services.ErrSubscriptionAlreadyExists) {
+		return status.Error(codes.AlreadyExists, "subscription already exists")
+	}
+	if errors.Is(err, services.ErrNoBillingPeriod) {
+		return status.Error(codes.FailedPrecondition, "subscription has no running billing period")
+	}
+	if errors.Is(err, services.ErrTrialsDisabled) {
+		return status.Error(codes.FailedPrecondition, "trials are disabled")
+	}
+	if errors.Is(err, services.ErrTrialAlreadyUsed) {
+		return status.Error(codes.FailedPrecondition, "trial already used")
+	}
+
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"github.com/in-rich/lib-go/monitor"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"time"
+)
+
+// ExpireNoteEditsJob periodically marks the pending note edits past their expiration as expired.
+type ExpireNoteEditsJob struct {
+	service  services.ExpireNoteEditsService
+	logger   monitor.Logger
+	interval time.Duration
+}
+
+// Run expires note edits immediately, then once every interval until the context is canceled.
+func (j *ExpireNoteEditsJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.expireNoteEdits(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *ExpireNoteEditsJob) expireNoteEdits(ctx context.Context) {
+	count, err := j.service.Exec(ctx, time.Now())
+	if count > 0 {
+		j.logger.Info(fmt.Sprintf("Expired %d note edits", count))
+	}
+	if err != nil && ctx.Err() == nil {
+		j.logger.Error(err, "failed to expire note edits")
+	}
+}
+
+func NewExpireNoteEditsJob(service services.ExpireNoteEditsService, logger monitor.Logger, interval time.Duration) *ExpireNoteEditsJob {
+	return &ExpireNoteEditsJob{
+		service:  service,
+		logger:   logger,
+		interval: interval,
+	}
+}
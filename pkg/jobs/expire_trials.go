@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"github.com/in-rich/lib-go/monitor"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"time"
+)
+
+// ExpireTrialsJob periodically marks the trials that ended as expired.
+type ExpireTrialsJob struct {
+	service  services.ExpireTrialsService
+	logger   monitor.Logger
+	interval time.Duration
+}
+
+// Run expires trials immediately, then once every interval until the context is canceled.
+func (j *ExpireTrialsJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.expireTrials(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *ExpireTrialsJob) expireTrials(ctx context.Context) {
+	count, err := j.service.Exec(ctx, time.Now())
+	if count > 0 {
+		j.logger.Info(fmt.Sprintf("Expired %d trials", count))
+	}
+	if err != nil && ctx.Err() == nil {
+		j.logger.Error(err, "failed to expire trials")
+	}
+}
+
+func NewExpireTrialsJob(service services.ExpireTrialsService, logger monitor.Logger, interval time.Duration) *ExpireTrialsJob {
+	return &ExpireTrialsJob{
+		service:  service,
+		logger:   logger,
+		interval: interval,
+	}
+}
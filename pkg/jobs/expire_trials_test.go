@@ -0,0 +1,59 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"github.com/in-rich/lib-go/monitor"
+	"github.com/in-rich/uservice-subscription/pkg/jobs"
+	servicesmocks "github.com/in-rich/uservice-subscription/pkg/services/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestExpireTrialsJob(t *testing.T) {
+	testData := []struct {
+		name string
+
+		serviceResp int
+		serviceErr  error
+	}{
+		{
+			name:        "ExpireTrialsJob",
+			serviceResp: 2,
+		},
+		{
+			// Errors are logged, and the job keeps running.
+			name:       "ServiceError",
+			serviceErr: errors.New("internal error"),
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			runs := 0
+
+			service := servicesmocks.NewMockExpireTrialsService(t)
+			service.
+				On("Exec", ctx, mock.Anything).
+				Run(func(_ mock.Arguments) {
+					// Stop the job after its second run.
+					runs++
+					if runs == 2 {
+						cancel()
+					}
+				}).
+				Return(tt.serviceResp, tt.serviceErr)
+
+			job := jobs.NewExpireTrialsJob(service, monitor.NewDummyGRPCLogger(), time.Millisecond)
+			job.Run(ctx)
+
+			require.GreaterOrEqual(t, runs, 2)
+			service.AssertExpectations(t)
+		})
+	}
+}
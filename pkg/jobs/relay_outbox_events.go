@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"github.com/in-rich/lib-go/monitor"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"time"
+)
+
+// RelayOutboxEventsJob periodically publishes the events waiting in the outbox.
+type RelayOutboxEventsJob struct {
+	service  services.RelayOutboxEventsService
+	logger   monitor.Logger
+	interval time.Duration
+}
+
+// Run relays events immediately, then once every interval until the context is canceled.
+func (j *RelayOutboxEventsJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.relayEvents(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *RelayOutboxEventsJob) relayEvents(ctx context.Context) {
+	count, err := j.service.Exec(ctx, time.Now())
+	if count > 0 {
+		j.logger.Info(fmt.Sprintf("Published %d outbox events", count))
+	}
+	if err != nil && ctx.Err() == nil {
+		j.logger.Error(err, "failed to relay outbox events")
+	}
+}
+
+func NewRelayOutboxEventsJob(service services.RelayOutboxEventsService, logger monitor.Logger, interval time.Duration) *RelayOutboxEventsJob {
+	return &RelayOutboxEventsJob{
+		service:  service,
+		logger:   logger,
+		interval: interval,
+	}
+}
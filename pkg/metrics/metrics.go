@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"time"
+)
+
+// NoteEditDecision is the outcome of a check to update a note.
+type NoteEditDecision string
+
+const (
+	// NoteEditAllowed is reported when a new edit is counted against the quota of the author.
+	NoteEditAllowed NoteEditDecision = "allowed"
+	// NoteEditReplayed is reported when a retry with the same idempotency key returns the edit counted by the first
+	// request, without counting a new one.
+	NoteEditReplayed NoteEditDecision = "replayed"
+	// NoteEditBuffered is reported when the note is edited again within its session, for free.
+	NoteEditBuffered NoteEditDecision = "buffered"
+	// NoteEditReadOnly is reported for checks that do not record any edit.
+	NoteEditReadOnly NoteEditDecision = "read_only"
+	// NoteEditDeniedExhausted is reported when the author has no edit left.
+	NoteEditDeniedExhausted NoteEditDecision = "denied_exhausted"
+	// NoteEditDeniedNotesExhausted is reported when the author cannot edit any more distinct notes.
+	NoteEditDeniedNotesExhausted NoteEditDecision = "denied_notes_exhausted"
+)
+
+// UnknownRepository labels the repository calls that were not given a repository name.
+const UnknownRepository = "unknown"
+
+// Metrics collects the Prometheus metrics of the service.
+type Metrics struct {
+	gatherer prometheus.Gatherer
+
+	noteEditDecisions      *prometheus.CounterVec
+	repositoryCallDuration *prometheus.HistogramVec
+}
+
+// RecordNoteEditDecision counts the outcome of a check to update a note.
+func (m *Metrics) RecordNoteEditDecision(tier, target string, decision NoteEditDecision) {
+	m.noteEditDecisions.WithLabelValues(tier, target, string(decision)).Inc()
+}
+
+// ObserveRepositoryCall records the duration of a call to the given repository method, with all the queries it sent.
+func (m *Metrics) ObserveRepositoryCall(repository string, duration time.Duration) {
+	if repository == "" {
+		repository = UnknownRepository
+	}
+
+	m.repositoryCallDuration.WithLabelValues(repository).Observe(duration.Seconds())
+}
+
+// Handler serves the collected metrics, in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.gatherer, promhttp.HandlerOpts{})
+}
+
+// NewMetrics registers the metrics of the service on the given registry.
+func NewMetrics(registry *prometheus.Registry) *Metrics {
+	m := &Metrics{
+		gatherer: registry,
+		noteEditDecisions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "subscription",
+			Name:      "note_edit_decisions_total",
+			Help:      "Outcomes of the checks to update a note.",
+		}, []string{"tier", "target", "decision"}),
+		repositoryCallDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "subscription",
+			Name:      "dao_call_duration_seconds",
+			Help:      "Duration of the repository calls, per repository method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"repository"}),
+	}
+
+	registry.MustRegister(m.noteEditDecisions, m.repositoryCallDuration)
+
+	return m
+}
@@ -0,0 +1,34 @@
+package metrics_test
+
+import (
+	"github.com/in-rich/uservice-subscription/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMetrics(t *testing.T) {
+	m := metrics.NewMetrics(prometheus.NewRegistry())
+
+	m.RecordNoteEditDecision("free", "company", metrics.NoteEditAllowed)
+	m.RecordNoteEditDecision("free", "company", metrics.NoteEditAllowed)
+	m.RecordNoteEditDecision("pro", "user", metrics.NoteEditDeniedExhausted)
+	m.ObserveRepositoryCall("CountNoteEditsByAuthor", 20*time.Millisecond)
+	m.ObserveRepositoryCall("", 20*time.Millisecond)
+
+	recorder := httptest.NewRecorder()
+	m.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `subscription_note_edit_decisions_total{decision="allowed",target="company",tier="free"} 2`)
+	require.Contains(t, string(body), `subscription_note_edit_decisions_total{decision="denied_exhausted",target="user",tier="pro"} 1`)
+	require.Contains(t, string(body), `subscription_dao_call_duration_seconds_count{repository="CountNoteEditsByAuthor"} 1`)
+	require.Contains(t, string(body), `subscription_dao_call_duration_seconds_count{repository="unknown"} 1`)
+}
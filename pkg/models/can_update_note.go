@@ -1,8 +1,27 @@
 package models
 
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
 type CanUpdateNoteRequest struct {
 	Target           string `json:"target" validate:"omitempty,required_without=ReadOnly,oneof=company user"`
 	PublicIdentifier string `json:"publicIdentifier" validate:"omitempty,required_without=ReadOnly,max=255"`
 	AuthorID         string `json:"authorID" validate:"required,max=255"`
 	ReadOnly         bool   `json:"read_only"`
+	// IdempotencyKey identifies retries of the same request, so they don't count as new edits.
+	IdempotencyKey string `json:"idempotencyKey" validate:"max=255"`
+}
+
+type CanUpdateNoteResult struct {
+	RemainingEdits int `json:"remainingEdits"`
+	// NoteEditID identifies the edit counted by the request, so it can be released if the note fails to update. It is
+	// nil when no new edit was counted.
+	NoteEditID *uuid.UUID `json:"noteEditID"`
+	// ExpiresAt is set when the edit is pending. The edit stops counting at this time, unless committed before.
+	ExpiresAt *time.Time `json:"expiresAt"`
+	// Replayed is true when the result is the one of an earlier request with the same idempotency key, so no edit was
+	// counted by this request.
+	Replayed bool `json:"replayed"`
 }
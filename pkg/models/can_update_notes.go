@@ -0,0 +1,25 @@
+package models
+
+type CanUpdateNotesRequest struct {
+	AuthorID string     `json:"authorID" validate:"required,max=255"`
+	Notes    []*NoteKey `json:"notes" validate:"required,min=1,max=100,dive,required"`
+}
+
+type NoteKey struct {
+	Target           string `json:"target" validate:"required,oneof=company user"`
+	PublicIdentifier string `json:"publicIdentifier" validate:"required,max=255"`
+}
+
+type CanUpdateNotesResult struct {
+	// RemainingEdits is shared by every note.
+	RemainingEdits int `json:"remainingEdits"`
+	// Notes are returned in the order of the request.
+	Notes []*NoteEditSession `json:"notes"`
+}
+
+type NoteEditSession struct {
+	Target           string `json:"target"`
+	PublicIdentifier string `json:"publicIdentifier"`
+	// InSession is true when the note was edited within the edit session window, so a new edit would not count.
+	InSession bool `json:"inSession"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type CancelSubscriptionRequest struct {
+	UserID      string `json:"userID" validate:"required,max=255"`
+	AtPeriodEnd bool   `json:"atPeriodEnd"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type ChangeSubscriptionTierRequest struct {
+	UserID string `json:"userID" validate:"required,max=255"`
+	Tier   string `json:"tier" validate:"required,max=255"`
+}
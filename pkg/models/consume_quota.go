@@ -0,0 +1,9 @@
+package models
+
+type ConsumeQuotaRequest struct {
+	Feature string `json:"feature" validate:"required,max=255"`
+	Subject string `json:"subject" validate:"required,max=255"`
+	Key     string `json:"key" validate:"required_without=ReadOnly,max=255"`
+	// ReadOnly only returns the remaining uses, without counting the request as a use.
+	ReadOnly bool `json:"readOnly"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type CreateOrganizationRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+}
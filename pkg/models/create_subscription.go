@@ -0,0 +1,9 @@
+package models
+
+import "time"
+
+type CreateSubscriptionRequest struct {
+	UserID           string     `json:"userID" validate:"required,max=255"`
+	Tier             string     `json:"tier" validate:"required,max=255"`
+	CurrentPeriodEnd *time.Time `json:"currentPeriodEnd"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type DeleteEntitlementOverrideRequest struct {
+	UserID      string `json:"userID" validate:"required,max=255"`
+	Entitlement string `json:"entitlement" validate:"required,max=255"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type DeleteQuotaOverrideRequest struct {
+	AuthorID string `json:"authorID" validate:"required,max=255"`
+}
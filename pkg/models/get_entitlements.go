@@ -0,0 +1,5 @@
+package models
+
+type GetEntitlementsRequest struct {
+	UserID string `json:"userID" validate:"required,max=255"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type GetSubscriptionRequest struct {
+	UserID string `json:"userID" validate:"required,max=255"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type GetUsageRequest struct {
+	UserID string `json:"userID" validate:"required,max=255"`
+}
+
+type Usage struct {
+	Used        int       `json:"used"`
+	Limit       int       `json:"limit"`
+	Remaining   int       `json:"remaining"`
+	WindowStart time.Time `json:"windowStart"`
+	// WindowEnd is the time at which every edit of a calendar window stops counting. It is nil for rolling windows, and
+	// for billing cycles whose billing period ended before its renewal was recorded.
+	WindowEnd *time.Time `json:"windowEnd"`
+	// OldestEditExpiresIn is nil when no edit is counted in the current window, or when the end of a billing cycle is
+	// unknown.
+	OldestEditExpiresIn *time.Duration `json:"oldestEditExpiresIn"`
+	// OrganizationID is set when edits are drawn from the pool of an organization. Used and Limit then describe the
+	// whole pool, while Remaining also accounts for the sub-limit of the member.
+	OrganizationID *uuid.UUID `json:"organizationID"`
+	MemberUsed     int        `json:"memberUsed"`
+	// MemberLimit is the sub-limit of the member inside the pool, if any.
+	MemberLimit *int `json:"memberLimit"`
+}
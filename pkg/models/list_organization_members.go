@@ -0,0 +1,5 @@
+package models
+
+type ListOrganizationMembersRequest struct {
+	OrganizationID string `json:"organizationID" validate:"required,uuid"`
+}
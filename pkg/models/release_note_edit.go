@@ -0,0 +1,6 @@
+package models
+
+type ReleaseNoteEditRequest struct {
+	NoteEditID string `json:"noteEditID" validate:"required,uuid"`
+	AuthorID   string `json:"authorID" validate:"required,max=255"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type RemoveOrganizationMemberRequest struct {
+	OrganizationID string `json:"organizationID" validate:"required,uuid"`
+	UserID         string `json:"userID" validate:"required,max=255"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/config"
+	"time"
+)
+
+// ResolvedTier is the tier that applies to a user, along with the name it is configured under.
+type ResolvedTier struct {
+	Name string
+	config.TierInformation
+	// BillingWarning is set when the subscription of the user failed to renew. The paid tier still applies during its
+	// grace period, the default tier applies after it.
+	BillingWarning bool
+	// BillingPeriodStart and BillingPeriodEnd bound the current billing period of the subscription, if any. They are
+	// used by billing cycle windows.
+	BillingPeriodStart *time.Time
+	BillingPeriodEnd   *time.Time
+	// OrganizationID is set when the tier comes from the subscription of the organization of the user. Note edits are
+	// then drawn from a pool shared by every member of the organization.
+	OrganizationID *uuid.UUID
+	// MemberMaxEdits caps the share of the organization pool the user can use, if set.
+	MemberMaxEdits *int
+}
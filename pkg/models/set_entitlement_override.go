@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type SetEntitlementOverrideRequest struct {
+	UserID      string `json:"userID" validate:"required,max=255"`
+	Entitlement string `json:"entitlement" validate:"required,max=255"`
+	// Enabled is the value of on/off entitlements. It is ignored by numeric entitlements.
+	Enabled bool `json:"enabled"`
+	// Limit is the allowance of numeric entitlements. It must be empty for on/off entitlements.
+	Limit     *int       `json:"limit" validate:"omitempty,min=0"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
@@ -0,0 +1,9 @@
+package models
+
+type SetOrganizationMemberRequest struct {
+	OrganizationID string `json:"organizationID" validate:"required,uuid"`
+	UserID         string `json:"userID" validate:"required,max=255"`
+	// MaxEdits caps the share of the organization pool the member can use. Leave empty to let the member use the whole
+	// pool.
+	MaxEdits *int `json:"maxEdits" validate:"omitempty,min=0"`
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type SetQuotaOverrideRequest struct {
+	AuthorID string `json:"authorID" validate:"required,max=255"`
+	Kind     string `json:"kind" validate:"required,oneof=add replace disable"`
+	// MaxEdits is the number of edits added by, or replacing, the tier limit. It is ignored when the limit is disabled.
+	MaxEdits  int        `json:"maxEdits" validate:"min=0"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
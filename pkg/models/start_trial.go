@@ -0,0 +1,5 @@
+package models
+
+type StartTrialRequest struct {
+	UserID string `json:"userID" validate:"required,max=255"`
+}
@@ -0,0 +1,46 @@
+package models
+
+import "encoding/json"
+
+// Stripe payloads follow the 2024-06-20 API version, which the webhook endpoint must be pinned to.
+
+type StripeEvent struct {
+	ID      string          `json:"id" validate:"required,max=255"`
+	Type    string          `json:"type" validate:"required,max=255"`
+	Created int64           `json:"created"`
+	Data    StripeEventData `json:"data"`
+}
+
+type StripeEventData struct {
+	Object json.RawMessage `json:"object" validate:"required"`
+}
+
+type StripeSubscription struct {
+	ID       string            `json:"id" validate:"required,max=255"`
+	Status   string            `json:"status" validate:"required"`
+	Metadata map[string]string `json:"metadata"`
+	Items    struct {
+		Data []StripeSubscriptionItem `json:"data" validate:"required,min=1,dive"`
+	} `json:"items"`
+
+	StartDate          int64  `json:"start_date"`
+	CurrentPeriodStart *int64 `json:"current_period_start"`
+	CurrentPeriodEnd   *int64 `json:"current_period_end"`
+	CancelAtPeriodEnd  bool   `json:"cancel_at_period_end"`
+	CancelAt           *int64 `json:"cancel_at"`
+	CanceledAt         *int64 `json:"canceled_at"`
+	EndedAt            *int64 `json:"ended_at"`
+	TrialEnd           *int64 `json:"trial_end"`
+}
+
+type StripeSubscriptionItem struct {
+	Price struct {
+		ID string `json:"id" validate:"required"`
+	} `json:"price"`
+}
+
+type StripeInvoice struct {
+	ID           string `json:"id" validate:"required,max=255"`
+	Subscription string `json:"subscription"`
+	Created      int64  `json:"created"`
+}
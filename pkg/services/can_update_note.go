@@ -5,98 +5,366 @@ import (
 	"errors"
 	"fmt"
 	"github.com/go-playground/validator/v10"
-	"github.com/in-rich/uservice-subscription/config"
 	"github.com/in-rich/uservice-subscription/pkg/dao"
 	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
 	"github.com/in-rich/uservice-subscription/pkg/models"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"time"
 )
 
 var (
-	// NoteEditBufferTime is the time window in which a user can edit a note, without it counting as a new edit.
-	NoteEditBufferTime = 60 * time.Minute
+	// IdempotencyKeyTTL is the time window in which a request replayed with the same idempotency key returns the
+	// result of the original request, instead of counting a new edit.
+	IdempotencyKeyTTL = 24 * time.Hour
 )
 
 type CanUpdateNoteService interface {
 	Exec(
 		ctx context.Context,
 		canUpdateRequest *models.CanUpdateNoteRequest,
-		tier config.TierInformation,
+		tier *models.ResolvedTier,
 		now time.Time,
-	) (int, error)
+	) (*models.CanUpdateNoteResult, error)
 }
 
 type canUpdateNoteServiceImpl struct {
-	countEditsRepository    dao.CountNoteEditsByAuthorRepository
-	createEditRepository    dao.CreateNoteEditRepository
-	getLatestEditRepository dao.GetLatestNoteEditByAuthorRepository
+	countEditsRepository       dao.CountNoteEditsByAuthorRepository
+	createEditRepository       dao.CreateNoteEditRepository
+	getLatestEditRepository    dao.GetLatestNoteEditByAuthorRepository
+	lockEditsRepository        dao.LockNoteEditsByAuthorRepository
+	getQuotaOverrideRepository dao.GetQuotaOverrideByAuthorRepository
+
+	countOrganizationEditsRepository dao.CountNoteEditsByOrganizationRepository
+	lockOrganizationEditsRepository  dao.LockNoteEditsByOrganizationRepository
+
+	getEditByIdempotencyKeyRepository dao.GetNoteEditByIdempotencyKeyRepository
+	clearEditIdempotencyKeyRepository dao.ClearNoteEditIdempotencyKeyRepository
+
+	updateEditActivityRepository dao.UpdateNoteEditActivityRepository
+
+	countNotesRepository dao.CountNotesByAuthorRepository
+
+	runInTransactionRepository dao.RunInTransactionRepository
+
+	emitter events.Emitter
+
+	// reservationTTL is how long new edits stay pending before they expire, unless committed. Edits are committed
+	// right away if zero.
+	reservationTTL time.Duration
 }
 
 func (s *canUpdateNoteServiceImpl) Exec(
 	ctx context.Context,
 	canUpdateRequest *models.CanUpdateNoteRequest,
-	tier config.TierInformation,
+	tier *models.ResolvedTier,
 	now time.Time,
-) (int, error) {
+) (*models.CanUpdateNoteResult, error) {
+	ctx, span := tracer.Start(ctx, "services.CanUpdateNote", trace.WithAttributes(
+		attribute.String("note.target", canUpdateRequest.Target),
+		attribute.Bool("note.read_only", canUpdateRequest.ReadOnly),
+		attribute.String("tier.name", tier.Name),
+	))
+
+	result, err := s.exec(ctx, canUpdateRequest, tier, now)
+	endSpan(span, err)
+
+	return result, err
+}
+
+func (s *canUpdateNoteServiceImpl) exec(
+	ctx context.Context,
+	canUpdateRequest *models.CanUpdateNoteRequest,
+	tier *models.ResolvedTier,
+	now time.Time,
+) (*models.CanUpdateNoteResult, error) {
 	validate := validator.New(validator.WithRequiredStructEnabled())
 	if err := validate.Struct(canUpdateRequest); err != nil {
-		return 0, errors.Join(ErrInvalidRequest, err)
+		return nil, errors.Join(ErrInvalidRequest, err)
 	}
 
-	editsSince := now.UTC().Add(-*tier.Notes.CountEditsOver)
-	editsCount, err := s.countEditsRepository.CountNoteEditsByAuthor(ctx, canUpdateRequest.AuthorID, &editsSince)
+	// Don't throw in read only mode.
+	if canUpdateRequest.ReadOnly {
+		tier, err := applyNoteQuotaOverride(ctx, s.getQuotaOverrideRepository, canUpdateRequest.AuthorID, tier, now)
+		if err != nil {
+			return nil, err
+		}
+
+		remainingEdits, err := s.countRemainingEdits(ctx, canUpdateRequest.AuthorID, tier, now)
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.CanUpdateNoteResult{RemainingEdits: remainingEdits}, nil
+	}
+
+	result := new(models.CanUpdateNoteResult)
+
+	// Check and consume the edit atomically, so parallel requests from the same author cannot overdraw their quota.
+	err := s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := s.lockEdits(ctx, canUpdateRequest.AuthorID, tier); err != nil {
+			return err
+		}
+
+		if canUpdateRequest.IdempotencyKey != "" {
+			replayed, err := s.replayEdit(ctx, canUpdateRequest.AuthorID, canUpdateRequest.IdempotencyKey, now)
+			if err != nil {
+				return err
+			}
+
+			if replayed != nil {
+				result = replayed
+				return nil
+			}
+		}
+
+		// The override applies to every limit checked below, including the caps on distinct notes and the edit
+		// sessions.
+		tier, err := applyNoteQuotaOverride(ctx, s.getQuotaOverrideRepository, canUpdateRequest.AuthorID, tier, now)
+		if err != nil {
+			return err
+		}
+
+		remainingEdits, err := s.countRemainingEdits(ctx, canUpdateRequest.AuthorID, tier, now)
+		if err != nil {
+			return err
+		}
+
+		latestEditForNote, err := s.getLatestEditRepository.GetLatestNoteEditByAuthor(
+			ctx,
+			canUpdateRequest.AuthorID,
+			entities.Target(canUpdateRequest.Target),
+			canUpdateRequest.PublicIdentifier,
+			now,
+		)
+		if err != nil && !errors.Is(err, dao.ErrNoNoteEditFound) {
+			return fmt.Errorf("get latest note edit: %w", err)
+		}
+
+		latestEditAt := noteSessionActivity(latestEditForNote, tier.Notes)
+
+		result.RemainingEdits, err = consumeQuota(remainingEdits, latestEditAt, tier.NoteEdits().BufferTime, now, ErrNoteEditsExhausted, func() error {
+			if err := s.checkNoteCaps(ctx, canUpdateRequest, tier, latestEditForNote, now); err != nil {
+				return err
+			}
+
+			createData := &dao.CreateNoteEditData{
+				Target:           entities.Target(canUpdateRequest.Target),
+				PublicIdentifier: canUpdateRequest.PublicIdentifier,
+				OrganizationID:   tier.OrganizationID,
+				Status:           entities.NoteEditStatusCommitted,
+			}
+			if canUpdateRequest.IdempotencyKey != "" {
+				createData.IdempotencyKey = lo.ToPtr(canUpdateRequest.IdempotencyKey)
+				createData.RemainingEdits = lo.ToPtr(remainingEdits - 1)
+			}
+			if s.reservationTTL > 0 {
+				createData.Status = entities.NoteEditStatusPending
+				createData.ExpiresAt = lo.ToPtr(now.Add(s.reservationTTL))
+			}
+
+			noteEdit, err := s.createEditRepository.CreateNoteEdit(ctx, canUpdateRequest.AuthorID, createData)
+			if err != nil {
+				return fmt.Errorf("create note edit: %w", err)
+			}
+
+			result.NoteEditID = noteEdit.ID
+			result.ExpiresAt = noteEdit.ExpiresAt
+
+			return s.emitNoteEditConsumed(ctx, canUpdateRequest, tier, noteEdit, remainingEdits-1, now)
+		})
+		if err != nil {
+			return err
+		}
+
+		// The note was edited within the session of the latest edit, so the session is extended.
+		if result.NoteEditID == nil && tier.Notes.SlidingEditSession {
+			_, err = s.updateEditActivityRepository.UpdateNoteEditActivity(ctx, *latestEditForNote.ID, now)
+			if err != nil {
+				return fmt.Errorf("update note edit activity: %w", err)
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
-		return 0, fmt.Errorf("count note edits: %w", err)
+		return nil, err
 	}
 
-	// Avoid discrepancies if the limit has been overflowed.
-	remainingEdits := lo.Max([]int{tier.Notes.MaxEdits - editsCount, 0})
-	// Don't throw in read only mode.
-	if canUpdateRequest.ReadOnly {
-		return remainingEdits, nil
+	return result, nil
+}
+
+// replayEdit returns the result of the request that first used the idempotency key, if it was used within
+// IdempotencyKeyTTL. Older keys are released, so they can be used again. So are the keys of edits that no longer
+// count, because they were voided or expired: replaying them would let the note update without a counted edit.
+func (s *canUpdateNoteServiceImpl) replayEdit(
+	ctx context.Context, author string, key string, now time.Time,
+) (*models.CanUpdateNoteResult, error) {
+	previousEdit, err := s.getEditByIdempotencyKeyRepository.GetNoteEditByIdempotencyKey(ctx, author, key)
+	if errors.Is(err, dao.ErrNoNoteEditFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get note edit by idempotency key: %w", err)
+	}
+
+	if previousEdit.CreatedAt.Before(now.Add(-IdempotencyKeyTTL)) || !previousEdit.IsCounted(now) {
+		if err := s.clearEditIdempotencyKeyRepository.ClearNoteEditIdempotencyKey(ctx, *previousEdit.ID); err != nil {
+			return nil, fmt.Errorf("clear note edit idempotency key: %w", err)
+		}
+
+		return nil, nil
+	}
+
+	return &models.CanUpdateNoteResult{
+		RemainingEdits: lo.FromPtr(previousEdit.RemainingEdits),
+		NoteEditID:     previousEdit.ID,
+		ExpiresAt:      previousEdit.ExpiresAt,
+		Replayed:       true,
+	}, nil
+}
+
+// checkNoteCaps returns ErrNotesExhausted if the edit would exceed the caps of the tier on the number of distinct
+// notes edited in a window.
+func (s *canUpdateNoteServiceImpl) checkNoteCaps(
+	ctx context.Context,
+	canUpdateRequest *models.CanUpdateNoteRequest,
+	tier *models.ResolvedTier,
+	latestEditForNote *entities.NoteEdit,
+	now time.Time,
+) error {
+	target := entities.Target(canUpdateRequest.Target)
+	maxNotesForTarget, hasTargetCap := tier.Notes.MaxNotesPerTarget[canUpdateRequest.Target]
+	if tier.Notes.MaxNotes == nil && !hasTargetCap {
+		return nil
+	}
+
+	notesSince, _ := tier.NoteEdits().CountWindow(now, tier.BillingPeriodStart, tier.BillingPeriodEnd)
+
+	// The note was already edited in the window, so it is already counted.
+	if latestEditForNote != nil && !latestEditForNote.CreatedAt.Before(notesSince) {
+		return nil
 	}
 
-	latestEditForNote, err := s.getLatestEditRepository.GetLatestNoteEditByAuthor(
-		ctx,
-		canUpdateRequest.AuthorID,
-		entities.Target(canUpdateRequest.Target),
-		canUpdateRequest.PublicIdentifier,
-	)
-	if err != nil && !errors.Is(err, dao.ErrNoNoteEditFound) {
-		return 0, fmt.Errorf("get latest note edit: %w", err)
+	notesCount, err := s.countNotesRepository.CountNotesByAuthor(ctx, canUpdateRequest.AuthorID, &notesSince, now)
+	if err != nil {
+		return fmt.Errorf("count notes: %w", err)
 	}
 
-	// Edit is recent, nothing to do.
-	if latestEditForNote != nil && latestEditForNote.CreatedAt.After(now.UTC().Add(-NoteEditBufferTime)) {
-		return remainingEdits, nil
+	if hasTargetCap && notesCount[target] >= maxNotesForTarget {
+		return ErrNotesExhausted
 	}
 
-	// No more edits remaining, throw.
-	if remainingEdits == 0 {
-		return 0, ErrNoteEditsExhausted
+	if tier.Notes.MaxNotes != nil && lo.Sum(lo.Values(notesCount)) >= *tier.Notes.MaxNotes {
+		return ErrNotesExhausted
 	}
 
-	// Create a new note edit.
-	_, err = s.createEditRepository.CreateNoteEdit(ctx, canUpdateRequest.AuthorID, &dao.CreateNoteEditData{
-		Target:           entities.Target(canUpdateRequest.Target),
+	return nil
+}
+
+// lockEdits locks the quota the edits of the author are drawn from. Members of an organization share a pool, so they
+// must wait for each other.
+func (s *canUpdateNoteServiceImpl) lockEdits(ctx context.Context, author string, tier *models.ResolvedTier) error {
+	if tier.OrganizationID != nil {
+		if err := s.lockOrganizationEditsRepository.LockNoteEditsByOrganization(ctx, *tier.OrganizationID); err != nil {
+			return fmt.Errorf("lock organization note edits: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := s.lockEditsRepository.LockNoteEditsByAuthor(ctx, author); err != nil {
+		return fmt.Errorf("lock note edits: %w", err)
+	}
+
+	return nil
+}
+
+func (s *canUpdateNoteServiceImpl) countRemainingEdits(
+	ctx context.Context, author string, tier *models.ResolvedTier, now time.Time,
+) (int, error) {
+	return countRemainingNoteEdits(ctx, s.countEditsRepository, s.countOrganizationEditsRepository, author, tier, now)
+}
+
+// emitNoteEditConsumed announces a new edit, along with the exhaustion of the quota if it was the last edit left.
+func (s *canUpdateNoteServiceImpl) emitNoteEditConsumed(
+	ctx context.Context,
+	canUpdateRequest *models.CanUpdateNoteRequest,
+	tier *models.ResolvedTier,
+	noteEdit *entities.NoteEdit,
+	remainingEdits int,
+	now time.Time,
+) error {
+	consumed := &events.NoteEditConsumed{
+		NoteEditID:       *noteEdit.ID,
+		AuthorID:         canUpdateRequest.AuthorID,
+		OrganizationID:   tier.OrganizationID,
+		Target:           canUpdateRequest.Target,
 		PublicIdentifier: canUpdateRequest.PublicIdentifier,
-	})
-	if err != nil {
-		return 0, fmt.Errorf("create note edit: %w", err)
+		Tier:             tier.Name,
+		RemainingEdits:   remainingEdits,
+		ConsumedAt:       now.UTC(),
+	}
+	if err := s.emitter.Emit(ctx, consumed); err != nil {
+		return fmt.Errorf("emit note edit consumed: %w", err)
+	}
+
+	if remainingEdits > 0 {
+		return nil
 	}
 
-	return remainingEdits - 1, nil
+	exhausted := &events.NoteEditsExhausted{
+		AuthorID:       canUpdateRequest.AuthorID,
+		OrganizationID: tier.OrganizationID,
+		Tier:           tier.Name,
+		ExhaustedAt:    now.UTC(),
+	}
+	if err := s.emitter.Emit(ctx, exhausted); err != nil {
+		return fmt.Errorf("emit note edits exhausted: %w", err)
+	}
+
+	return nil
 }
 
 func NewCanUpdateNoteService(
 	countEditsRepository dao.CountNoteEditsByAuthorRepository,
 	createEditRepository dao.CreateNoteEditRepository,
 	getLatestEditRepository dao.GetLatestNoteEditByAuthorRepository,
+	lockEditsRepository dao.LockNoteEditsByAuthorRepository,
+	getQuotaOverrideRepository dao.GetQuotaOverrideByAuthorRepository,
+	countOrganizationEditsRepository dao.CountNoteEditsByOrganizationRepository,
+	lockOrganizationEditsRepository dao.LockNoteEditsByOrganizationRepository,
+	getEditByIdempotencyKeyRepository dao.GetNoteEditByIdempotencyKeyRepository,
+	clearEditIdempotencyKeyRepository dao.ClearNoteEditIdempotencyKeyRepository,
+	updateEditActivityRepository dao.UpdateNoteEditActivityRepository,
+	countNotesRepository dao.CountNotesByAuthorRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+	emitter events.Emitter,
+	reservationTTL time.Duration,
 ) CanUpdateNoteService {
 	return &canUpdateNoteServiceImpl{
-		countEditsRepository:    countEditsRepository,
-		createEditRepository:    createEditRepository,
-		getLatestEditRepository: getLatestEditRepository,
+		countEditsRepository:       countEditsRepository,
+		createEditRepository:       createEditRepository,
+		getLatestEditRepository:    getLatestEditRepository,
+		lockEditsRepository:        lockEditsRepository,
+		getQuotaOverrideRepository: getQuotaOverrideRepository,
+
+		countOrganizationEditsRepository: countOrganizationEditsRepository,
+		lockOrganizationEditsRepository:  lockOrganizationEditsRepository,
+
+		getEditByIdempotencyKeyRepository: getEditByIdempotencyKeyRepository,
+		clearEditIdempotencyKeyRepository: clearEditIdempotencyKeyRepository,
+
+		updateEditActivityRepository: updateEditActivityRepository,
+
+		countNotesRepository: countNotesRepository,
+
+		runInTransactionRepository: runInTransactionRepository,
+
+		emitter: emitter,
+
+		reservationTTL: reservationTTL,
 	}
 }
@@ -2,19 +2,26 @@ package services_test
 
 import (
 	"context"
+	"github.com/google/uuid"
 	"github.com/in-rich/uservice-subscription/config"
 	"github.com/in-rich/uservice-subscription/pkg/dao"
 	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
 	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	eventsmocks "github.com/in-rich/uservice-subscription/pkg/events/mocks"
 	"github.com/in-rich/uservice-subscription/pkg/models"
 	"github.com/in-rich/uservice-subscription/pkg/services"
 	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"testing"
 	"time"
 )
 
 func TestCanUpdateNote(t *testing.T) {
+	organizationID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+	noteEditID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
 	testData := []struct {
 		name string
 
@@ -22,9 +29,32 @@ func TestCanUpdateNote(t *testing.T) {
 		now  time.Time
 		tier config.TierInformation
 
+		billingPeriodStart *time.Time
+		billingPeriodEnd   *time.Time
+
+		// Set when the edits are drawn from the pool of an organization.
+		organizationID *uuid.UUID
+		memberMaxEdits *int
+
+		shouldLockNotes bool
+		lockNotesErr    error
+
+		// Set when the request has an idempotency key.
+		idempotentEditResponse *entities.NoteEdit
+		idempotentEditErr      error
+		shouldClearIdempotency bool
+		clearIdempotencyErr    error
+
+		quotaOverrideResponse *entities.QuotaOverride
+		quotaOverrideErr      error
+
 		shouldCallCountNote bool
-		countNoteResponse   int
-		countNoteErr        error
+		// Defaults to the start of the rolling window of the tier.
+		countNoteSince    *time.Time
+		countNoteResponse int
+		countNoteErr      error
+		// Used instead of countNoteResponse for organization pools.
+		countPoolResponse *dao.OrganizationNoteEditsCount
 
 		shouldCallLatestNote bool
 		latestNoteResponse   *entities.NoteEdit
@@ -33,38 +63,906 @@ func TestCanUpdateNote(t *testing.T) {
 		shouldCallCreateNote bool
 		createNoteErr        error
 
-		expect    int
-		expectErr error
-	}{
-		// Success cases.
+		// Events are emitted for every new edit.
+		emitErr error
+
+		shouldUpdateActivity bool
+		updateActivityErr    error
+
+		shouldCountNotes   bool
+		countNotesResponse map[entities.Target]int
+		countNotesErr      error
+
+		// Edits are created as pending reservations when set.
+		reservationTTL time.Duration
+
+		expect int
+		// Overrides the expected result, when the request is replayed.
+		expectReplay *models.CanUpdateNoteResult
+		expectErr    error
+	}{
+		// Success cases.
+		{
+			name: "CanUpdateNote/NewEdit",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			expect:               1,
+		},
+		{
+			name: "CanUpdateNote/NewEdit/Reservation",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			reservationTTL:       5 * time.Minute,
+			expect:               1,
+		},
+		{
+			name: "CanUpdateNote/NewEdit/IdempotencyKey",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				IdempotencyKey:   "idempotency-key-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes:      true,
+			idempotentEditErr:    dao.ErrNoNoteEditFound,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteErr:        dao.ErrNoNoteEditFound,
+			shouldCallCreateNote: true,
+			expect:               1,
+		},
+		{
+			// A retry of a request that already counted an edit returns the original result.
+			name: "CanUpdateNote/NewEdit/IdempotencyKeyReplay",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				IdempotencyKey:   "idempotency-key-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes: true,
+			idempotentEditResponse: &entities.NoteEdit{
+				ID:               &noteEditID,
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				Status:           entities.NoteEditStatusCommitted,
+				IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+				RemainingEdits:   lo.ToPtr(1),
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC)),
+			},
+			expectReplay: &models.CanUpdateNoteResult{RemainingEdits: 1, NoteEditID: &noteEditID, Replayed: true},
+		},
+		{
+			// Keys are released once IdempotencyKeyTTL is over, and the request counts a new edit.
+			name: "CanUpdateNote/NewEdit/IdempotencyKeyExpired",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				IdempotencyKey:   "idempotency-key-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes: true,
+			idempotentEditResponse: &entities.NoteEdit{
+				ID:               &noteEditID,
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				Status:           entities.NoteEditStatusCommitted,
+				IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+				RemainingEdits:   lo.ToPtr(1),
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldClearIdempotency: true,
+			shouldCallCountNote:    true,
+			countNoteResponse:      3,
+			shouldCallLatestNote:   true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			expect:               1,
+		},
+		{
+			// Keys of voided edits are released, and the retry counts a new edit.
+			name: "CanUpdateNote/NewEdit/IdempotencyKeyVoided",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				IdempotencyKey:   "idempotency-key-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes: true,
+			idempotentEditResponse: &entities.NoteEdit{
+				ID:               &noteEditID,
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				Status:           entities.NoteEditStatusCommitted,
+				IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+				RemainingEdits:   lo.ToPtr(1),
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC)),
+				VoidedAt:         lo.ToPtr(time.Date(2021, 1, 2, 12, 1, 0, 0, time.UTC)),
+			},
+			shouldClearIdempotency: true,
+			shouldCallCountNote:    true,
+			countNoteResponse:      3,
+			shouldCallLatestNote:   true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			expect:               1,
+		},
+		{
+			// Keys of reservations that expired are released, and the retry counts a new edit.
+			name: "CanUpdateNote/NewEdit/IdempotencyKeyReservationExpired",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				IdempotencyKey:   "idempotency-key-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes: true,
+			idempotentEditResponse: &entities.NoteEdit{
+				ID:               &noteEditID,
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				Status:           entities.NoteEditStatusPending,
+				ExpiresAt:        lo.ToPtr(time.Date(2021, 1, 2, 12, 5, 0, 0, time.UTC)),
+				IdempotencyKey:   lo.ToPtr("idempotency-key-1"),
+				RemainingEdits:   lo.ToPtr(1),
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC)),
+			},
+			shouldClearIdempotency: true,
+			shouldCallCountNote:    true,
+			countNoteResponse:      3,
+			shouldCallLatestNote:   true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			expect:               1,
+		},
+		{
+			name: "CanUpdateNote/NewEdit/NoEditRemaining",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    4,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			expect:               0,
+		},
+		{
+			name: "CanUpdateNote/RecentEdit",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+						BufferTime:    60 * time.Minute,
+					},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 23, 30, 0, 0, time.UTC)),
+			},
+			expect: 2,
+		},
+		{
+			// You are still allowed to continue edit a recent note, if you just reached your maximum edit count.
+			name: "CanUpdateNote/RecentEdit/EditsExhausted",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+						BufferTime:    60 * time.Minute,
+					},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    5,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 23, 30, 0, 0, time.UTC)),
+			},
+			expect: 0,
+		},
+		{
+			// Every edit counts when the tier has no edit session window.
+			name: "CanUpdateNote/RecentEdit/NoSessionWindow",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 23, 59, 59, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			expect:               1,
+		},
+		{
+			// The edit session window may be longer than the counting window.
+			name: "CanUpdateNote/RecentEdit/LongSessionWindow",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+						BufferTime:    30 * 24 * time.Hour,
+					},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    5,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2020, 12, 10, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: 0,
+		},
+		{
+			// Sliding sessions are extended by every edit within the session.
+			name: "CanUpdateNote/RecentEdit/SlidingSession",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+						BufferTime:    60 * time.Minute,
+					},
+				},
+				Notes: config.NoteTierInformation{
+					SlidingEditSession: true,
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				ID:               &noteEditID,
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 22, 0, 0, 0, time.UTC)),
+				LastActivityAt:   lo.ToPtr(time.Date(2021, 1, 2, 23, 30, 0, 0, time.UTC)),
+			},
+			shouldUpdateActivity: true,
+			expect:               2,
+		},
+		{
+			// The session has no activity yet, so it is measured from the edit that started it.
+			name: "CanUpdateNote/RecentEdit/SlidingSessionNoActivity",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+						BufferTime:    60 * time.Minute,
+					},
+				},
+				Notes: config.NoteTierInformation{
+					SlidingEditSession: true,
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				ID:               &noteEditID,
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 23, 30, 0, 0, time.UTC)),
+			},
+			shouldUpdateActivity: true,
+			expect:               2,
+		},
+		{
+			name: "CanUpdateNote/NewEdit/SlidingSessionInactive",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+						BufferTime:    60 * time.Minute,
+					},
+				},
+				Notes: config.NoteTierInformation{
+					SlidingEditSession: true,
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				ID:               &noteEditID,
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 21, 0, 0, 0, time.UTC)),
+				LastActivityAt:   lo.ToPtr(time.Date(2021, 1, 2, 22, 30, 0, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			expect:               1,
+		},
+		{
+			// Activity is ignored when sessions don't slide.
+			name: "CanUpdateNote/NewEdit/FixedSessionWithActivity",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+						BufferTime:    60 * time.Minute,
+					},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				ID:               &noteEditID,
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 22, 0, 0, 0, time.UTC)),
+				LastActivityAt:   lo.ToPtr(time.Date(2021, 1, 2, 23, 30, 0, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			expect:               1,
+		},
+		{
+			// Edits of new notes are counted until the caps of the tier are reached.
+			name: "CanUpdateNote/NewEdit/NoteCaps",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+				Notes: config.NoteTierInformation{
+					MaxNotes:          lo.ToPtr(10),
+					MaxNotesPerTarget: map[string]int{"company": 3},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteErr:        dao.ErrNoNoteEditFound,
+			shouldCountNotes:     true,
+			countNotesResponse:   map[entities.Target]int{entities.TargetCompany: 2, entities.TargetUser: 7},
+			shouldCallCreateNote: true,
+			expect:               1,
+		},
+		{
+			// Notes already edited in the window are already counted against the caps.
+			name: "CanUpdateNote/NewEdit/NoteCapsSameNote",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+				Notes: config.NoteTierInformation{
+					MaxNotesPerTarget: map[string]int{"company": 3},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			expect:               1,
+		},
+		{
+			// Caps of other targets don't apply.
+			name: "CanUpdateNote/NewEdit/NoteCapsOtherTarget",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+				Notes: config.NoteTierInformation{
+					MaxNotesPerTarget: map[string]int{"user": 0},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteErr:        dao.ErrNoNoteEditFound,
+			shouldCallCreateNote: true,
+			expect:               1,
+		},
+		{
+			name: "CanUpdateNote/ReadOnly",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID: "author-id-1",
+				ReadOnly: true,
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldCallCountNote: true,
+			countNoteResponse:   3,
+			expect:              2,
+		},
+		{
+			name: "CanUpdateNote/ReadOnly/EditsExhausted",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID: "author-id-1",
+				ReadOnly: true,
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldCallCountNote: true,
+			countNoteResponse:   5,
+			expect:              0,
+		},
+		{
+			name: "CanUpdateNote/ReadOnly/QuotaOverrideAdd",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID: "author-id-1",
+				ReadOnly: true,
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			quotaOverrideResponse: &entities.QuotaOverride{
+				AuthorID: "author-id-1",
+				Kind:     entities.QuotaOverrideKindAdd,
+				MaxEdits: 10,
+			},
+			shouldCallCountNote: true,
+			countNoteResponse:   5,
+			expect:              10,
+		},
+		{
+			name: "CanUpdateNote/ReadOnly/QuotaOverrideReplace",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID: "author-id-1",
+				ReadOnly: true,
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			quotaOverrideResponse: &entities.QuotaOverride{
+				AuthorID:  "author-id-1",
+				Kind:      entities.QuotaOverrideKindReplace,
+				MaxEdits:  2,
+				ExpiresAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCountNote: true,
+			countNoteResponse:   1,
+			expect:              1,
+		},
+		{
+			name: "CanUpdateNote/ReadOnly/QuotaOverrideDisable",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID: "author-id-1",
+				ReadOnly: true,
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			quotaOverrideResponse: &entities.QuotaOverride{
+				AuthorID: "author-id-1",
+				Kind:     entities.QuotaOverrideKindDisable,
+			},
+			shouldCallCountNote: true,
+			countNoteResponse:   5,
+			expect:              services.UnlimitedEdits - 5,
+		},
+		{
+			name: "CanUpdateNote/ReadOnly/QuotaOverrideExpired",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID: "author-id-1",
+				ReadOnly: true,
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			quotaOverrideResponse: &entities.QuotaOverride{
+				AuthorID:  "author-id-1",
+				Kind:      entities.QuotaOverrideKindAdd,
+				MaxEdits:  10,
+				ExpiresAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCountNote: true,
+			countNoteResponse:   5,
+			expect:              0,
+		},
+		{
+			// Granted edits can be consumed once the tier limit is reached.
+			name: "CanUpdateNote/NewEdit/QuotaOverrideAdd",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			quotaOverrideResponse: &entities.QuotaOverride{
+				AuthorID: "author-id-1",
+				Kind:     entities.QuotaOverrideKindAdd,
+				MaxEdits: 1,
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    5,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			expect:               0,
+		},
+		{
+			// Disabled quotas lift the caps on distinct notes too.
+			name: "CanUpdateNote/NewEdit/QuotaOverrideDisableNoteCaps",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+				Notes: config.NoteTierInformation{
+					MaxNotes:          lo.ToPtr(1),
+					MaxNotesPerTarget: map[string]int{"company": 1},
+				},
+			},
+			quotaOverrideResponse: &entities.QuotaOverride{
+				AuthorID: "author-id-1",
+				Kind:     entities.QuotaOverrideKindDisable,
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    5,
+			shouldCallLatestNote: true,
+			latestNoteErr:        dao.ErrNoNoteEditFound,
+			shouldCallCreateNote: true,
+			expect:               services.UnlimitedEdits - 5 - 1,
+		},
+
 		{
-			name: "CanUpdateNote/NewEdit",
+			name: "CanUpdateNote/CalendarWindow",
 			data: &models.CanUpdateNoteRequest{
 				AuthorID:         "author-id-1",
 				Target:           "company",
 				PublicIdentifier: "public-identifier-1",
 			},
-			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			now: time.Date(2021, 3, 15, 12, 0, 0, 0, time.UTC),
 			tier: config.TierInformation{
-				Notes: config.NoteTierInformation{
-					CountEditsOver: lo.ToPtr(24 * time.Hour),
-					MaxEdits:       5,
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						Window:   config.WindowMonth,
+						Timezone: "Europe/Paris",
+						MaxUses:  100,
+					},
 				},
 			},
+			shouldLockNotes:      true,
 			shouldCallCountNote:  true,
-			countNoteResponse:    3,
+			countNoteSince:       lo.ToPtr(time.Date(2021, 2, 28, 23, 0, 0, 0, time.UTC)),
+			countNoteResponse:    40,
 			shouldCallLatestNote: true,
-			latestNoteResponse: &entities.NoteEdit{
+			latestNoteErr:        dao.ErrNoNoteEditFound,
+			shouldCallCreateNote: true,
+			expect:               59,
+		},
+		{
+			name: "CanUpdateNote/BillingCycleWindow",
+			data: &models.CanUpdateNoteRequest{
 				AuthorID:         "author-id-1",
 				Target:           "company",
 				PublicIdentifier: "public-identifier-1",
-				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				ReadOnly:         true,
 			},
-			shouldCallCreateNote: true,
-			expect:               1,
+			now: time.Date(2021, 3, 15, 12, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						Window:  config.WindowBillingCycle,
+						MaxUses: 100,
+					},
+				},
+			},
+			billingPeriodStart:  lo.ToPtr(time.Date(2021, 3, 10, 8, 0, 0, 0, time.UTC)),
+			billingPeriodEnd:    lo.ToPtr(time.Date(2021, 4, 10, 8, 0, 0, 0, time.UTC)),
+			shouldCallCountNote: true,
+			countNoteSince:      lo.ToPtr(time.Date(2021, 3, 10, 8, 0, 0, 0, time.UTC)),
+			countNoteResponse:   40,
+			expect:              60,
 		},
+
+		// Local error cases.
 		{
-			name: "CanUpdateNote/NewEdit/NoEditRemaining",
+			name: "CanUpdateNote/EditsExhausted",
 			data: &models.CanUpdateNoteRequest{
 				AuthorID:         "author-id-1",
 				Target:           "company",
@@ -72,13 +970,16 @@ func TestCanUpdateNote(t *testing.T) {
 			},
 			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			tier: config.TierInformation{
-				Notes: config.NoteTierInformation{
-					CountEditsOver: lo.ToPtr(24 * time.Hour),
-					MaxEdits:       5,
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
 				},
 			},
+			shouldLockNotes:      true,
 			shouldCallCountNote:  true,
-			countNoteResponse:    4,
+			countNoteResponse:    5,
 			shouldCallLatestNote: true,
 			latestNoteResponse: &entities.NoteEdit{
 				AuthorID:         "author-id-1",
@@ -86,11 +987,10 @@ func TestCanUpdateNote(t *testing.T) {
 				PublicIdentifier: "public-identifier-1",
 				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
 			},
-			shouldCallCreateNote: true,
-			expect:               0,
+			expectErr: services.ErrNoteEditsExhausted,
 		},
 		{
-			name: "CanUpdateNote/RecentEdit",
+			name: "CanUpdateNote/Organization",
 			data: &models.CanUpdateNoteRequest{
 				AuthorID:         "author-id-1",
 				Target:           "company",
@@ -98,25 +998,48 @@ func TestCanUpdateNote(t *testing.T) {
 			},
 			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			tier: config.TierInformation{
-				Notes: config.NoteTierInformation{
-					CountEditsOver: lo.ToPtr(24 * time.Hour),
-					MaxEdits:       5,
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       50,
+					},
 				},
 			},
+			organizationID:       &organizationID,
+			shouldLockNotes:      true,
 			shouldCallCountNote:  true,
-			countNoteResponse:    3,
+			countPoolResponse:    &dao.OrganizationNoteEditsCount{Total: 40, Member: 2},
 			shouldCallLatestNote: true,
-			latestNoteResponse: &entities.NoteEdit{
+			latestNoteErr:        dao.ErrNoNoteEditFound,
+			shouldCallCreateNote: true,
+			expect:               9,
+		},
+		{
+			name: "CanUpdateNote/Organization/MemberLimit",
+			data: &models.CanUpdateNoteRequest{
 				AuthorID:         "author-id-1",
 				Target:           "company",
 				PublicIdentifier: "public-identifier-1",
-				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 23, 30, 0, 0, time.UTC)),
+				ReadOnly:         true,
 			},
-			expect: 2,
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       50,
+					},
+				},
+			},
+			organizationID:      &organizationID,
+			memberMaxEdits:      lo.ToPtr(5),
+			shouldCallCountNote: true,
+			countPoolResponse:   &dao.OrganizationNoteEditsCount{Total: 40, Member: 2},
+			expect:              3,
 		},
 		{
-			// You are still allowed to continue edit a recent note, if you just reached your maximum edit count.
-			name: "CanUpdateNote/RecentEdit/EditsExhausted",
+			// The pool is exhausted, even though the member did not reach their own limit.
+			name: "CanUpdateNote/Organization/PoolExhausted",
 			data: &models.CanUpdateNoteRequest{
 				AuthorID:         "author-id-1",
 				Target:           "company",
@@ -124,60 +1047,87 @@ func TestCanUpdateNote(t *testing.T) {
 			},
 			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			tier: config.TierInformation{
-				Notes: config.NoteTierInformation{
-					CountEditsOver: lo.ToPtr(24 * time.Hour),
-					MaxEdits:       5,
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       50,
+					},
 				},
 			},
+			organizationID:       &organizationID,
+			memberMaxEdits:       lo.ToPtr(5),
+			shouldLockNotes:      true,
 			shouldCallCountNote:  true,
-			countNoteResponse:    5,
+			countPoolResponse:    &dao.OrganizationNoteEditsCount{Total: 50, Member: 2},
 			shouldCallLatestNote: true,
-			latestNoteResponse: &entities.NoteEdit{
-				AuthorID:         "author-id-1",
-				Target:           "company",
-				PublicIdentifier: "public-identifier-1",
-				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 23, 30, 0, 0, time.UTC)),
-			},
-			expect: 0,
+			latestNoteErr:        dao.ErrNoNoteEditFound,
+			expectErr:            services.ErrNoteEditsExhausted,
 		},
 		{
-			name: "CanUpdateNote/ReadOnly",
+			name: "CanUpdateNote/TargetNotesExhausted",
 			data: &models.CanUpdateNoteRequest{
-				AuthorID: "author-id-1",
-				ReadOnly: true,
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
 			},
 			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
 				Notes: config.NoteTierInformation{
-					CountEditsOver: lo.ToPtr(24 * time.Hour),
-					MaxEdits:       5,
+					MaxNotesPerTarget: map[string]int{"company": 3},
 				},
 			},
-			shouldCallCountNote: true,
-			countNoteResponse:   3,
-			expect:              2,
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteErr:        dao.ErrNoNoteEditFound,
+			shouldCountNotes:     true,
+			countNotesResponse:   map[entities.Target]int{entities.TargetCompany: 3},
+			expectErr:            services.ErrNotesExhausted,
 		},
 		{
-			name: "CanUpdateNote/ReadOnly/EditsExhausted",
+			name: "CanUpdateNote/NotesExhausted",
 			data: &models.CanUpdateNoteRequest{
-				AuthorID: "author-id-1",
-				ReadOnly: true,
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
 			},
 			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
 				Notes: config.NoteTierInformation{
-					CountEditsOver: lo.ToPtr(24 * time.Hour),
-					MaxEdits:       5,
+					MaxNotes: lo.ToPtr(10),
 				},
 			},
-			shouldCallCountNote: true,
-			countNoteResponse:   5,
-			expect:              0,
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteErr:        dao.ErrNoNoteEditFound,
+			shouldCountNotes:     true,
+			countNotesResponse:   map[entities.Target]int{entities.TargetCompany: 2, entities.TargetUser: 8},
+			expectErr:            services.ErrNotesExhausted,
+		},
+		{
+			name:      "CanUpdateNote/InvalidRequest",
+			data:      &models.CanUpdateNoteRequest{},
+			expectErr: services.ErrInvalidRequest,
 		},
 
-		// Local error cases.
+		// Dependency error cases.
 		{
-			name: "CanUpdateNote/EditsExhausted",
+			name: "CreateNoteError",
 			data: &models.CanUpdateNoteRequest{
 				AuthorID:         "author-id-1",
 				Target:           "company",
@@ -185,13 +1135,16 @@ func TestCanUpdateNote(t *testing.T) {
 			},
 			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			tier: config.TierInformation{
-				Notes: config.NoteTierInformation{
-					CountEditsOver: lo.ToPtr(24 * time.Hour),
-					MaxEdits:       5,
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
 				},
 			},
+			shouldLockNotes:      true,
 			shouldCallCountNote:  true,
-			countNoteResponse:    5,
+			countNoteResponse:    3,
 			shouldCallLatestNote: true,
 			latestNoteResponse: &entities.NoteEdit{
 				AuthorID:         "author-id-1",
@@ -199,17 +1152,42 @@ func TestCanUpdateNote(t *testing.T) {
 				PublicIdentifier: "public-identifier-1",
 				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
 			},
-			expectErr: services.ErrNoteEditsExhausted,
+			shouldCallCreateNote: true,
+			createNoteErr:        FooErr,
+			expectErr:            FooErr,
 		},
 		{
-			name:      "CanUpdateNote/InvalidRequest",
-			data:      &models.CanUpdateNoteRequest{},
-			expectErr: services.ErrInvalidRequest,
+			name: "EmitError",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    4,
+			shouldCallLatestNote: true,
+			latestNoteResponse: &entities.NoteEdit{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCreateNote: true,
+			emitErr:              FooErr,
+			expectErr:            FooErr,
 		},
-
-		// Dependency error cases.
 		{
-			name: "CreateNoteError",
+			name: "UpdateActivityError",
 			data: &models.CanUpdateNoteRequest{
 				AuthorID:         "author-id-1",
 				Target:           "company",
@@ -217,26 +1195,34 @@ func TestCanUpdateNote(t *testing.T) {
 			},
 			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+						BufferTime:    60 * time.Minute,
+					},
+				},
 				Notes: config.NoteTierInformation{
-					CountEditsOver: lo.ToPtr(24 * time.Hour),
-					MaxEdits:       5,
+					SlidingEditSession: true,
 				},
 			},
+			shouldLockNotes:      true,
 			shouldCallCountNote:  true,
 			countNoteResponse:    3,
 			shouldCallLatestNote: true,
 			latestNoteResponse: &entities.NoteEdit{
+				ID:               &noteEditID,
 				AuthorID:         "author-id-1",
 				Target:           "company",
 				PublicIdentifier: "public-identifier-1",
-				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 23, 30, 0, 0, time.UTC)),
 			},
-			shouldCallCreateNote: true,
-			createNoteErr:        FooErr,
+			shouldUpdateActivity: true,
+			updateActivityErr:    FooErr,
 			expectErr:            FooErr,
 		},
 		{
-			name: "GetLatestNoteError",
+			name: "CountNotesError",
 			data: &models.CanUpdateNoteRequest{
 				AuthorID:         "author-id-1",
 				Target:           "company",
@@ -244,17 +1230,136 @@ func TestCanUpdateNote(t *testing.T) {
 			},
 			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
 				Notes: config.NoteTierInformation{
-					CountEditsOver: lo.ToPtr(24 * time.Hour),
-					MaxEdits:       5,
+					MaxNotes: lo.ToPtr(10),
+				},
+			},
+			shouldLockNotes:      true,
+			shouldCallCountNote:  true,
+			countNoteResponse:    3,
+			shouldCallLatestNote: true,
+			latestNoteErr:        dao.ErrNoNoteEditFound,
+			shouldCountNotes:     true,
+			countNotesErr:        FooErr,
+			expectErr:            FooErr,
+		},
+		{
+			name: "GetLatestNoteError",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
 				},
 			},
+			shouldLockNotes:      true,
 			shouldCallCountNote:  true,
 			countNoteResponse:    3,
 			shouldCallLatestNote: true,
 			latestNoteErr:        FooErr,
 			expectErr:            FooErr,
 		},
+		{
+			name: "LockNotesError",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes: true,
+			lockNotesErr:    FooErr,
+			expectErr:       FooErr,
+		},
+		{
+			name: "GetIdempotentEditError",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				IdempotencyKey:   "idempotency-key-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes:   true,
+			idempotentEditErr: FooErr,
+			expectErr:         FooErr,
+		},
+		{
+			name: "ClearIdempotencyKeyError",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+				IdempotencyKey:   "idempotency-key-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes: true,
+			idempotentEditResponse: &entities.NoteEdit{
+				ID:        &noteEditID,
+				AuthorID:  "author-id-1",
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldClearIdempotency: true,
+			clearIdempotencyErr:    FooErr,
+			expectErr:              FooErr,
+		},
+		{
+			name: "QuotaOverrideError",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes:  true,
+			quotaOverrideErr: FooErr,
+			expectErr:        FooErr,
+		},
 		{
 			name: "CountNotesError",
 			data: &models.CanUpdateNoteRequest{
@@ -264,15 +1369,61 @@ func TestCanUpdateNote(t *testing.T) {
 			},
 			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			tier: config.TierInformation{
-				Notes: config.NoteTierInformation{
-					CountEditsOver: lo.ToPtr(24 * time.Hour),
-					MaxEdits:       5,
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldLockNotes:     true,
+			shouldCallCountNote: true,
+			countNoteErr:        FooErr,
+			expectErr:           FooErr,
+		},
+		{
+			name: "CountOrganizationNotesError",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       50,
+					},
 				},
 			},
+			organizationID:      &organizationID,
+			shouldLockNotes:     true,
 			shouldCallCountNote: true,
 			countNoteErr:        FooErr,
 			expectErr:           FooErr,
 		},
+		{
+			name: "LockOrganizationNotesError",
+			data: &models.CanUpdateNoteRequest{
+				AuthorID:         "author-id-1",
+				Target:           "company",
+				PublicIdentifier: "public-identifier-1",
+			},
+			now: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       50,
+					},
+				},
+			},
+			organizationID:  &organizationID,
+			shouldLockNotes: true,
+			lockNotesErr:    FooErr,
+			expectErr:       FooErr,
+		},
 	}
 
 	for _, tt := range testData {
@@ -280,53 +1431,212 @@ func TestCanUpdateNote(t *testing.T) {
 			countNoteRepository := daomocks.NewMockCountNoteEditsByAuthorRepository(t)
 			latestNoteRepository := daomocks.NewMockGetLatestNoteEditByAuthorRepository(t)
 			createNoteRepository := daomocks.NewMockCreateNoteEditRepository(t)
+			lockNotesRepository := daomocks.NewMockLockNoteEditsByAuthorRepository(t)
+			getQuotaOverrideRepository := daomocks.NewMockGetQuotaOverrideByAuthorRepository(t)
+			countPoolRepository := daomocks.NewMockCountNoteEditsByOrganizationRepository(t)
+			lockPoolRepository := daomocks.NewMockLockNoteEditsByOrganizationRepository(t)
+			getIdempotentEditRepository := daomocks.NewMockGetNoteEditByIdempotencyKeyRepository(t)
+			clearIdempotencyRepository := daomocks.NewMockClearNoteEditIdempotencyKeyRepository(t)
+			updateActivityRepository := daomocks.NewMockUpdateNoteEditActivityRepository(t)
+			countNotesRepository := daomocks.NewMockCountNotesByAuthorRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+			emitter := eventsmocks.NewMockEmitter(t)
+
+			if tt.shouldLockNotes {
+				runInTransactionRepository.
+					On("RunInTransaction", mock.Anything, mock.Anything).
+					Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				if tt.organizationID != nil {
+					lockPoolRepository.
+						On("LockNoteEditsByOrganization", mock.Anything, *tt.organizationID).
+						Return(tt.lockNotesErr)
+				} else {
+					lockNotesRepository.
+						On("LockNoteEditsByAuthor", mock.Anything, tt.data.AuthorID).
+						Return(tt.lockNotesErr)
+				}
+			}
+
+			if tt.idempotentEditResponse != nil || tt.idempotentEditErr != nil {
+				getIdempotentEditRepository.
+					On("GetNoteEditByIdempotencyKey", mock.Anything, tt.data.AuthorID, tt.data.IdempotencyKey).
+					Return(tt.idempotentEditResponse, tt.idempotentEditErr)
+			}
+
+			if tt.shouldClearIdempotency {
+				clearIdempotencyRepository.
+					On("ClearNoteEditIdempotencyKey", mock.Anything, *tt.idempotentEditResponse.ID).
+					Return(tt.clearIdempotencyErr)
+			}
+
+			// Quota overrides are looked up right before counting individual edits.
+			if tt.organizationID == nil && (tt.shouldCallCountNote || tt.quotaOverrideErr != nil) {
+				quotaOverrideErr := tt.quotaOverrideErr
+				if tt.quotaOverrideResponse == nil && quotaOverrideErr == nil {
+					quotaOverrideErr = dao.ErrQuotaOverrideNotFound
+				}
+
+				getQuotaOverrideRepository.
+					On("GetQuotaOverrideByAuthor", mock.Anything, tt.data.AuthorID).
+					Return(tt.quotaOverrideResponse, quotaOverrideErr)
+			}
 
 			if tt.shouldCallCountNote {
-				countNoteRepository.
-					On("CountNoteEditsByAuthor", context.TODO(), tt.data.AuthorID, lo.ToPtr(tt.now.UTC().Add(-*tt.tier.Notes.CountEditsOver))).
-					Return(tt.countNoteResponse, tt.countNoteErr)
+				countNoteSince := tt.countNoteSince
+				if countNoteSince == nil {
+					countNoteSince = lo.ToPtr(tt.now.UTC().Add(-*tt.tier.NoteEdits().CountUsesOver))
+				}
+
+				if tt.organizationID != nil {
+					countPoolRepository.
+						On("CountNoteEditsByOrganization", mock.Anything, *tt.organizationID, tt.data.AuthorID, countNoteSince, tt.now).
+						Return(tt.countPoolResponse, tt.countNoteErr)
+				} else {
+					countNoteRepository.
+						On("CountNoteEditsByAuthor", mock.Anything, tt.data.AuthorID, countNoteSince, tt.now).
+						Return(tt.countNoteResponse, tt.countNoteErr)
+				}
 			}
 
 			if tt.shouldCallLatestNote {
 				latestNoteRepository.
 					On(
 						"GetLatestNoteEditByAuthor",
-						context.TODO(),
+						mock.Anything,
 						tt.data.AuthorID,
 						entities.Target(tt.data.Target),
 						tt.data.PublicIdentifier,
+						tt.now,
 					).
 					Return(tt.latestNoteResponse, tt.latestNoteErr)
 			}
 
+			var expectExpiresAt *time.Time
+			if tt.reservationTTL > 0 {
+				expectExpiresAt = lo.ToPtr(tt.now.Add(tt.reservationTTL))
+			}
+
 			if tt.shouldCallCreateNote {
+				createData := &dao.CreateNoteEditData{
+					Target:           entities.Target(tt.data.Target),
+					PublicIdentifier: tt.data.PublicIdentifier,
+					OrganizationID:   tt.organizationID,
+					Status:           entities.NoteEditStatusCommitted,
+				}
+				if tt.data.IdempotencyKey != "" {
+					createData.IdempotencyKey = lo.ToPtr(tt.data.IdempotencyKey)
+					createData.RemainingEdits = lo.ToPtr(tt.expect)
+				}
+				if expectExpiresAt != nil {
+					createData.Status = entities.NoteEditStatusPending
+					createData.ExpiresAt = expectExpiresAt
+				}
+
 				createNoteRepository.
-					On(
-						"CreateNoteEdit",
-						context.TODO(),
-						tt.data.AuthorID,
-						&dao.CreateNoteEditData{
-							Target:           entities.Target(tt.data.Target),
-							PublicIdentifier: tt.data.PublicIdentifier,
-						},
-					).
-					Return(nil, tt.createNoteErr)
+					On("CreateNoteEdit", mock.Anything, tt.data.AuthorID, createData).
+					Return(&entities.NoteEdit{ID: &noteEditID, ExpiresAt: expectExpiresAt}, tt.createNoteErr)
+			}
+
+			if tt.shouldCallCreateNote && tt.createNoteErr == nil {
+				emitter.
+					On("Emit", mock.Anything, &events.NoteEditConsumed{
+						NoteEditID:       noteEditID,
+						AuthorID:         tt.data.AuthorID,
+						OrganizationID:   tt.organizationID,
+						Target:           tt.data.Target,
+						PublicIdentifier: tt.data.PublicIdentifier,
+						Tier:             "free",
+						RemainingEdits:   tt.expect,
+						ConsumedAt:       tt.now,
+					}).
+					Return(tt.emitErr)
+
+				if tt.expect == 0 && tt.emitErr == nil {
+					emitter.
+						On("Emit", mock.Anything, &events.NoteEditsExhausted{
+							AuthorID:       tt.data.AuthorID,
+							OrganizationID: tt.organizationID,
+							Tier:           "free",
+							ExhaustedAt:    tt.now,
+						}).
+						Return(nil)
+				}
+			}
+
+			if tt.shouldCountNotes {
+				notesSince := lo.ToPtr(tt.now.UTC().Add(-*tt.tier.NoteEdits().CountUsesOver))
+				countNotesRepository.
+					On("CountNotesByAuthor", mock.Anything, tt.data.AuthorID, notesSince, tt.now).
+					Return(tt.countNotesResponse, tt.countNotesErr)
+			}
+
+			if tt.shouldUpdateActivity {
+				updateActivityRepository.
+					On("UpdateNoteEditActivity", mock.Anything, *tt.latestNoteResponse.ID, tt.now).
+					Return(tt.latestNoteResponse, tt.updateActivityErr)
 			}
 
 			service := services.NewCanUpdateNoteService(
 				countNoteRepository,
 				createNoteRepository,
 				latestNoteRepository,
+				lockNotesRepository,
+				getQuotaOverrideRepository,
+				countPoolRepository,
+				lockPoolRepository,
+				getIdempotentEditRepository,
+				clearIdempotencyRepository,
+				updateActivityRepository,
+				countNotesRepository,
+				runInTransactionRepository,
+				emitter,
+				tt.reservationTTL,
 			)
 
-			remainingEdits, err := service.Exec(context.TODO(), tt.data, tt.tier, tt.now)
+			tier := &models.ResolvedTier{
+				Name:               "free",
+				TierInformation:    tt.tier,
+				BillingPeriodStart: tt.billingPeriodStart,
+				BillingPeriodEnd:   tt.billingPeriodEnd,
+				OrganizationID:     tt.organizationID,
+				MemberMaxEdits:     tt.memberMaxEdits,
+			}
+
+			result, err := service.Exec(context.TODO(), tt.data, tier, tt.now)
 
 			require.ErrorIs(t, err, tt.expectErr)
-			require.Equal(t, tt.expect, remainingEdits)
+
+			if tt.expectErr != nil {
+				require.Nil(t, result)
+			} else if tt.expectReplay != nil {
+				require.Equal(t, tt.expectReplay, result)
+			} else {
+				// The edit counted by the request is returned, so it can be released.
+				expect := &models.CanUpdateNoteResult{RemainingEdits: tt.expect}
+				if tt.shouldCallCreateNote {
+					expect.NoteEditID = &noteEditID
+					expect.ExpiresAt = expectExpiresAt
+				}
+
+				require.Equal(t, expect, result)
+			}
 
 			countNoteRepository.AssertExpectations(t)
 			latestNoteRepository.AssertExpectations(t)
 			createNoteRepository.AssertExpectations(t)
+			lockNotesRepository.AssertExpectations(t)
+			getQuotaOverrideRepository.AssertExpectations(t)
+			countPoolRepository.AssertExpectations(t)
+			lockPoolRepository.AssertExpectations(t)
+			getIdempotentEditRepository.AssertExpectations(t)
+			clearIdempotencyRepository.AssertExpectations(t)
+			updateActivityRepository.AssertExpectations(t)
+			countNotesRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+			emitter.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/samber/lo"
+	"time"
+)
+
+// CanUpdateNotesService checks whether many notes of an author can be edited at once, without counting any edit.
+type CanUpdateNotesService interface {
+	Exec(
+		ctx context.Context,
+		request *models.CanUpdateNotesRequest,
+		tier *models.ResolvedTier,
+		now time.Time,
+	) (*models.CanUpdateNotesResult, error)
+}
+
+type canUpdateNotesServiceImpl struct {
+	countEditsRepository       dao.CountNoteEditsByAuthorRepository
+	listLatestEditsRepository  dao.ListLatestNoteEditsByAuthorRepository
+	getQuotaOverrideRepository dao.GetQuotaOverrideByAuthorRepository
+
+	countOrganizationEditsRepository dao.CountNoteEditsByOrganizationRepository
+}
+
+func (s *canUpdateNotesServiceImpl) Exec(
+	ctx context.Context,
+	request *models.CanUpdateNotesRequest,
+	tier *models.ResolvedTier,
+	now time.Time,
+) (*models.CanUpdateNotesResult, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	tier, err := applyNoteQuotaOverride(ctx, s.getQuotaOverrideRepository, request.AuthorID, tier, now)
+	if err != nil {
+		return nil, err
+	}
+
+	remainingEdits, err := countRemainingNoteEdits(
+		ctx, s.countEditsRepository, s.countOrganizationEditsRepository, request.AuthorID, tier, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	latestEdits, err := s.listLatestEditsRepository.ListLatestNoteEditsByAuthor(
+		ctx,
+		request.AuthorID,
+		lo.Map(request.Notes, func(note *models.NoteKey, _ int) dao.NoteKey {
+			return dao.NoteKey{Target: entities.Target(note.Target), PublicIdentifier: note.PublicIdentifier}
+		}),
+		now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list latest note edits: %w", err)
+	}
+
+	latestEditsByNote := lo.KeyBy(latestEdits, func(noteEdit *entities.NoteEdit) dao.NoteKey {
+		return dao.NoteKey{Target: noteEdit.Target, PublicIdentifier: noteEdit.PublicIdentifier}
+	})
+
+	return &models.CanUpdateNotesResult{
+		RemainingEdits: remainingEdits,
+		Notes: lo.Map(request.Notes, func(note *models.NoteKey, _ int) *models.NoteEditSession {
+			latestEdit := latestEditsByNote[dao.NoteKey{
+				Target:           entities.Target(note.Target),
+				PublicIdentifier: note.PublicIdentifier,
+			}]
+
+			return &models.NoteEditSession{
+				Target:           note.Target,
+				PublicIdentifier: note.PublicIdentifier,
+				InSession: withinBuffer(
+					noteSessionActivity(latestEdit, tier.Notes), tier.NoteEdits().BufferTime, now,
+				),
+			}
+		}),
+	}, nil
+}
+
+func NewCanUpdateNotesService(
+	countEditsRepository dao.CountNoteEditsByAuthorRepository,
+	listLatestEditsRepository dao.ListLatestNoteEditsByAuthorRepository,
+	getQuotaOverrideRepository dao.GetQuotaOverrideByAuthorRepository,
+	countOrganizationEditsRepository dao.CountNoteEditsByOrganizationRepository,
+) CanUpdateNotesService {
+	return &canUpdateNotesServiceImpl{
+		countEditsRepository:       countEditsRepository,
+		listLatestEditsRepository:  listLatestEditsRepository,
+		getQuotaOverrideRepository: getQuotaOverrideRepository,
+
+		countOrganizationEditsRepository: countOrganizationEditsRepository,
+	}
+}
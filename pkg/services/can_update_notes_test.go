@@ -0,0 +1,320 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCanUpdateNotes(t *testing.T) {
+	organizationID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+
+	notes := []*models.NoteKey{
+		{Target: "company", PublicIdentifier: "public-identifier-1"},
+		{Target: "company", PublicIdentifier: "public-identifier-2"},
+		{Target: "user", PublicIdentifier: "public-identifier-1"},
+	}
+
+	testData := []struct {
+		name string
+
+		data *models.CanUpdateNotesRequest
+		now  time.Time
+		tier config.TierInformation
+
+		// Set when the edits are drawn from the pool of an organization.
+		organizationID *uuid.UUID
+
+		quotaOverrideErr error
+
+		shouldCallCount bool
+		countResponse   int
+		countPool       *dao.OrganizationNoteEditsCount
+		countErr        error
+
+		shouldCallList bool
+		listResponse   []*entities.NoteEdit
+		listErr        error
+
+		expect    *models.CanUpdateNotesResult
+		expectErr error
+	}{
+		// Success cases.
+		{
+			name: "CanUpdateNotes",
+			data: &models.CanUpdateNotesRequest{AuthorID: "author-id-1", Notes: notes},
+			now:  time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+						BufferTime:    60 * time.Minute,
+					},
+				},
+			},
+			shouldCallCount: true,
+			countResponse:   3,
+			shouldCallList:  true,
+			listResponse: []*entities.NoteEdit{
+				{
+					AuthorID:         "author-id-1",
+					Target:           entities.TargetCompany,
+					PublicIdentifier: "public-identifier-1",
+					CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 23, 30, 0, 0, time.UTC)),
+				},
+				{
+					AuthorID:         "author-id-1",
+					Target:           entities.TargetCompany,
+					PublicIdentifier: "public-identifier-2",
+					CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 22, 0, 0, 0, time.UTC)),
+				},
+			},
+			expect: &models.CanUpdateNotesResult{
+				RemainingEdits: 2,
+				Notes: []*models.NoteEditSession{
+					{Target: "company", PublicIdentifier: "public-identifier-1", InSession: true},
+					{Target: "company", PublicIdentifier: "public-identifier-2"},
+					{Target: "user", PublicIdentifier: "public-identifier-1"},
+				},
+			},
+		},
+		{
+			name: "CanUpdateNotes/SlidingSession",
+			data: &models.CanUpdateNotesRequest{AuthorID: "author-id-1", Notes: notes[:1]},
+			now:  time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+						BufferTime:    60 * time.Minute,
+					},
+				},
+				Notes: config.NoteTierInformation{
+					SlidingEditSession: true,
+				},
+			},
+			shouldCallCount: true,
+			countResponse:   5,
+			shouldCallList:  true,
+			listResponse: []*entities.NoteEdit{
+				{
+					AuthorID:         "author-id-1",
+					Target:           entities.TargetCompany,
+					PublicIdentifier: "public-identifier-1",
+					CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 20, 0, 0, 0, time.UTC)),
+					LastActivityAt:   lo.ToPtr(time.Date(2021, 1, 2, 23, 50, 0, 0, time.UTC)),
+				},
+			},
+			expect: &models.CanUpdateNotesResult{
+				RemainingEdits: 0,
+				Notes: []*models.NoteEditSession{
+					{Target: "company", PublicIdentifier: "public-identifier-1", InSession: true},
+				},
+			},
+		},
+		{
+			// Every edit counts when the tier has no edit session window.
+			name: "CanUpdateNotes/NoSessionWindow",
+			data: &models.CanUpdateNotesRequest{AuthorID: "author-id-1", Notes: notes[:1]},
+			now:  time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldCallCount: true,
+			countResponse:   1,
+			shouldCallList:  true,
+			listResponse: []*entities.NoteEdit{
+				{
+					AuthorID:         "author-id-1",
+					Target:           entities.TargetCompany,
+					PublicIdentifier: "public-identifier-1",
+					CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 23, 59, 59, 0, time.UTC)),
+				},
+			},
+			expect: &models.CanUpdateNotesResult{
+				RemainingEdits: 4,
+				Notes: []*models.NoteEditSession{
+					{Target: "company", PublicIdentifier: "public-identifier-1"},
+				},
+			},
+		},
+		{
+			name: "CanUpdateNotes/Organization",
+			data: &models.CanUpdateNotesRequest{AuthorID: "author-id-1", Notes: notes[:1]},
+			now:  time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       50,
+						BufferTime:    60 * time.Minute,
+					},
+				},
+			},
+			organizationID:  &organizationID,
+			shouldCallCount: true,
+			countPool:       &dao.OrganizationNoteEditsCount{Total: 40, Member: 2},
+			shouldCallList:  true,
+			listResponse:    []*entities.NoteEdit{},
+			expect: &models.CanUpdateNotesResult{
+				RemainingEdits: 10,
+				Notes: []*models.NoteEditSession{
+					{Target: "company", PublicIdentifier: "public-identifier-1"},
+				},
+			},
+		},
+
+		// Local error cases.
+		{
+			name:      "CanUpdateNotes/NoNotes",
+			data:      &models.CanUpdateNotesRequest{AuthorID: "author-id-1"},
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "CanUpdateNotes/InvalidTarget",
+			data: &models.CanUpdateNotesRequest{
+				AuthorID: "author-id-1",
+				Notes:    []*models.NoteKey{{Target: "school", PublicIdentifier: "public-identifier-1"}},
+			},
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "QuotaOverrideError",
+			data: &models.CanUpdateNotesRequest{AuthorID: "author-id-1", Notes: notes},
+			now:  time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			quotaOverrideErr: FooErr,
+			expectErr:        FooErr,
+		},
+		{
+			name: "CountNotesError",
+			data: &models.CanUpdateNotesRequest{AuthorID: "author-id-1", Notes: notes},
+			now:  time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldCallCount: true,
+			countErr:        FooErr,
+			expectErr:       FooErr,
+		},
+		{
+			name: "ListLatestNotesError",
+			data: &models.CanUpdateNotesRequest{AuthorID: "author-id-1", Notes: notes},
+			now:  time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			tier: config.TierInformation{
+				Features: map[string]config.FeatureTierInformation{
+					config.NoteEditsFeature: {
+						CountUsesOver: lo.ToPtr(24 * time.Hour),
+						MaxUses:       5,
+					},
+				},
+			},
+			shouldCallCount: true,
+			countResponse:   3,
+			shouldCallList:  true,
+			listErr:         FooErr,
+			expectErr:       FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			countRepository := daomocks.NewMockCountNoteEditsByAuthorRepository(t)
+			listLatestRepository := daomocks.NewMockListLatestNoteEditsByAuthorRepository(t)
+			getQuotaOverrideRepository := daomocks.NewMockGetQuotaOverrideByAuthorRepository(t)
+			countPoolRepository := daomocks.NewMockCountNoteEditsByOrganizationRepository(t)
+
+			if tt.organizationID == nil && (tt.shouldCallCount || tt.quotaOverrideErr != nil) {
+				quotaOverrideErr := tt.quotaOverrideErr
+				if quotaOverrideErr == nil {
+					quotaOverrideErr = dao.ErrQuotaOverrideNotFound
+				}
+
+				getQuotaOverrideRepository.
+					On("GetQuotaOverrideByAuthor", context.TODO(), tt.data.AuthorID).
+					Return(nil, quotaOverrideErr)
+			}
+
+			if tt.shouldCallCount {
+				countSince := lo.ToPtr(tt.now.UTC().Add(-*tt.tier.NoteEdits().CountUsesOver))
+
+				if tt.organizationID != nil {
+					countPoolRepository.
+						On("CountNoteEditsByOrganization", context.TODO(), *tt.organizationID, tt.data.AuthorID, countSince, tt.now).
+						Return(tt.countPool, tt.countErr)
+				} else {
+					countRepository.
+						On("CountNoteEditsByAuthor", context.TODO(), tt.data.AuthorID, countSince, tt.now).
+						Return(tt.countResponse, tt.countErr)
+				}
+			}
+
+			if tt.shouldCallList {
+				listLatestRepository.
+					On(
+						"ListLatestNoteEditsByAuthor",
+						context.TODO(),
+						tt.data.AuthorID,
+						lo.Map(tt.data.Notes, func(note *models.NoteKey, _ int) dao.NoteKey {
+							return dao.NoteKey{Target: entities.Target(note.Target), PublicIdentifier: note.PublicIdentifier}
+						}),
+						tt.now,
+					).
+					Return(tt.listResponse, tt.listErr)
+			}
+
+			service := services.NewCanUpdateNotesService(
+				countRepository,
+				listLatestRepository,
+				getQuotaOverrideRepository,
+				countPoolRepository,
+			)
+
+			tier := &models.ResolvedTier{
+				Name:            "free",
+				TierInformation: tt.tier,
+				OrganizationID:  tt.organizationID,
+			}
+
+			result, err := service.Exec(context.TODO(), tt.data, tier, tt.now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, result)
+
+			countRepository.AssertExpectations(t)
+			listLatestRepository.AssertExpectations(t)
+			getQuotaOverrideRepository.AssertExpectations(t)
+			countPoolRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"time"
+)
+
+type CancelSubscriptionService interface {
+	Exec(ctx context.Context, request *models.CancelSubscriptionRequest, now time.Time) (*entities.Subscription, error)
+}
+
+type cancelSubscriptionServiceImpl struct {
+	getSubscriptionRepository    dao.GetSubscriptionByUserRepository
+	updateSubscriptionRepository dao.UpdateSubscriptionRepository
+	lockSubscriptionsRepository  dao.LockSubscriptionsByUserRepository
+	runInTransactionRepository   dao.RunInTransactionRepository
+
+	emitter events.Emitter
+}
+
+func (s *cancelSubscriptionServiceImpl) Exec(
+	ctx context.Context, request *models.CancelSubscriptionRequest, now time.Time,
+) (*entities.Subscription, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	var subscription *entities.Subscription
+
+	// The change is announced in the same transaction, so the event is only sent if the change is saved.
+	err := s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+		// A parallel write, for example from a Stripe event, could otherwise be overwritten.
+		if err := s.lockSubscriptionsRepository.LockSubscriptionsByUser(ctx, request.UserID); err != nil {
+			return fmt.Errorf("lock subscriptions: %w", err)
+		}
+
+		currentSubscription, err := s.getSubscriptionRepository.GetSubscriptionByUser(ctx, request.UserID)
+		if err != nil {
+			if errors.Is(err, dao.ErrSubscriptionNotFound) {
+				return ErrSubscriptionNotFound
+			}
+
+			return fmt.Errorf("get subscription: %w", err)
+		}
+
+		if !currentSubscription.IsRunning(now) {
+			return ErrSubscriptionNotFound
+		}
+
+		canceledAt := now.UTC()
+
+		data := subscriptionUpdateData(currentSubscription)
+		data.CanceledAt = &canceledAt
+
+		if request.AtPeriodEnd {
+			// The subscription keeps running until the end of the period the user already paid for.
+			if currentSubscription.CurrentPeriodEnd == nil || !currentSubscription.CurrentPeriodEnd.After(now) {
+				return ErrNoBillingPeriod
+			}
+
+			data.CancelAtPeriodEnd = true
+			data.EndsAt = currentSubscription.CurrentPeriodEnd
+		} else {
+			data.Status = entities.SubscriptionStatusCanceled
+			data.CancelAtPeriodEnd = false
+			data.EndsAt = &canceledAt
+		}
+
+		subscription, err = s.updateSubscriptionRepository.UpdateSubscription(ctx, *currentSubscription.ID, data)
+		if err != nil {
+			return fmt.Errorf("update subscription: %w", err)
+		}
+
+		if err := s.emitter.Emit(ctx, subscriptionChanged(subscription, currentSubscription.Tier, now)); err != nil {
+			return fmt.Errorf("emit subscription changed: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func NewCancelSubscriptionService(
+	getSubscriptionRepository dao.GetSubscriptionByUserRepository,
+	updateSubscriptionRepository dao.UpdateSubscriptionRepository,
+	lockSubscriptionsRepository dao.LockSubscriptionsByUserRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+	emitter events.Emitter,
+) CancelSubscriptionService {
+	return &cancelSubscriptionServiceImpl{
+		getSubscriptionRepository:    getSubscriptionRepository,
+		updateSubscriptionRepository: updateSubscriptionRepository,
+		lockSubscriptionsRepository:  lockSubscriptionsRepository,
+		runInTransactionRepository:   runInTransactionRepository,
+		emitter:                      emitter,
+	}
+}
@@ -0,0 +1,286 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	eventsmocks "github.com/in-rich/uservice-subscription/pkg/events/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCancelSubscription(t *testing.T) {
+	runningSubscription := &entities.Subscription{
+		ID:                 lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:             "user-id-1",
+		Tier:               "pro",
+		Status:             entities.SubscriptionStatusActive,
+		StartedAt:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CurrentPeriodStart: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CurrentPeriodEnd:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	testData := []struct {
+		name string
+
+		request *models.CancelSubscriptionRequest
+		now     time.Time
+
+		// The lock is always taken before getting the subscription.
+		shouldCallLockSubscriptions bool
+		lockSubscriptionsErr        error
+
+		shouldCallGetSubscription bool
+		getSubscriptionResponse   *entities.Subscription
+		getSubscriptionErr        error
+
+		shouldCallUpdateSubscription bool
+		updateSubscriptionData       *dao.UpdateSubscriptionData
+		updateSubscriptionErr        error
+
+		emitErr error
+
+		expectErr error
+	}{
+		{
+			name: "CancelSubscription/Immediately",
+			request: &models.CancelSubscriptionRequest{
+				UserID: "user-id-1",
+			},
+			now:                          time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      runningSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:               "pro",
+				Status:             entities.SubscriptionStatusCanceled,
+				EndsAt:             lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodStart: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CanceledAt:         lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "CancelSubscription/AtPeriodEnd",
+			request: &models.CancelSubscriptionRequest{
+				UserID:      "user-id-1",
+				AtPeriodEnd: true,
+			},
+			now:                          time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      runningSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:               "pro",
+				Status:             entities.SubscriptionStatusActive,
+				EndsAt:             lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodStart: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CancelAtPeriodEnd:  true,
+				CanceledAt:         lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+
+		// Local error cases.
+		{
+			name: "CancelSubscription/AtPeriodEnd/NoBillingPeriod",
+			request: &models.CancelSubscriptionRequest{
+				UserID:      "user-id-1",
+				AtPeriodEnd: true,
+			},
+			now:                       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription: true,
+			getSubscriptionResponse: &entities.Subscription{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    "user-id-1",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expectErr: services.ErrNoBillingPeriod,
+		},
+		{
+			name: "CancelSubscription/AlreadyCanceled",
+			request: &models.CancelSubscriptionRequest{
+				UserID: "user-id-1",
+			},
+			now:                       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription: true,
+			getSubscriptionResponse: &entities.Subscription{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    "user-id-1",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusCanceled,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				EndsAt:    lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+			expectErr: services.ErrSubscriptionNotFound,
+		},
+		{
+			name: "CancelSubscription/NoSubscription",
+			request: &models.CancelSubscriptionRequest{
+				UserID: "user-id-1",
+			},
+			now:                       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription: true,
+			getSubscriptionErr:        dao.ErrSubscriptionNotFound,
+			expectErr:                 services.ErrSubscriptionNotFound,
+		},
+		{
+			name:      "CancelSubscription/InvalidRequest",
+			request:   &models.CancelSubscriptionRequest{},
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "EmitError",
+			request: &models.CancelSubscriptionRequest{
+				UserID: "user-id-1",
+			},
+			now:                          time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      runningSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:               "pro",
+				Status:             entities.SubscriptionStatusCanceled,
+				EndsAt:             lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodStart: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CanceledAt:         lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+			emitErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name: "UpdateSubscriptionError",
+			request: &models.CancelSubscriptionRequest{
+				UserID: "user-id-1",
+			},
+			now:                          time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      runningSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:               "pro",
+				Status:             entities.SubscriptionStatusCanceled,
+				EndsAt:             lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodStart: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				CanceledAt:         lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+			updateSubscriptionErr: FooErr,
+			expectErr:             FooErr,
+		},
+		{
+			name: "LockSubscriptionsError",
+			request: &models.CancelSubscriptionRequest{
+				UserID: "user-id-1",
+			},
+			now:                         time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallLockSubscriptions: true,
+			lockSubscriptionsErr:        FooErr,
+			expectErr:                   FooErr,
+		},
+		{
+			name: "GetSubscriptionError",
+			request: &models.CancelSubscriptionRequest{
+				UserID: "user-id-1",
+			},
+			now:                       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription: true,
+			getSubscriptionErr:        FooErr,
+			expectErr:                 FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			getSubscriptionRepository := daomocks.NewMockGetSubscriptionByUserRepository(t)
+			updateSubscriptionRepository := daomocks.NewMockUpdateSubscriptionRepository(t)
+			lockSubscriptionsRepository := daomocks.NewMockLockSubscriptionsByUserRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+			emitter := eventsmocks.NewMockEmitter(t)
+
+			if tt.shouldCallLockSubscriptions || tt.shouldCallGetSubscription {
+				runInTransactionRepository.
+					On("RunInTransaction", context.TODO(), mock.Anything).
+					Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				lockSubscriptionsRepository.
+					On("LockSubscriptionsByUser", context.TODO(), tt.request.UserID).
+					Return(tt.lockSubscriptionsErr)
+			}
+
+			if tt.shouldCallGetSubscription {
+				getSubscriptionRepository.
+					On("GetSubscriptionByUser", context.TODO(), tt.request.UserID).
+					Return(tt.getSubscriptionResponse, tt.getSubscriptionErr)
+			}
+
+			var expect *entities.Subscription
+			if tt.shouldCallUpdateSubscription {
+				var updated *entities.Subscription
+				if tt.updateSubscriptionErr == nil {
+					updated = &entities.Subscription{
+						ID:     tt.getSubscriptionResponse.ID,
+						UserID: tt.getSubscriptionResponse.UserID,
+						Tier:   tt.updateSubscriptionData.Tier,
+						Status: tt.updateSubscriptionData.Status,
+						EndsAt: tt.updateSubscriptionData.EndsAt,
+					}
+
+					emitter.
+						On("Emit", context.TODO(), &events.SubscriptionChanged{
+							SubscriptionID: *updated.ID,
+							UserID:         updated.UserID,
+							Tier:           updated.Tier,
+							PreviousTier:   tt.getSubscriptionResponse.Tier,
+							Status:         string(updated.Status),
+							EndsAt:         updated.EndsAt,
+							ChangedAt:      tt.now,
+						}).
+						Return(tt.emitErr)
+
+					if tt.emitErr == nil {
+						expect = updated
+					}
+				}
+
+				updateSubscriptionRepository.
+					On("UpdateSubscription", context.TODO(), *tt.getSubscriptionResponse.ID, tt.updateSubscriptionData).
+					Return(updated, tt.updateSubscriptionErr)
+			}
+
+			service := services.NewCancelSubscriptionService(
+				getSubscriptionRepository,
+				updateSubscriptionRepository,
+				lockSubscriptionsRepository,
+				runInTransactionRepository,
+				emitter,
+			)
+
+			subscription, err := service.Exec(context.TODO(), tt.request, tt.now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, expect, subscription)
+
+			getSubscriptionRepository.AssertExpectations(t)
+			updateSubscriptionRepository.AssertExpectations(t)
+			lockSubscriptionsRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+			emitter.AssertExpectations(t)
+		})
+	}
+}
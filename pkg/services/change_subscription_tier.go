@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"time"
+)
+
+type ChangeSubscriptionTierService interface {
+	Exec(ctx context.Context, request *models.ChangeSubscriptionTierRequest, now time.Time) (*entities.Subscription, error)
+}
+
+type changeSubscriptionTierServiceImpl struct {
+	getSubscriptionRepository    dao.GetSubscriptionByUserRepository
+	updateSubscriptionRepository dao.UpdateSubscriptionRepository
+	lockSubscriptionsRepository  dao.LockSubscriptionsByUserRepository
+	runInTransactionRepository   dao.RunInTransactionRepository
+
+	emitter events.Emitter
+	tiers   map[string]config.TierInformation
+}
+
+func (s *changeSubscriptionTierServiceImpl) Exec(
+	ctx context.Context, request *models.ChangeSubscriptionTierRequest, now time.Time,
+) (*entities.Subscription, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	if _, ok := s.tiers[request.Tier]; !ok {
+		return nil, errors.Join(ErrInvalidRequest, fmt.Errorf("%w: %q", ErrUnknownTier, request.Tier))
+	}
+
+	var subscription *entities.Subscription
+
+	// The change is announced in the same transaction, so the event is only sent if the change is saved.
+	err := s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+		// A parallel write, for example from a Stripe event, could otherwise be overwritten.
+		if err := s.lockSubscriptionsRepository.LockSubscriptionsByUser(ctx, request.UserID); err != nil {
+			return fmt.Errorf("lock subscriptions: %w", err)
+		}
+
+		currentSubscription, err := s.getSubscriptionRepository.GetSubscriptionByUser(ctx, request.UserID)
+		if err != nil {
+			if errors.Is(err, dao.ErrSubscriptionNotFound) {
+				return ErrSubscriptionNotFound
+			}
+
+			return fmt.Errorf("get subscription: %w", err)
+		}
+
+		// Only running subscriptions can be changed. Ended subscriptions must be renewed through a new subscription.
+		if !currentSubscription.IsRunning(now) {
+			return ErrSubscriptionNotFound
+		}
+
+		data := subscriptionUpdateData(currentSubscription)
+		data.Tier = request.Tier
+
+		subscription, err = s.updateSubscriptionRepository.UpdateSubscription(ctx, *currentSubscription.ID, data)
+		if err != nil {
+			return fmt.Errorf("update subscription: %w", err)
+		}
+
+		if err := s.emitter.Emit(ctx, subscriptionChanged(subscription, currentSubscription.Tier, now)); err != nil {
+			return fmt.Errorf("emit subscription changed: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func NewChangeSubscriptionTierService(
+	getSubscriptionRepository dao.GetSubscriptionByUserRepository,
+	updateSubscriptionRepository dao.UpdateSubscriptionRepository,
+	lockSubscriptionsRepository dao.LockSubscriptionsByUserRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+	emitter events.Emitter,
+	tiers map[string]config.TierInformation,
+) ChangeSubscriptionTierService {
+	return &changeSubscriptionTierServiceImpl{
+		getSubscriptionRepository:    getSubscriptionRepository,
+		updateSubscriptionRepository: updateSubscriptionRepository,
+		lockSubscriptionsRepository:  lockSubscriptionsRepository,
+		runInTransactionRepository:   runInTransactionRepository,
+		emitter:                      emitter,
+		tiers:                        tiers,
+	}
+}
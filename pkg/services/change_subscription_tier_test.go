@@ -0,0 +1,271 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	eventsmocks "github.com/in-rich/uservice-subscription/pkg/events/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestChangeSubscriptionTier(t *testing.T) {
+	runningSubscription := &entities.Subscription{
+		ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:           "user-id-1",
+		Tier:             "free",
+		Status:           entities.SubscriptionStatusActive,
+		StartedAt:        lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CurrentPeriodEnd: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	testData := []struct {
+		name string
+
+		request *models.ChangeSubscriptionTierRequest
+		now     time.Time
+
+		// The lock is always taken before getting the subscription.
+		shouldCallLockSubscriptions bool
+		lockSubscriptionsErr        error
+
+		shouldCallGetSubscription bool
+		getSubscriptionResponse   *entities.Subscription
+		getSubscriptionErr        error
+
+		shouldCallUpdateSubscription bool
+		updateSubscriptionData       *dao.UpdateSubscriptionData
+		updateSubscriptionResponse   *entities.Subscription
+		updateSubscriptionErr        error
+
+		shouldCallEmit bool
+		emitEvent      *events.SubscriptionChanged
+		emitErr        error
+
+		expect    *entities.Subscription
+		expectErr error
+	}{
+		{
+			name: "ChangeSubscriptionTier",
+			request: &models.ChangeSubscriptionTierRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                          time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      runningSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:             "pro",
+				Status:           entities.SubscriptionStatusActive,
+				CurrentPeriodEnd: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			updateSubscriptionResponse: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusActive,
+			},
+			shouldCallEmit: true,
+			emitEvent: &events.SubscriptionChanged{
+				SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:         "user-id-1",
+				Tier:           "pro",
+				PreviousTier:   "free",
+				Status:         "active",
+				ChangedAt:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+			expect: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusActive,
+			},
+		},
+
+		// Local error cases.
+		{
+			name: "ChangeSubscriptionTier/SubscriptionEnded",
+			request: &models.ChangeSubscriptionTierRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription: true,
+			getSubscriptionResponse: &entities.Subscription{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    "user-id-1",
+				Tier:      "free",
+				Status:    entities.SubscriptionStatusCanceled,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				EndsAt:    lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+			expectErr: services.ErrSubscriptionNotFound,
+		},
+		{
+			name: "ChangeSubscriptionTier/NoSubscription",
+			request: &models.ChangeSubscriptionTierRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription: true,
+			getSubscriptionErr:        dao.ErrSubscriptionNotFound,
+			expectErr:                 services.ErrSubscriptionNotFound,
+		},
+		{
+			name: "ChangeSubscriptionTier/UnknownTier",
+			request: &models.ChangeSubscriptionTierRequest{
+				UserID: "user-id-1",
+				Tier:   "legacy",
+			},
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name:      "ChangeSubscriptionTier/InvalidRequest",
+			request:   &models.ChangeSubscriptionTierRequest{},
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "EmitError",
+			request: &models.ChangeSubscriptionTierRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                          time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      runningSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:             "pro",
+				Status:           entities.SubscriptionStatusActive,
+				CurrentPeriodEnd: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			updateSubscriptionResponse: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusActive,
+			},
+			shouldCallEmit: true,
+			emitEvent: &events.SubscriptionChanged{
+				SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:         "user-id-1",
+				Tier:           "pro",
+				PreviousTier:   "free",
+				Status:         "active",
+				ChangedAt:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+			emitErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name: "UpdateSubscriptionError",
+			request: &models.ChangeSubscriptionTierRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                          time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      runningSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:             "pro",
+				Status:           entities.SubscriptionStatusActive,
+				CurrentPeriodEnd: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			updateSubscriptionErr: FooErr,
+			expectErr:             FooErr,
+		},
+		{
+			name: "LockSubscriptionsError",
+			request: &models.ChangeSubscriptionTierRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                         time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallLockSubscriptions: true,
+			lockSubscriptionsErr:        FooErr,
+			expectErr:                   FooErr,
+		},
+		{
+			name: "GetSubscriptionError",
+			request: &models.ChangeSubscriptionTierRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription: true,
+			getSubscriptionErr:        FooErr,
+			expectErr:                 FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			getSubscriptionRepository := daomocks.NewMockGetSubscriptionByUserRepository(t)
+			updateSubscriptionRepository := daomocks.NewMockUpdateSubscriptionRepository(t)
+			lockSubscriptionsRepository := daomocks.NewMockLockSubscriptionsByUserRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+			emitter := eventsmocks.NewMockEmitter(t)
+
+			if tt.shouldCallLockSubscriptions || tt.shouldCallGetSubscription {
+				runInTransactionRepository.
+					On("RunInTransaction", context.TODO(), mock.Anything).
+					Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				lockSubscriptionsRepository.
+					On("LockSubscriptionsByUser", context.TODO(), tt.request.UserID).
+					Return(tt.lockSubscriptionsErr)
+			}
+
+			if tt.shouldCallGetSubscription {
+				getSubscriptionRepository.
+					On("GetSubscriptionByUser", context.TODO(), tt.request.UserID).
+					Return(tt.getSubscriptionResponse, tt.getSubscriptionErr)
+			}
+
+			if tt.shouldCallUpdateSubscription {
+				updateSubscriptionRepository.
+					On("UpdateSubscription", context.TODO(), *tt.getSubscriptionResponse.ID, tt.updateSubscriptionData).
+					Return(tt.updateSubscriptionResponse, tt.updateSubscriptionErr)
+			}
+
+			if tt.shouldCallEmit {
+				emitter.On("Emit", context.TODO(), tt.emitEvent).Return(tt.emitErr)
+			}
+
+			service := services.NewChangeSubscriptionTierService(
+				getSubscriptionRepository,
+				updateSubscriptionRepository,
+				lockSubscriptionsRepository,
+				runInTransactionRepository,
+				emitter,
+				subscriptionTiers,
+			)
+
+			subscription, err := service.Exec(context.TODO(), tt.request, tt.now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, subscription)
+
+			getSubscriptionRepository.AssertExpectations(t)
+			updateSubscriptionRepository.AssertExpectations(t)
+			lockSubscriptionsRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+			emitter.AssertExpectations(t)
+		})
+	}
+}
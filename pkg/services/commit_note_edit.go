@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"time"
+)
+
+// CommitNoteEditService confirms an edit reserved by CanUpdateNote, once the note it was counted for is durably
+// updated. Committed edits keep counting after the reservation expires.
+type CommitNoteEditService interface {
+	Exec(ctx context.Context, request *models.CommitNoteEditRequest, now time.Time) error
+}
+
+type commitNoteEditServiceImpl struct {
+	commitNoteEditRepository dao.CommitNoteEditRepository
+}
+
+func (s *commitNoteEditServiceImpl) Exec(ctx context.Context, request *models.CommitNoteEditRequest, now time.Time) error {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return errors.Join(ErrInvalidRequest, err)
+	}
+
+	_, err := s.commitNoteEditRepository.CommitNoteEdit(ctx, uuid.MustParse(request.NoteEditID), request.AuthorID, now)
+	if err != nil {
+		if errors.Is(err, dao.ErrNoNoteEditFound) {
+			return ErrNoteEditNotFound
+		}
+
+		return fmt.Errorf("commit note edit: %w", err)
+	}
+
+	return nil
+}
+
+func NewCommitNoteEditService(commitNoteEditRepository dao.CommitNoteEditRepository) CommitNoteEditService {
+	return &commitNoteEditServiceImpl{
+		commitNoteEditRepository: commitNoteEditRepository,
+	}
+}
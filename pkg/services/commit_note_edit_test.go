@@ -0,0 +1,94 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCommitNoteEdit(t *testing.T) {
+	noteEditID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	now := time.Date(2021, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name string
+
+		request *models.CommitNoteEditRequest
+
+		shouldCallCommit bool
+		commitErr        error
+
+		expectErr error
+	}{
+		{
+			name: "CommitNoteEdit",
+			request: &models.CommitNoteEditRequest{
+				NoteEditID: noteEditID.String(),
+				AuthorID:   "author-id-1",
+			},
+			shouldCallCommit: true,
+		},
+		{
+			name: "CommitNoteEdit/NotFound",
+			request: &models.CommitNoteEditRequest{
+				NoteEditID: noteEditID.String(),
+				AuthorID:   "author-id-1",
+			},
+			shouldCallCommit: true,
+			commitErr:        dao.ErrNoNoteEditFound,
+			expectErr:        services.ErrNoteEditNotFound,
+		},
+		{
+			name: "CommitNoteEdit/InvalidNoteEditID",
+			request: &models.CommitNoteEditRequest{
+				NoteEditID: "note-edit-id-1",
+				AuthorID:   "author-id-1",
+			},
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "CommitNoteEdit/NoAuthor",
+			request: &models.CommitNoteEditRequest{
+				NoteEditID: noteEditID.String(),
+			},
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "CommitNoteEditError",
+			request: &models.CommitNoteEditRequest{
+				NoteEditID: noteEditID.String(),
+				AuthorID:   "author-id-1",
+			},
+			shouldCallCommit: true,
+			commitErr:        FooErr,
+			expectErr:        FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			commitNoteEditRepository := daomocks.NewMockCommitNoteEditRepository(t)
+
+			if tt.shouldCallCommit {
+				commitNoteEditRepository.
+					On("CommitNoteEdit", context.TODO(), noteEditID, tt.request.AuthorID, now).
+					Return(&entities.NoteEdit{ID: &noteEditID}, tt.commitErr)
+			}
+
+			service := services.NewCommitNoteEditService(commitNoteEditRepository)
+
+			err := service.Exec(context.TODO(), tt.request, now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			commitNoteEditRepository.AssertExpectations(t)
+		})
+	}
+}
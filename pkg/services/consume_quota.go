@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"time"
+)
+
+// ConsumeQuotaService counts a use of a metered feature against the quota of a subject. Uses of the same key within
+// the buffer time of the feature only count once. Note edits are counted by CanUpdateNoteService, which also enforces
+// the limits of config.NoteTierInformation, so config.NoteEditsFeature is rejected.
+type ConsumeQuotaService interface {
+	Exec(ctx context.Context, request *models.ConsumeQuotaRequest, tier *models.ResolvedTier, now time.Time) (int, error)
+}
+
+type consumeQuotaServiceImpl struct {
+	countUsageEventsRepository    dao.CountUsageEventsBySubjectRepository
+	createUsageEventRepository    dao.CreateUsageEventRepository
+	getLatestUsageEventRepository dao.GetLatestUsageEventBySubjectRepository
+	lockUsageEventsRepository     dao.LockUsageEventsBySubjectRepository
+
+	runInTransactionRepository dao.RunInTransactionRepository
+}
+
+func (s *consumeQuotaServiceImpl) Exec(
+	ctx context.Context, request *models.ConsumeQuotaRequest, tier *models.ResolvedTier, now time.Time,
+) (int, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return 0, errors.Join(ErrInvalidRequest, err)
+	}
+
+	feature, ok := tier.Features[request.Feature]
+	if !ok || request.Feature == config.NoteEditsFeature {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownFeature, request.Feature)
+	}
+
+	// Don't throw in read only mode.
+	if request.ReadOnly {
+		return s.countRemainingUses(ctx, request, feature, tier, now)
+	}
+
+	var remainingUses int
+
+	// Check and consume the use atomically, so parallel requests from the same subject cannot overdraw their quota.
+	err := s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := s.lockUsageEventsRepository.LockUsageEventsBySubject(ctx, request.Feature, request.Subject); err != nil {
+			return fmt.Errorf("lock usage events: %w", err)
+		}
+
+		var err error
+		remainingUses, err = s.countRemainingUses(ctx, request, feature, tier, now)
+		if err != nil {
+			return err
+		}
+
+		latestUsageEvent, err := s.getLatestUsageEventRepository.GetLatestUsageEventBySubject(
+			ctx, request.Feature, request.Subject, request.Key,
+		)
+		if err != nil && !errors.Is(err, dao.ErrNoUsageEventFound) {
+			return fmt.Errorf("get latest usage event: %w", err)
+		}
+
+		var latestUseAt *time.Time
+		if latestUsageEvent != nil {
+			latestUseAt = latestUsageEvent.CreatedAt
+		}
+
+		remainingUses, err = consumeQuota(remainingUses, latestUseAt, feature.BufferTime, now, ErrQuotaExhausted, func() error {
+			_, err := s.createUsageEventRepository.CreateUsageEvent(ctx, request.Subject, &dao.CreateUsageEventData{
+				Feature: request.Feature,
+				Key:     request.Key,
+			})
+			if err != nil {
+				return fmt.Errorf("create usage event: %w", err)
+			}
+
+			return nil
+		})
+
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return remainingUses, nil
+}
+
+func (s *consumeQuotaServiceImpl) countRemainingUses(
+	ctx context.Context,
+	request *models.ConsumeQuotaRequest,
+	feature config.FeatureTierInformation,
+	tier *models.ResolvedTier,
+	now time.Time,
+) (int, error) {
+	usesSince, _ := feature.CountWindow(now, tier.BillingPeriodStart, tier.BillingPeriodEnd)
+	usesCount, err := s.countUsageEventsRepository.CountUsageEventsBySubject(ctx, request.Feature, request.Subject, &usesSince)
+	if err != nil {
+		return 0, fmt.Errorf("count usage events: %w", err)
+	}
+
+	return remainingUses(feature.MaxUses, usesCount), nil
+}
+
+func NewConsumeQuotaService(
+	countUsageEventsRepository dao.CountUsageEventsBySubjectRepository,
+	createUsageEventRepository dao.CreateUsageEventRepository,
+	getLatestUsageEventRepository dao.GetLatestUsageEventBySubjectRepository,
+	lockUsageEventsRepository dao.LockUsageEventsBySubjectRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+) ConsumeQuotaService {
+	return &consumeQuotaServiceImpl{
+		countUsageEventsRepository:    countUsageEventsRepository,
+		createUsageEventRepository:    createUsageEventRepository,
+		getLatestUsageEventRepository: getLatestUsageEventRepository,
+		lockUsageEventsRepository:     lockUsageEventsRepository,
+		runInTransactionRepository:    runInTransactionRepository,
+	}
+}
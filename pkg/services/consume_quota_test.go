@@ -0,0 +1,355 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestConsumeQuota(t *testing.T) {
+	tier := &models.ResolvedTier{
+		Name: "free",
+		TierInformation: config.TierInformation{
+			Features: map[string]config.FeatureTierInformation{
+				"exports": {
+					MaxUses:       5,
+					CountUsesOver: lo.ToPtr(24 * time.Hour),
+					BufferTime:    time.Hour,
+				},
+				"ai-summaries": {
+					MaxUses: 3,
+					Window:  config.WindowMonth,
+				},
+				config.NoteEditsFeature: {
+					MaxUses:       5,
+					CountUsesOver: lo.ToPtr(24 * time.Hour),
+				},
+			},
+		},
+	}
+
+	now := time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name string
+
+		data *models.ConsumeQuotaRequest
+
+		shouldLock bool
+		lockErr    error
+
+		shouldCallCount bool
+		countSince      time.Time
+		countResponse   int
+		countErr        error
+
+		shouldCallLatest bool
+		latestResponse   *entities.UsageEvent
+		latestErr        error
+
+		shouldCallCreate bool
+		createErr        error
+
+		expect    int
+		expectErr error
+	}{
+		// Success cases.
+		{
+			name: "ConsumeQuota/NewUse",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "exports",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			shouldLock:       true,
+			shouldCallCount:  true,
+			countSince:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			countResponse:    3,
+			shouldCallLatest: true,
+			latestResponse: &entities.UsageEvent{
+				Feature:   "exports",
+				Subject:   "user-id-1",
+				Key:       "key-1",
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 22, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCreate: true,
+			expect:           1,
+		},
+		{
+			name: "ConsumeQuota/NewUse/NeverUsed",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "exports",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			shouldLock:       true,
+			shouldCallCount:  true,
+			countSince:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			shouldCallLatest: true,
+			latestErr:        dao.ErrNoUsageEventFound,
+			shouldCallCreate: true,
+			expect:           4,
+		},
+		{
+			name: "ConsumeQuota/NewUse/NoUseRemaining",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "exports",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			shouldLock:       true,
+			shouldCallCount:  true,
+			countSince:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			countResponse:    4,
+			shouldCallLatest: true,
+			latestErr:        dao.ErrNoUsageEventFound,
+			shouldCallCreate: true,
+			expect:           0,
+		},
+		{
+			name: "ConsumeQuota/RecentUse",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "exports",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			shouldLock:       true,
+			shouldCallCount:  true,
+			countSince:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			countResponse:    5,
+			shouldCallLatest: true,
+			latestResponse: &entities.UsageEvent{
+				Feature:   "exports",
+				Subject:   "user-id-1",
+				Key:       "key-1",
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 23, 30, 0, 0, time.UTC)),
+			},
+			expect: 0,
+		},
+		{
+			name: "ConsumeQuota/NoBufferTime",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "ai-summaries",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			shouldLock:       true,
+			shouldCallCount:  true,
+			countSince:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			countResponse:    1,
+			shouldCallLatest: true,
+			latestResponse: &entities.UsageEvent{
+				Feature:   "ai-summaries",
+				Subject:   "user-id-1",
+				Key:       "key-1",
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 23, 59, 0, 0, time.UTC)),
+			},
+			shouldCallCreate: true,
+			expect:           1,
+		},
+		{
+			name: "ConsumeQuota/ReadOnly",
+			data: &models.ConsumeQuotaRequest{
+				Feature:  "exports",
+				Subject:  "user-id-1",
+				ReadOnly: true,
+			},
+			shouldCallCount: true,
+			countSince:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			countResponse:   3,
+			expect:          2,
+		},
+		{
+			name: "ConsumeQuota/ReadOnly/QuotaExhausted",
+			data: &models.ConsumeQuotaRequest{
+				Feature:  "exports",
+				Subject:  "user-id-1",
+				ReadOnly: true,
+			},
+			shouldCallCount: true,
+			countSince:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			countResponse:   8,
+			expect:          0,
+		},
+
+		// Local error cases.
+		{
+			name: "ConsumeQuota/QuotaExhausted",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "exports",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			shouldLock:       true,
+			shouldCallCount:  true,
+			countSince:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			countResponse:    5,
+			shouldCallLatest: true,
+			latestResponse: &entities.UsageEvent{
+				Feature:   "exports",
+				Subject:   "user-id-1",
+				Key:       "key-1",
+				CreatedAt: lo.ToPtr(time.Date(2021, 1, 2, 22, 0, 0, 0, time.UTC)),
+			},
+			expectErr: services.ErrQuotaExhausted,
+		},
+		{
+			name: "ConsumeQuota/UnknownFeature",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "enrichments",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			expectErr: services.ErrUnknownFeature,
+		},
+		{
+			// Note edits are counted by CanUpdateNote.
+			name: "ConsumeQuota/NoteEdits",
+			data: &models.ConsumeQuotaRequest{
+				Feature: config.NoteEditsFeature,
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			expectErr: services.ErrUnknownFeature,
+		},
+		{
+			name: "ConsumeQuota/InvalidRequest",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "exports",
+				Subject: "user-id-1",
+			},
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "CreateUsageEventError",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "exports",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			shouldLock:       true,
+			shouldCallCount:  true,
+			countSince:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			countResponse:    3,
+			shouldCallLatest: true,
+			latestErr:        dao.ErrNoUsageEventFound,
+			shouldCallCreate: true,
+			createErr:        FooErr,
+			expectErr:        FooErr,
+		},
+		{
+			name: "GetLatestUsageEventError",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "exports",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			shouldLock:       true,
+			shouldCallCount:  true,
+			countSince:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			countResponse:    3,
+			shouldCallLatest: true,
+			latestErr:        FooErr,
+			expectErr:        FooErr,
+		},
+		{
+			name: "CountUsageEventsError",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "exports",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			shouldLock:      true,
+			shouldCallCount: true,
+			countSince:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			countErr:        FooErr,
+			expectErr:       FooErr,
+		},
+		{
+			name: "LockUsageEventsError",
+			data: &models.ConsumeQuotaRequest{
+				Feature: "exports",
+				Subject: "user-id-1",
+				Key:     "key-1",
+			},
+			shouldLock: true,
+			lockErr:    FooErr,
+			expectErr:  FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			countRepository := daomocks.NewMockCountUsageEventsBySubjectRepository(t)
+			createRepository := daomocks.NewMockCreateUsageEventRepository(t)
+			latestRepository := daomocks.NewMockGetLatestUsageEventBySubjectRepository(t)
+			lockRepository := daomocks.NewMockLockUsageEventsBySubjectRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+
+			if tt.shouldLock {
+				runInTransactionRepository.
+					On("RunInTransaction", context.TODO(), mock.Anything).
+					Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				lockRepository.
+					On("LockUsageEventsBySubject", context.TODO(), tt.data.Feature, tt.data.Subject).
+					Return(tt.lockErr)
+			}
+
+			if tt.shouldCallCount {
+				countRepository.
+					On("CountUsageEventsBySubject", context.TODO(), tt.data.Feature, tt.data.Subject, &tt.countSince).
+					Return(tt.countResponse, tt.countErr)
+			}
+
+			if tt.shouldCallLatest {
+				latestRepository.
+					On("GetLatestUsageEventBySubject", context.TODO(), tt.data.Feature, tt.data.Subject, tt.data.Key).
+					Return(tt.latestResponse, tt.latestErr)
+			}
+
+			if tt.shouldCallCreate {
+				createRepository.
+					On(
+						"CreateUsageEvent",
+						context.TODO(),
+						tt.data.Subject,
+						&dao.CreateUsageEventData{Feature: tt.data.Feature, Key: tt.data.Key},
+					).
+					Return(nil, tt.createErr)
+			}
+
+			service := services.NewConsumeQuotaService(
+				countRepository,
+				createRepository,
+				latestRepository,
+				lockRepository,
+				runInTransactionRepository,
+			)
+
+			remainingUses, err := service.Exec(context.TODO(), tt.data, tier, now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, remainingUses)
+
+			countRepository.AssertExpectations(t)
+			createRepository.AssertExpectations(t)
+			latestRepository.AssertExpectations(t)
+			lockRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+		})
+	}
+}
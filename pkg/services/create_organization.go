@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+)
+
+// CreateOrganizationService creates an organization. Its subscription is then managed like the one of a user, under
+// entities.OrganizationSubscriberID.
+type CreateOrganizationService interface {
+	Exec(ctx context.Context, request *models.CreateOrganizationRequest) (*entities.Organization, error)
+}
+
+type createOrganizationServiceImpl struct {
+	createOrganizationRepository dao.CreateOrganizationRepository
+}
+
+func (s *createOrganizationServiceImpl) Exec(
+	ctx context.Context, request *models.CreateOrganizationRequest,
+) (*entities.Organization, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	organization, err := s.createOrganizationRepository.CreateOrganization(ctx, &dao.CreateOrganizationData{
+		Name: request.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create organization: %w", err)
+	}
+
+	return organization, nil
+}
+
+func NewCreateOrganizationService(createOrganizationRepository dao.CreateOrganizationRepository) CreateOrganizationService {
+	return &createOrganizationServiceImpl{
+		createOrganizationRepository: createOrganizationRepository,
+	}
+}
@@ -0,0 +1,85 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateOrganization(t *testing.T) {
+	testData := []struct {
+		name string
+
+		request *models.CreateOrganizationRequest
+
+		shouldCallCreate bool
+		createResponse   *entities.Organization
+		createErr        error
+
+		expect    *entities.Organization
+		expectErr error
+	}{
+		// Success cases.
+		{
+			name: "CreateOrganization",
+			request: &models.CreateOrganizationRequest{
+				Name: "Acme",
+			},
+			shouldCallCreate: true,
+			createResponse: &entities.Organization{
+				ID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				Name: "Acme",
+			},
+			expect: &entities.Organization{
+				ID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000101")),
+				Name: "Acme",
+			},
+		},
+
+		// Local error cases.
+		{
+			name:      "CreateOrganization/InvalidRequest",
+			request:   &models.CreateOrganizationRequest{},
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "CreateOrganizationError",
+			request: &models.CreateOrganizationRequest{
+				Name: "Acme",
+			},
+			shouldCallCreate: true,
+			createErr:        FooErr,
+			expectErr:        FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			createOrganizationRepository := daomocks.NewMockCreateOrganizationRepository(t)
+
+			if tt.shouldCallCreate {
+				createOrganizationRepository.
+					On("CreateOrganization", context.TODO(), &dao.CreateOrganizationData{Name: tt.request.Name}).
+					Return(tt.createResponse, tt.createErr)
+			}
+
+			service := services.NewCreateOrganizationService(createOrganizationRepository)
+
+			organization, err := service.Exec(context.TODO(), tt.request)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, organization)
+
+			createOrganizationRepository.AssertExpectations(t)
+		})
+	}
+}
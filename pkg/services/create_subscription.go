@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"time"
+)
+
+type CreateSubscriptionService interface {
+	Exec(ctx context.Context, request *models.CreateSubscriptionRequest, now time.Time) (*entities.Subscription, error)
+}
+
+type createSubscriptionServiceImpl struct {
+	getSubscriptionRepository    dao.GetSubscriptionByUserRepository
+	createSubscriptionRepository dao.CreateSubscriptionRepository
+	lockSubscriptionsRepository  dao.LockSubscriptionsByUserRepository
+	runInTransactionRepository   dao.RunInTransactionRepository
+
+	emitter events.Emitter
+	tiers   map[string]config.TierInformation
+}
+
+func (s *createSubscriptionServiceImpl) Exec(
+	ctx context.Context, request *models.CreateSubscriptionRequest, now time.Time,
+) (*entities.Subscription, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	if _, ok := s.tiers[request.Tier]; !ok {
+		return nil, errors.Join(ErrInvalidRequest, fmt.Errorf("%w: %q", ErrUnknownTier, request.Tier))
+	}
+
+	if request.CurrentPeriodEnd != nil && !request.CurrentPeriodEnd.After(now) {
+		return nil, errors.Join(ErrInvalidRequest, errors.New("current period end must be in the future"))
+	}
+
+	var subscription *entities.Subscription
+
+	// The change is announced in the same transaction, so the event is only sent if the change is saved.
+	err := s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+		// Parallel requests for the same user could otherwise both see no running subscription, and both create one.
+		if err := s.lockSubscriptionsRepository.LockSubscriptionsByUser(ctx, request.UserID); err != nil {
+			return fmt.Errorf("lock subscriptions: %w", err)
+		}
+
+		currentSubscription, err := s.getSubscriptionRepository.GetSubscriptionByUser(ctx, request.UserID)
+		if err != nil && !errors.Is(err, dao.ErrSubscriptionNotFound) {
+			return fmt.Errorf("get subscription: %w", err)
+		}
+
+		if currentSubscription != nil && currentSubscription.IsRunning(now) {
+			return ErrSubscriptionAlreadyExists
+		}
+
+		data := &dao.CreateSubscriptionData{
+			Tier:             request.Tier,
+			Status:           entities.SubscriptionStatusActive,
+			StartedAt:        now.UTC(),
+			CurrentPeriodEnd: request.CurrentPeriodEnd,
+		}
+		if request.CurrentPeriodEnd != nil {
+			data.CurrentPeriodStart = &data.StartedAt
+		}
+
+		subscription, err = s.createSubscriptionRepository.CreateSubscription(ctx, request.UserID, data)
+		if err != nil {
+			return fmt.Errorf("create subscription: %w", err)
+		}
+
+		if err := s.emitter.Emit(ctx, subscriptionChanged(subscription, "", now)); err != nil {
+			return fmt.Errorf("emit subscription changed: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func NewCreateSubscriptionService(
+	getSubscriptionRepository dao.GetSubscriptionByUserRepository,
+	createSubscriptionRepository dao.CreateSubscriptionRepository,
+	lockSubscriptionsRepository dao.LockSubscriptionsByUserRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+	emitter events.Emitter,
+	tiers map[string]config.TierInformation,
+) CreateSubscriptionService {
+	return &createSubscriptionServiceImpl{
+		getSubscriptionRepository:    getSubscriptionRepository,
+		createSubscriptionRepository: createSubscriptionRepository,
+		lockSubscriptionsRepository:  lockSubscriptionsRepository,
+		runInTransactionRepository:   runInTransactionRepository,
+		emitter:                      emitter,
+		tiers:                        tiers,
+	}
+}
@@ -0,0 +1,326 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	eventsmocks "github.com/in-rich/uservice-subscription/pkg/events/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var subscriptionTiers = map[string]config.TierInformation{
+	"free": {
+		Features: map[string]config.FeatureTierInformation{
+			config.NoteEditsFeature: {
+				CountUsesOver: lo.ToPtr(24 * time.Hour),
+				MaxUses:       5,
+			},
+		},
+	},
+	"pro": {
+		Features: map[string]config.FeatureTierInformation{
+			config.NoteEditsFeature: {
+				CountUsesOver: lo.ToPtr(24 * time.Hour),
+				MaxUses:       50,
+			},
+		},
+	},
+}
+
+func TestCreateSubscription(t *testing.T) {
+	testData := []struct {
+		name string
+
+		request *models.CreateSubscriptionRequest
+		now     time.Time
+
+		// The lock is always taken before getting the subscription.
+		shouldCallLockSubscriptions bool
+		lockSubscriptionsErr        error
+
+		shouldCallGetSubscription bool
+		getSubscriptionResponse   *entities.Subscription
+		getSubscriptionErr        error
+
+		shouldCallCreateSubscription bool
+		createSubscriptionData       *dao.CreateSubscriptionData
+		createSubscriptionResponse   *entities.Subscription
+		createSubscriptionErr        error
+
+		shouldCallEmit bool
+		emitEvent      *events.SubscriptionChanged
+		emitErr        error
+
+		expect    *entities.Subscription
+		expectErr error
+	}{
+		{
+			name: "CreateSubscription",
+			request: &models.CreateSubscriptionRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                          time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription:    true,
+			getSubscriptionErr:           dao.ErrSubscriptionNotFound,
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			createSubscriptionResponse: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusActive,
+			},
+			shouldCallEmit: true,
+			emitEvent: &events.SubscriptionChanged{
+				SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:         "user-id-1",
+				Tier:           "pro",
+				Status:         "active",
+				ChangedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expect: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusActive,
+			},
+		},
+		{
+			name: "CreateSubscription/WithBillingPeriod",
+			request: &models.CreateSubscriptionRequest{
+				UserID:           "user-id-1",
+				Tier:             "pro",
+				CurrentPeriodEnd: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			now:                       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription: true,
+			// Previous subscription has ended.
+			getSubscriptionResponse: &entities.Subscription{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    "user-id-1",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusCanceled,
+				StartedAt: lo.ToPtr(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+				EndsAt:    lo.ToPtr(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:               "pro",
+				Status:             entities.SubscriptionStatusActive,
+				StartedAt:          time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				CurrentPeriodStart: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			createSubscriptionResponse: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusActive,
+			},
+			shouldCallEmit: true,
+			emitEvent: &events.SubscriptionChanged{
+				SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				UserID:         "user-id-1",
+				Tier:           "pro",
+				Status:         "active",
+				ChangedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expect: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusActive,
+			},
+		},
+
+		// Local error cases.
+		{
+			name: "CreateSubscription/AlreadyExists",
+			request: &models.CreateSubscriptionRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription: true,
+			getSubscriptionResponse: &entities.Subscription{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    "user-id-1",
+				Tier:      "free",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expectErr: services.ErrSubscriptionAlreadyExists,
+		},
+		{
+			name: "CreateSubscription/UnknownTier",
+			request: &models.CreateSubscriptionRequest{
+				UserID: "user-id-1",
+				Tier:   "legacy",
+			},
+			now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "CreateSubscription/PastBillingPeriod",
+			request: &models.CreateSubscriptionRequest{
+				UserID:           "user-id-1",
+				Tier:             "pro",
+				CurrentPeriodEnd: lo.ToPtr(time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name:      "CreateSubscription/InvalidRequest",
+			request:   &models.CreateSubscriptionRequest{},
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "EmitError",
+			request: &models.CreateSubscriptionRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                          time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription:    true,
+			getSubscriptionErr:           dao.ErrSubscriptionNotFound,
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			createSubscriptionResponse: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusActive,
+			},
+			shouldCallEmit: true,
+			emitEvent: &events.SubscriptionChanged{
+				SubscriptionID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:         "user-id-1",
+				Tier:           "pro",
+				Status:         "active",
+				ChangedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			emitErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name: "CreateSubscriptionError",
+			request: &models.CreateSubscriptionRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                          time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription:    true,
+			getSubscriptionErr:           dao.ErrSubscriptionNotFound,
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			createSubscriptionErr: FooErr,
+			expectErr:             FooErr,
+		},
+		{
+			name: "LockSubscriptionsError",
+			request: &models.CreateSubscriptionRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                         time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallLockSubscriptions: true,
+			lockSubscriptionsErr:        FooErr,
+			expectErr:                   FooErr,
+		},
+		{
+			name: "GetSubscriptionError",
+			request: &models.CreateSubscriptionRequest{
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			now:                       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallGetSubscription: true,
+			getSubscriptionErr:        FooErr,
+			expectErr:                 FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			getSubscriptionRepository := daomocks.NewMockGetSubscriptionByUserRepository(t)
+			createSubscriptionRepository := daomocks.NewMockCreateSubscriptionRepository(t)
+			lockSubscriptionsRepository := daomocks.NewMockLockSubscriptionsByUserRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+			emitter := eventsmocks.NewMockEmitter(t)
+
+			if tt.shouldCallLockSubscriptions || tt.shouldCallGetSubscription {
+				runInTransactionRepository.
+					On("RunInTransaction", context.TODO(), mock.Anything).
+					Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				lockSubscriptionsRepository.
+					On("LockSubscriptionsByUser", context.TODO(), tt.request.UserID).
+					Return(tt.lockSubscriptionsErr)
+			}
+
+			if tt.shouldCallGetSubscription {
+				getSubscriptionRepository.
+					On("GetSubscriptionByUser", context.TODO(), tt.request.UserID).
+					Return(tt.getSubscriptionResponse, tt.getSubscriptionErr)
+			}
+
+			if tt.shouldCallCreateSubscription {
+				createSubscriptionRepository.
+					On("CreateSubscription", context.TODO(), tt.request.UserID, tt.createSubscriptionData).
+					Return(tt.createSubscriptionResponse, tt.createSubscriptionErr)
+			}
+
+			if tt.shouldCallEmit {
+				emitter.On("Emit", context.TODO(), tt.emitEvent).Return(tt.emitErr)
+			}
+
+			service := services.NewCreateSubscriptionService(
+				getSubscriptionRepository,
+				createSubscriptionRepository,
+				lockSubscriptionsRepository,
+				runInTransactionRepository,
+				emitter,
+				subscriptionTiers,
+			)
+
+			subscription, err := service.Exec(context.TODO(), tt.request, tt.now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, subscription)
+
+			getSubscriptionRepository.AssertExpectations(t)
+			createSubscriptionRepository.AssertExpectations(t)
+			lockSubscriptionsRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+			emitter.AssertExpectations(t)
+		})
+	}
+}
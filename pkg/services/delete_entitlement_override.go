@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+)
+
+type DeleteEntitlementOverrideService interface {
+	Exec(ctx context.Context, request *models.DeleteEntitlementOverrideRequest) error
+}
+
+type deleteEntitlementOverrideServiceImpl struct {
+	deleteEntitlementOverrideRepository dao.DeleteEntitlementOverrideRepository
+}
+
+func (s *deleteEntitlementOverrideServiceImpl) Exec(ctx context.Context, request *models.DeleteEntitlementOverrideRequest) error {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return errors.Join(ErrInvalidRequest, err)
+	}
+
+	err := s.deleteEntitlementOverrideRepository.DeleteEntitlementOverride(ctx, request.UserID, request.Entitlement)
+	if err != nil {
+		if errors.Is(err, dao.ErrEntitlementOverrideNotFound) {
+			return ErrEntitlementOverrideNotFound
+		}
+
+		return fmt.Errorf("delete entitlement override: %w", err)
+	}
+
+	return nil
+}
+
+func NewDeleteEntitlementOverrideService(
+	deleteEntitlementOverrideRepository dao.DeleteEntitlementOverrideRepository,
+) DeleteEntitlementOverrideService {
+	return &deleteEntitlementOverrideServiceImpl{
+		deleteEntitlementOverrideRepository: deleteEntitlementOverrideRepository,
+	}
+}
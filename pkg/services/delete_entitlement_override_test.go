@@ -0,0 +1,80 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDeleteEntitlementOverride(t *testing.T) {
+	testData := []struct {
+		name string
+
+		request *models.DeleteEntitlementOverrideRequest
+
+		shouldCallDelete bool
+		deleteErr        error
+
+		expectErr error
+	}{
+		{
+			name: "DeleteEntitlementOverride",
+			request: &models.DeleteEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+			},
+			shouldCallDelete: true,
+		},
+		{
+			name: "DeleteEntitlementOverride/NotFound",
+			request: &models.DeleteEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+			},
+			shouldCallDelete: true,
+			deleteErr:        dao.ErrEntitlementOverrideNotFound,
+			expectErr:        services.ErrEntitlementOverrideNotFound,
+		},
+		{
+			name: "DeleteEntitlementOverride/InvalidRequest",
+			request: &models.DeleteEntitlementOverrideRequest{
+				UserID: "user-id-1",
+			},
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "DeleteEntitlementOverrideError",
+			request: &models.DeleteEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+			},
+			shouldCallDelete: true,
+			deleteErr:        FooErr,
+			expectErr:        FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			deleteEntitlementOverrideRepository := daomocks.NewMockDeleteEntitlementOverrideRepository(t)
+
+			if tt.shouldCallDelete {
+				deleteEntitlementOverrideRepository.
+					On("DeleteEntitlementOverride", context.TODO(), tt.request.UserID, tt.request.Entitlement).
+					Return(tt.deleteErr)
+			}
+
+			service := services.NewDeleteEntitlementOverrideService(deleteEntitlementOverrideRepository)
+
+			err := service.Exec(context.TODO(), tt.request)
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			deleteEntitlementOverrideRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+)
+
+type DeleteQuotaOverrideService interface {
+	Exec(ctx context.Context, request *models.DeleteQuotaOverrideRequest) error
+}
+
+type deleteQuotaOverrideServiceImpl struct {
+	deleteQuotaOverrideRepository dao.DeleteQuotaOverrideRepository
+}
+
+func (s *deleteQuotaOverrideServiceImpl) Exec(ctx context.Context, request *models.DeleteQuotaOverrideRequest) error {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return errors.Join(ErrInvalidRequest, err)
+	}
+
+	if err := s.deleteQuotaOverrideRepository.DeleteQuotaOverride(ctx, request.AuthorID); err != nil {
+		if errors.Is(err, dao.ErrQuotaOverrideNotFound) {
+			return ErrQuotaOverrideNotFound
+		}
+
+		return fmt.Errorf("delete quota override: %w", err)
+	}
+
+	return nil
+}
+
+func NewDeleteQuotaOverrideService(deleteQuotaOverrideRepository dao.DeleteQuotaOverrideRepository) DeleteQuotaOverrideService {
+	return &deleteQuotaOverrideServiceImpl{
+		deleteQuotaOverrideRepository: deleteQuotaOverrideRepository,
+	}
+}
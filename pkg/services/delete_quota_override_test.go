@@ -0,0 +1,75 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDeleteQuotaOverride(t *testing.T) {
+	testData := []struct {
+		name string
+
+		request *models.DeleteQuotaOverrideRequest
+
+		shouldCallDelete bool
+		deleteErr        error
+
+		expectErr error
+	}{
+		{
+			name: "DeleteQuotaOverride",
+			request: &models.DeleteQuotaOverrideRequest{
+				AuthorID: "author-id-1",
+			},
+			shouldCallDelete: true,
+		},
+		{
+			name: "DeleteQuotaOverride/NotFound",
+			request: &models.DeleteQuotaOverrideRequest{
+				AuthorID: "author-id-1",
+			},
+			shouldCallDelete: true,
+			deleteErr:        dao.ErrQuotaOverrideNotFound,
+			expectErr:        services.ErrQuotaOverrideNotFound,
+		},
+		{
+			name:      "DeleteQuotaOverride/InvalidRequest",
+			request:   &models.DeleteQuotaOverrideRequest{},
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "DeleteQuotaOverrideError",
+			request: &models.DeleteQuotaOverrideRequest{
+				AuthorID: "author-id-1",
+			},
+			shouldCallDelete: true,
+			deleteErr:        FooErr,
+			expectErr:        FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			deleteQuotaOverrideRepository := daomocks.NewMockDeleteQuotaOverrideRepository(t)
+
+			if tt.shouldCallDelete {
+				deleteQuotaOverrideRepository.
+					On("DeleteQuotaOverride", context.TODO(), tt.request.AuthorID).
+					Return(tt.deleteErr)
+			}
+
+			service := services.NewDeleteQuotaOverrideService(deleteQuotaOverrideRepository)
+
+			err := service.Exec(context.TODO(), tt.request)
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			deleteQuotaOverrideRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -4,6 +4,32 @@ import "errors"
 
 var (
 	ErrNoteEditsExhausted = errors.New("note edits exhausted")
+	ErrNotesExhausted     = errors.New("distinct notes exhausted")
+	ErrNoteEditNotFound   = errors.New("note edit not found")
+	ErrQuotaExhausted     = errors.New("quota exhausted")
+	ErrUnknownFeature     = errors.New("unknown feature")
 
 	ErrInvalidRequest = errors.New("invalid request")
+
+	ErrUnknownTier = errors.New("unknown tier")
+
+	ErrSubscriptionNotFound      = errors.New("subscription not found")
+	ErrSubscriptionAlreadyExists = errors.New("subscription already exists")
+	ErrNoBillingPeriod           = errors.New("subscription has no running billing period")
+
+	ErrTrialsDisabled   = errors.New("trials are disabled")
+	ErrTrialAlreadyUsed = errors.New("trial already used")
+
+	ErrQuotaOverrideNotFound = errors.New("quota override not found")
+
+	ErrUnknownEntitlement          = errors.New("unknown entitlement")
+	ErrEntitlementOverrideNotFound = errors.New("entitlement override not found")
+
+	ErrOrganizationNotFound        = errors.New("organization not found")
+	ErrOrganizationMemberNotFound  = errors.New("organization member not found")
+	ErrMemberOfAnotherOrganization = errors.New("user is a member of another organization")
+	ErrNoSeatsAvailable            = errors.New("no seats available in organization")
+
+	ErrInvalidStripeSignature = errors.New("invalid stripe signature")
+	ErrUnknownStripePrice     = errors.New("unknown stripe price")
 )
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"time"
+)
+
+// ExpireNoteEditsService marks the pending edits that expired before now as expired, and emits a NoteEditReleased
+// event for each of them. It returns the number of expired edits.
+type ExpireNoteEditsService interface {
+	Exec(ctx context.Context, now time.Time) (int, error)
+}
+
+type expireNoteEditsServiceImpl struct {
+	expireNoteEditsRepository  dao.ExpireNoteEditsRepository
+	runInTransactionRepository dao.RunInTransactionRepository
+
+	emitter   events.Emitter
+	batchSize int
+}
+
+func (s *expireNoteEditsServiceImpl) Exec(ctx context.Context, now time.Time) (int, error) {
+	total := 0
+
+	for {
+		var expired []*entities.NoteEdit
+
+		// Edits are only marked as expired once their events are emitted, so failed batches are retried on the next
+		// run.
+		err := s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+			var err error
+
+			expired, err = s.expireNoteEditsRepository.ExpireNoteEdits(ctx, now, s.batchSize)
+			if err != nil {
+				return fmt.Errorf("expire note edits: %w", err)
+			}
+
+			for _, noteEdit := range expired {
+				event := &events.NoteEditReleased{
+					NoteEditID:     *noteEdit.ID,
+					AuthorID:       noteEdit.AuthorID,
+					OrganizationID: noteEdit.OrganizationID,
+					Reason:         events.NoteEditReleasedReasonExpired,
+					ReleasedAt:     now.UTC(),
+				}
+
+				if err := s.emitter.Emit(ctx, event); err != nil {
+					return fmt.Errorf("emit note edit released: %w", err)
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += len(expired)
+
+		if len(expired) < s.batchSize {
+			return total, nil
+		}
+	}
+}
+
+func NewExpireNoteEditsService(
+	expireNoteEditsRepository dao.ExpireNoteEditsRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+	emitter events.Emitter,
+	batchSize int,
+) ExpireNoteEditsService {
+	return &expireNoteEditsServiceImpl{
+		expireNoteEditsRepository:  expireNoteEditsRepository,
+		runInTransactionRepository: runInTransactionRepository,
+		emitter:                    emitter,
+		batchSize:                  batchSize,
+	}
+}
@@ -0,0 +1,165 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	eventsmocks "github.com/in-rich/uservice-subscription/pkg/events/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestExpireNoteEdits(t *testing.T) {
+	now := time.Date(2021, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	expiredNoteEdit := func(id string, author string) *entities.NoteEdit {
+		return &entities.NoteEdit{
+			ID:        lo.ToPtr(uuid.MustParse(id)),
+			AuthorID:  author,
+			Status:    entities.NoteEditStatusExpired,
+			ExpiresAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+		}
+	}
+
+	expiredNoteEdit1 := expiredNoteEdit("00000000-0000-0000-0000-000000000001", "author-id-1")
+	expiredNoteEdit2 := expiredNoteEdit("00000000-0000-0000-0000-000000000002", "author-id-2")
+	expiredNoteEdit3 := expiredNoteEdit("00000000-0000-0000-0000-000000000003", "author-id-1")
+
+	noteEditReleased := func(noteEdit *entities.NoteEdit) *events.NoteEditReleased {
+		return &events.NoteEditReleased{
+			NoteEditID: *noteEdit.ID,
+			AuthorID:   noteEdit.AuthorID,
+			Reason:     events.NoteEditReleasedReasonExpired,
+			ReleasedAt: now,
+		}
+	}
+
+	testData := []struct {
+		name string
+
+		// One response per batch.
+		expireNoteEditsResponses [][]*entities.NoteEdit
+		expireNoteEditsErr       error
+
+		expectEvents []*events.NoteEditReleased
+		emitErr      error
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name:                     "ExpireNoteEdits",
+			expireNoteEditsResponses: [][]*entities.NoteEdit{{expiredNoteEdit1}},
+			expectEvents:             []*events.NoteEditReleased{noteEditReleased(expiredNoteEdit1)},
+			expect:                   1,
+		},
+		{
+			name: "ExpireNoteEdits/MultipleBatches",
+			expireNoteEditsResponses: [][]*entities.NoteEdit{
+				{expiredNoteEdit1, expiredNoteEdit2},
+				{expiredNoteEdit3},
+			},
+			expectEvents: []*events.NoteEditReleased{
+				noteEditReleased(expiredNoteEdit1),
+				noteEditReleased(expiredNoteEdit2),
+				noteEditReleased(expiredNoteEdit3),
+			},
+			expect: 3,
+		},
+		{
+			name: "ExpireNoteEdits/FullLastBatch",
+			expireNoteEditsResponses: [][]*entities.NoteEdit{
+				{expiredNoteEdit1, expiredNoteEdit2},
+				{},
+			},
+			expectEvents: []*events.NoteEditReleased{
+				noteEditReleased(expiredNoteEdit1),
+				noteEditReleased(expiredNoteEdit2),
+			},
+			expect: 2,
+		},
+		{
+			name:                     "ExpireNoteEdits/NoExpiredNoteEdits",
+			expireNoteEditsResponses: [][]*entities.NoteEdit{{}},
+		},
+
+		// Dependency error cases.
+		{
+			name:                     "EmitError",
+			expireNoteEditsResponses: [][]*entities.NoteEdit{{expiredNoteEdit1}},
+			expectEvents:             []*events.NoteEditReleased{noteEditReleased(expiredNoteEdit1)},
+			emitErr:                  FooErr,
+			expectErr:                FooErr,
+		},
+		{
+			name:                     "ExpireNoteEditsError",
+			expireNoteEditsResponses: [][]*entities.NoteEdit{nil},
+			expireNoteEditsErr:       FooErr,
+			expectErr:                FooErr,
+		},
+		{
+			// Edits expired by previous batches are still reported.
+			name:                     "ExpireNoteEditsError/AfterFirstBatch",
+			expireNoteEditsResponses: [][]*entities.NoteEdit{{expiredNoteEdit1, expiredNoteEdit2}, nil},
+			expireNoteEditsErr:       FooErr,
+			expectEvents: []*events.NoteEditReleased{
+				noteEditReleased(expiredNoteEdit1),
+				noteEditReleased(expiredNoteEdit2),
+			},
+			expect:    2,
+			expectErr: FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			expireNoteEditsRepository := daomocks.NewMockExpireNoteEditsRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+			emitter := eventsmocks.NewMockEmitter(t)
+
+			runInTransactionRepository.
+				On("RunInTransaction", context.TODO(), mock.Anything).
+				Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).
+				Times(len(tt.expireNoteEditsResponses))
+
+			for i, response := range tt.expireNoteEditsResponses {
+				// Errors are returned by the last batch only.
+				var err error
+				if i == len(tt.expireNoteEditsResponses)-1 {
+					err = tt.expireNoteEditsErr
+				}
+
+				expireNoteEditsRepository.
+					On("ExpireNoteEdits", context.TODO(), now, 2).
+					Return(response, err).
+					Once()
+			}
+
+			for _, event := range tt.expectEvents {
+				emitter.
+					On("Emit", context.TODO(), event).
+					Return(tt.emitErr).
+					Once()
+			}
+
+			service := services.NewExpireNoteEditsService(expireNoteEditsRepository, runInTransactionRepository, emitter, 2)
+
+			count, err := service.Exec(context.TODO(), now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, count)
+
+			expireNoteEditsRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+			emitter.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"time"
+)
+
+// ExpireTrialsService marks the trials that ended before now as expired, and emits a TrialExpired event for each of
+// them. It returns the number of expired trials.
+type ExpireTrialsService interface {
+	Exec(ctx context.Context, now time.Time) (int, error)
+}
+
+type expireTrialsServiceImpl struct {
+	expireTrialsRepository     dao.ExpireTrialsRepository
+	runInTransactionRepository dao.RunInTransactionRepository
+
+	emitter   events.Emitter
+	batchSize int
+}
+
+func (s *expireTrialsServiceImpl) Exec(ctx context.Context, now time.Time) (int, error) {
+	total := 0
+
+	for {
+		var expired []*entities.Subscription
+
+		// Trials are only marked as expired once their events are emitted, so failed batches are retried on the next
+		// run.
+		err := s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+			var err error
+
+			expired, err = s.expireTrialsRepository.ExpireTrials(ctx, now, s.batchSize)
+			if err != nil {
+				return fmt.Errorf("expire trials: %w", err)
+			}
+
+			for _, subscription := range expired {
+				event := &events.TrialExpired{
+					SubscriptionID: *subscription.ID,
+					UserID:         subscription.UserID,
+					Tier:           subscription.Tier,
+					TrialEndsAt:    *subscription.TrialEndsAt,
+				}
+
+				if err := s.emitter.Emit(ctx, event); err != nil {
+					return fmt.Errorf("emit trial expired: %w", err)
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += len(expired)
+
+		if len(expired) < s.batchSize {
+			return total, nil
+		}
+	}
+}
+
+func NewExpireTrialsService(
+	expireTrialsRepository dao.ExpireTrialsRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+	emitter events.Emitter,
+	batchSize int,
+) ExpireTrialsService {
+	return &expireTrialsServiceImpl{
+		expireTrialsRepository:     expireTrialsRepository,
+		runInTransactionRepository: runInTransactionRepository,
+		emitter:                    emitter,
+		batchSize:                  batchSize,
+	}
+}
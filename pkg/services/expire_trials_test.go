@@ -0,0 +1,156 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	eventsmocks "github.com/in-rich/uservice-subscription/pkg/events/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestExpireTrials(t *testing.T) {
+	now := time.Date(2021, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	expiredTrial1 := &entities.Subscription{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:      "user-id-1",
+		Tier:        "pro",
+		Status:      entities.SubscriptionStatusCanceled,
+		TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+	}
+	expiredTrial2 := &entities.Subscription{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+		UserID:      "user-id-2",
+		Tier:        "pro",
+		Status:      entities.SubscriptionStatusCanceled,
+		TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 16, 0, 0, 0, 0, time.UTC)),
+	}
+	expiredTrial3 := &entities.Subscription{
+		ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		UserID:      "user-id-3",
+		Tier:        "pro",
+		Status:      entities.SubscriptionStatusCanceled,
+		TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 17, 0, 0, 0, 0, time.UTC)),
+	}
+
+	trialExpired := func(subscription *entities.Subscription) *events.TrialExpired {
+		return &events.TrialExpired{
+			SubscriptionID: *subscription.ID,
+			UserID:         subscription.UserID,
+			Tier:           subscription.Tier,
+			TrialEndsAt:    *subscription.TrialEndsAt,
+		}
+	}
+
+	testData := []struct {
+		name string
+
+		// One response per batch.
+		expireTrialsResponses [][]*entities.Subscription
+		expireTrialsErr       error
+
+		expectEvents []*events.TrialExpired
+		emitErr      error
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name:                  "ExpireTrials",
+			expireTrialsResponses: [][]*entities.Subscription{{expiredTrial1}},
+			expectEvents:          []*events.TrialExpired{trialExpired(expiredTrial1)},
+			expect:                1,
+		},
+		{
+			name: "ExpireTrials/MultipleBatches",
+			expireTrialsResponses: [][]*entities.Subscription{
+				{expiredTrial1, expiredTrial2},
+				{expiredTrial3},
+			},
+			expectEvents: []*events.TrialExpired{
+				trialExpired(expiredTrial1),
+				trialExpired(expiredTrial2),
+				trialExpired(expiredTrial3),
+			},
+			expect: 3,
+		},
+		{
+			name: "ExpireTrials/FullLastBatch",
+			expireTrialsResponses: [][]*entities.Subscription{
+				{expiredTrial1, expiredTrial2},
+				{},
+			},
+			expectEvents: []*events.TrialExpired{
+				trialExpired(expiredTrial1),
+				trialExpired(expiredTrial2),
+			},
+			expect: 2,
+		},
+		{
+			name:                  "ExpireTrials/NoExpiredTrials",
+			expireTrialsResponses: [][]*entities.Subscription{{}},
+		},
+
+		// Dependency error cases.
+		{
+			name:                  "EmitError",
+			expireTrialsResponses: [][]*entities.Subscription{{expiredTrial1}},
+			expectEvents:          []*events.TrialExpired{trialExpired(expiredTrial1)},
+			emitErr:               FooErr,
+			expectErr:             FooErr,
+		},
+		{
+			name:                  "ExpireTrialsError",
+			expireTrialsResponses: [][]*entities.Subscription{nil},
+			expireTrialsErr:       FooErr,
+			expectErr:             FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			expireTrialsRepository := daomocks.NewMockExpireTrialsRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+			emitter := eventsmocks.NewMockEmitter(t)
+
+			runInTransactionRepository.
+				On("RunInTransaction", context.TODO(), mock.Anything).
+				Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).
+				Times(len(tt.expireTrialsResponses))
+
+			for _, response := range tt.expireTrialsResponses {
+				expireTrialsRepository.
+					On("ExpireTrials", context.TODO(), now, 2).
+					Return(response, tt.expireTrialsErr).
+					Once()
+			}
+
+			for _, event := range tt.expectEvents {
+				emitter.
+					On("Emit", context.TODO(), event).
+					Return(tt.emitErr).
+					Once()
+			}
+
+			service := services.NewExpireTrialsService(expireTrialsRepository, runInTransactionRepository, emitter, 2)
+
+			count, err := service.Exec(context.TODO(), now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, count)
+
+			expireTrialsRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+			emitter.AssertExpectations(t)
+		})
+	}
+}
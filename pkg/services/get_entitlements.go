@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"time"
+)
+
+// GetEntitlementsService returns every entitlement of a user, from their tier with their active overrides applied
+// on top of it.
+type GetEntitlementsService interface {
+	Exec(
+		ctx context.Context, request *models.GetEntitlementsRequest, tier *models.ResolvedTier, now time.Time,
+	) (map[string]config.Entitlement, error)
+}
+
+type getEntitlementsServiceImpl struct {
+	listEntitlementOverridesRepository dao.ListEntitlementOverridesByUserRepository
+}
+
+func (s *getEntitlementsServiceImpl) Exec(
+	ctx context.Context, request *models.GetEntitlementsRequest, tier *models.ResolvedTier, now time.Time,
+) (map[string]config.Entitlement, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	overrides, err := s.listEntitlementOverridesRepository.ListEntitlementOverridesByUser(ctx, request.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("list entitlement overrides: %w", err)
+	}
+
+	// Copy the entitlements, so overrides don't leak into the tier configuration.
+	entitlements := make(map[string]config.Entitlement, len(tier.Entitlements))
+	for name, entitlement := range tier.Entitlements {
+		entitlements[name] = entitlement
+	}
+
+	for _, override := range overrides {
+		entitlement, ok := entitlements[override.Entitlement]
+		// Ignore overrides of entitlements that have since been removed, or changed type.
+		if !ok || entitlement.IsNumeric() != (override.Limit != nil) || !override.IsActive(now) {
+			continue
+		}
+
+		entitlements[override.Entitlement] = config.Entitlement{Enabled: override.Enabled, Limit: override.Limit}
+	}
+
+	return entitlements, nil
+}
+
+func NewGetEntitlementsService(
+	listEntitlementOverridesRepository dao.ListEntitlementOverridesByUserRepository,
+) GetEntitlementsService {
+	return &getEntitlementsServiceImpl{
+		listEntitlementOverridesRepository: listEntitlementOverridesRepository,
+	}
+}
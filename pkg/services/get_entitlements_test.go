@@ -0,0 +1,166 @@
+package services_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/config"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestGetEntitlements(t *testing.T) {
+	tier := &models.ResolvedTier{
+		Name: "free",
+		TierInformation: config.TierInformation{
+			Entitlements: map[string]config.Entitlement{
+				"can-export-csv":           {Enabled: false},
+				"can-see-company-insights": {Enabled: false},
+				"max-team-seats":           config.NumericEntitlement(1),
+			},
+		},
+	}
+
+	now := time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name string
+
+		request *models.GetEntitlementsRequest
+
+		shouldCallList bool
+		listResponse   []*entities.EntitlementOverride
+		listErr        error
+
+		expect    map[string]config.Entitlement
+		expectErr error
+	}{
+		{
+			name: "GetEntitlements",
+			request: &models.GetEntitlementsRequest{
+				UserID: "user-id-1",
+			},
+			shouldCallList: true,
+			listResponse:   []*entities.EntitlementOverride{},
+			expect: map[string]config.Entitlement{
+				"can-export-csv":           {Enabled: false},
+				"can-see-company-insights": {Enabled: false},
+				"max-team-seats":           config.NumericEntitlement(1),
+			},
+		},
+		{
+			name: "GetEntitlements/Overrides",
+			request: &models.GetEntitlementsRequest{
+				UserID: "user-id-1",
+			},
+			shouldCallList: true,
+			listResponse: []*entities.EntitlementOverride{
+				{
+					UserID:      "user-id-1",
+					Entitlement: "can-export-csv",
+					Enabled:     true,
+				},
+				{
+					UserID:      "user-id-1",
+					Entitlement: "max-team-seats",
+					Enabled:     true,
+					Limit:       lo.ToPtr(25),
+					ExpiresAt:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			expect: map[string]config.Entitlement{
+				"can-export-csv":           {Enabled: true},
+				"can-see-company-insights": {Enabled: false},
+				"max-team-seats":           config.NumericEntitlement(25),
+			},
+		},
+		{
+			name: "GetEntitlements/ExpiredOverride",
+			request: &models.GetEntitlementsRequest{
+				UserID: "user-id-1",
+			},
+			shouldCallList: true,
+			listResponse: []*entities.EntitlementOverride{
+				{
+					UserID:      "user-id-1",
+					Entitlement: "can-export-csv",
+					Enabled:     true,
+					ExpiresAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			expect: map[string]config.Entitlement{
+				"can-export-csv":           {Enabled: false},
+				"can-see-company-insights": {Enabled: false},
+				"max-team-seats":           config.NumericEntitlement(1),
+			},
+		},
+		{
+			name: "GetEntitlements/StaleOverrides",
+			request: &models.GetEntitlementsRequest{
+				UserID: "user-id-1",
+			},
+			shouldCallList: true,
+			listResponse: []*entities.EntitlementOverride{
+				// Entitlement removed from the configuration.
+				{
+					UserID:      "user-id-1",
+					Entitlement: "can-fly",
+					Enabled:     true,
+				},
+				// Entitlement changed from on/off to numeric.
+				{
+					UserID:      "user-id-1",
+					Entitlement: "max-team-seats",
+					Enabled:     true,
+				},
+			},
+			expect: map[string]config.Entitlement{
+				"can-export-csv":           {Enabled: false},
+				"can-see-company-insights": {Enabled: false},
+				"max-team-seats":           config.NumericEntitlement(1),
+			},
+		},
+		{
+			name:      "GetEntitlements/InvalidRequest",
+			request:   &models.GetEntitlementsRequest{},
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "ListEntitlementOverridesError",
+			request: &models.GetEntitlementsRequest{
+				UserID: "user-id-1",
+			},
+			shouldCallList: true,
+			listErr:        FooErr,
+			expectErr:      FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			listEntitlementOverridesRepository := daomocks.NewMockListEntitlementOverridesByUserRepository(t)
+
+			if tt.shouldCallList {
+				listEntitlementOverridesRepository.
+					On("ListEntitlementOverridesByUser", context.TODO(), tt.request.UserID).
+					Return(tt.listResponse, tt.listErr)
+			}
+
+			service := services.NewGetEntitlementsService(listEntitlementOverridesRepository)
+
+			entitlements, err := service.Exec(context.TODO(), tt.request, tier, now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, entitlements)
+
+			listEntitlementOverridesRepository.AssertExpectations(t)
+		})
+	}
+
+	// Overrides must not leak into the configuration of the tier.
+	require.Equal(t, config.Entitlement{Enabled: false}, tier.Entitlements["can-export-csv"])
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+)
+
+type GetSubscriptionService interface {
+	Exec(ctx context.Context, request *models.GetSubscriptionRequest) (*entities.Subscription, error)
+}
+
+type getSubscriptionServiceImpl struct {
+	getSubscriptionRepository dao.GetSubscriptionByUserRepository
+}
+
+func (s *getSubscriptionServiceImpl) Exec(
+	ctx context.Context, request *models.GetSubscriptionRequest,
+) (*entities.Subscription, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	subscription, err := s.getSubscriptionRepository.GetSubscriptionByUser(ctx, request.UserID)
+	if err != nil {
+		if errors.Is(err, dao.ErrSubscriptionNotFound) {
+			return nil, ErrSubscriptionNotFound
+		}
+
+		return nil, fmt.Errorf("get subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func NewGetSubscriptionService(getSubscriptionRepository dao.GetSubscriptionByUserRepository) GetSubscriptionService {
+	return &getSubscriptionServiceImpl{
+		getSubscriptionRepository: getSubscriptionRepository,
+	}
+}
@@ -0,0 +1,91 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetSubscription(t *testing.T) {
+	testData := []struct {
+		name string
+
+		request *models.GetSubscriptionRequest
+
+		shouldCallGetSubscription bool
+		getSubscriptionResponse   *entities.Subscription
+		getSubscriptionErr        error
+
+		expect    *entities.Subscription
+		expectErr error
+	}{
+		{
+			name: "GetSubscription",
+			request: &models.GetSubscriptionRequest{
+				UserID: "user-id-1",
+			},
+			shouldCallGetSubscription: true,
+			getSubscriptionResponse: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+			expect: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+			},
+		},
+		{
+			name: "GetSubscription/NotFound",
+			request: &models.GetSubscriptionRequest{
+				UserID: "user-id-1",
+			},
+			shouldCallGetSubscription: true,
+			getSubscriptionErr:        dao.ErrSubscriptionNotFound,
+			expectErr:                 services.ErrSubscriptionNotFound,
+		},
+		{
+			name:      "GetSubscription/InvalidRequest",
+			request:   &models.GetSubscriptionRequest{},
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "GetSubscriptionError",
+			request: &models.GetSubscriptionRequest{
+				UserID: "user-id-1",
+			},
+			shouldCallGetSubscription: true,
+			getSubscriptionErr:        FooErr,
+			expectErr:                 FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			getSubscriptionRepository := daomocks.NewMockGetSubscriptionByUserRepository(t)
+
+			if tt.shouldCallGetSubscription {
+				getSubscriptionRepository.
+					On("GetSubscriptionByUser", context.TODO(), tt.request.UserID).
+					Return(tt.getSubscriptionResponse, tt.getSubscriptionErr)
+			}
+
+			service := services.NewGetSubscriptionService(getSubscriptionRepository)
+
+			subscription, err := service.Exec(context.TODO(), tt.request)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, subscription)
+
+			getSubscriptionRepository.AssertExpectations(t)
+		})
+	}
+}
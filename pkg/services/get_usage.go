@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/samber/lo"
+	"time"
+)
+
+type GetUsageService interface {
+	Exec(ctx context.Context, request *models.GetUsageRequest, tier *models.ResolvedTier, now time.Time) (*models.Usage, error)
+}
+
+type getUsageServiceImpl struct {
+	countEditsRepository       dao.CountNoteEditsByAuthorRepository
+	getOldestEditRepository    dao.GetOldestNoteEditByAuthorRepository
+	getQuotaOverrideRepository dao.GetQuotaOverrideByAuthorRepository
+
+	countOrganizationEditsRepository    dao.CountNoteEditsByOrganizationRepository
+	getOldestOrganizationEditRepository dao.GetOldestNoteEditByOrganizationRepository
+}
+
+func (s *getUsageServiceImpl) Exec(
+	ctx context.Context, request *models.GetUsageRequest, tier *models.ResolvedTier, now time.Time,
+) (*models.Usage, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	usage, err := s.countUsage(ctx, request.UserID, tier, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every edit stops counting at the end of calendar windows.
+	if usage.WindowEnd != nil {
+		if usage.Used > 0 {
+			usage.OldestEditExpiresIn = lo.ToPtr(usage.WindowEnd.Sub(now))
+		}
+
+		return usage, nil
+	}
+
+	// Billing cycles only end once the renewal of the billing period is recorded. Until then, the time at which the
+	// edits stop counting is unknown, and is not reported.
+	if tier.NoteEdits().Window != config.WindowRolling {
+		return usage, nil
+	}
+
+	oldestEdit, err := s.getOldestEdit(ctx, request.UserID, tier, &usage.WindowStart, now)
+	if err != nil {
+		if errors.Is(err, dao.ErrNoNoteEditFound) {
+			return usage, nil
+		}
+
+		return nil, err
+	}
+
+	usage.OldestEditExpiresIn = lo.ToPtr(oldestEdit.CreatedAt.Sub(usage.WindowStart))
+
+	return usage, nil
+}
+
+// countUsage counts the edits of the user in the current window. Members of an organization get the usage of the pool
+// of the organization.
+func (s *getUsageServiceImpl) countUsage(
+	ctx context.Context, user string, tier *models.ResolvedTier, now time.Time,
+) (*models.Usage, error) {
+	// Quota overrides only apply to individual quotas.
+	if tier.OrganizationID != nil {
+		windowStart, windowEnd := tier.NoteEdits().CountWindow(now, tier.BillingPeriodStart, tier.BillingPeriodEnd)
+
+		editsCount, err := s.countOrganizationEditsRepository.CountNoteEditsByOrganization(
+			ctx, *tier.OrganizationID, user, &windowStart, now,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("count organization note edits: %w", err)
+		}
+
+		return &models.Usage{
+			Used:           editsCount.Total,
+			Limit:          tier.NoteEdits().MaxUses,
+			Remaining:      remainingPooledUses(tier, editsCount),
+			WindowStart:    windowStart,
+			WindowEnd:      windowEnd,
+			OrganizationID: tier.OrganizationID,
+			MemberUsed:     editsCount.Member,
+			MemberLimit:    tier.MemberMaxEdits,
+		}, nil
+	}
+
+	tierInformation, err := applyQuotaOverride(ctx, s.getQuotaOverrideRepository, user, tier.TierInformation, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same window as the one used to count edits in CanUpdateNote.
+	windowStart, windowEnd := tierInformation.NoteEdits().CountWindow(now, tier.BillingPeriodStart, tier.BillingPeriodEnd)
+
+	editsCount, err := s.countEditsRepository.CountNoteEditsByAuthor(ctx, user, &windowStart, now)
+	if err != nil {
+		return nil, fmt.Errorf("count note edits: %w", err)
+	}
+
+	return &models.Usage{
+		Used:        editsCount,
+		Limit:       tierInformation.NoteEdits().MaxUses,
+		Remaining:   remainingUses(tierInformation.NoteEdits().MaxUses, editsCount),
+		WindowStart: windowStart,
+		WindowEnd:   windowEnd,
+	}, nil
+}
+
+// getOldestEdit returns the oldest edit counted in the current window.
+func (s *getUsageServiceImpl) getOldestEdit(
+	ctx context.Context, user string, tier *models.ResolvedTier, since *time.Time, now time.Time,
+) (*entities.NoteEdit, error) {
+	if tier.OrganizationID != nil {
+		oldestEdit, err := s.getOldestOrganizationEditRepository.GetOldestNoteEditByOrganization(ctx, *tier.OrganizationID, since, now)
+		if err != nil && !errors.Is(err, dao.ErrNoNoteEditFound) {
+			return nil, fmt.Errorf("get oldest organization note edit: %w", err)
+		}
+
+		return oldestEdit, err
+	}
+
+	oldestEdit, err := s.getOldestEditRepository.GetOldestNoteEditByAuthor(ctx, user, since, now)
+	if err != nil && !errors.Is(err, dao.ErrNoNoteEditFound) {
+		return nil, fmt.Errorf("get oldest note edit: %w", err)
+	}
+
+	return oldestEdit, err
+}
+
+func NewGetUsageService(
+	countEditsRepository dao.CountNoteEditsByAuthorRepository,
+	getOldestEditRepository dao.GetOldestNoteEditByAuthorRepository,
+	getQuotaOverrideRepository dao.GetQuotaOverrideByAuthorRepository,
+	countOrganizationEditsRepository dao.CountNoteEditsByOrganizationRepository,
+	getOldestOrganizationEditRepository dao.GetOldestNoteEditByOrganizationRepository,
+) GetUsageService {
+	return &getUsageServiceImpl{
+		countEditsRepository:       countEditsRepository,
+		getOldestEditRepository:    getOldestEditRepository,
+		getQuotaOverrideRepository: getQuotaOverrideRepository,
+
+		countOrganizationEditsRepository:    countOrganizationEditsRepository,
+		getOldestOrganizationEditRepository: getOldestOrganizationEditRepository,
+	}
+}
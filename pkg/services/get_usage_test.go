@@ -0,0 +1,417 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestGetUsage(t *testing.T) {
+	tier := config.TierInformation{
+		Features: map[string]config.FeatureTierInformation{
+			config.NoteEditsFeature: {
+				CountUsesOver: lo.ToPtr(24 * time.Hour),
+				MaxUses:       5,
+			},
+		},
+	}
+	monthlyTier := config.TierInformation{
+		Features: map[string]config.FeatureTierInformation{
+			config.NoteEditsFeature: {
+				Window:  config.WindowMonth,
+				MaxUses: 100,
+			},
+		},
+	}
+
+	billingCycleTier := config.TierInformation{
+		Features: map[string]config.FeatureTierInformation{
+			config.NoteEditsFeature: {
+				Window:  config.WindowBillingCycle,
+				MaxUses: 100,
+			},
+		},
+	}
+
+	organizationID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+
+	testData := []struct {
+		name string
+
+		request *models.GetUsageRequest
+		now     time.Time
+		// Defaults to a rolling window of 24 hours.
+		tier        *config.TierInformation
+		windowStart *time.Time
+
+		billingPeriodStart *time.Time
+		billingPeriodEnd   *time.Time
+
+		// Set when the edits are drawn from the pool of an organization.
+		organizationID *uuid.UUID
+		memberMaxEdits *int
+
+		quotaOverrideResponse *entities.QuotaOverride
+		quotaOverrideErr      error
+
+		shouldCallCountEdits bool
+		countEditsResponse   int
+		countEditsErr        error
+		// Used instead of countEditsResponse for organization pools.
+		countPoolResponse *dao.OrganizationNoteEditsCount
+
+		shouldCallGetOldestEdit bool
+		getOldestEditResponse   *entities.NoteEdit
+		getOldestEditErr        error
+
+		expect    *models.Usage
+		expectErr error
+	}{
+		{
+			name: "GetUsage",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                     time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC),
+			shouldCallCountEdits:    true,
+			countEditsResponse:      2,
+			shouldCallGetOldestEdit: true,
+			getOldestEditResponse: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID:         "user-id-1",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 18, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.Usage{
+				Used:                2,
+				Limit:               5,
+				Remaining:           3,
+				WindowStart:         time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC),
+				OldestEditExpiresIn: lo.ToPtr(6 * time.Hour),
+			},
+		},
+		{
+			name: "GetUsage/NoEdits",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                     time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC),
+			shouldCallCountEdits:    true,
+			countEditsResponse:      0,
+			shouldCallGetOldestEdit: true,
+			getOldestEditErr:        dao.ErrNoNoteEditFound,
+			expect: &models.Usage{
+				Used:        0,
+				Limit:       5,
+				Remaining:   5,
+				WindowStart: time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "GetUsage/LimitOverflowed",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                     time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC),
+			shouldCallCountEdits:    true,
+			countEditsResponse:      7,
+			shouldCallGetOldestEdit: true,
+			getOldestEditResponse: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID:         "user-id-1",
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 12, 30, 0, 0, time.UTC)),
+			},
+			expect: &models.Usage{
+				Used:                7,
+				Limit:               5,
+				Remaining:           0,
+				WindowStart:         time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC),
+				OldestEditExpiresIn: lo.ToPtr(30 * time.Minute),
+			},
+		},
+		{
+			name: "GetUsage/QuotaOverride",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now: time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC),
+			quotaOverrideResponse: &entities.QuotaOverride{
+				AuthorID: "user-id-1",
+				Kind:     entities.QuotaOverrideKindReplace,
+				MaxEdits: 20,
+			},
+			shouldCallCountEdits:    true,
+			countEditsResponse:      7,
+			shouldCallGetOldestEdit: true,
+			getOldestEditErr:        dao.ErrNoNoteEditFound,
+			expect: &models.Usage{
+				Used:        7,
+				Limit:       20,
+				Remaining:   13,
+				WindowStart: time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC),
+			},
+		},
+
+		{
+			name: "GetUsage/CalendarWindow",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                  time.Date(2021, 1, 31, 12, 0, 0, 0, time.UTC),
+			tier:                 &monthlyTier,
+			windowStart:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			shouldCallCountEdits: true,
+			countEditsResponse:   40,
+			expect: &models.Usage{
+				Used:                40,
+				Limit:               100,
+				Remaining:           60,
+				WindowStart:         time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				WindowEnd:           lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				OldestEditExpiresIn: lo.ToPtr(12 * time.Hour),
+			},
+		},
+		{
+			name: "GetUsage/CalendarWindow/NoEdits",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                  time.Date(2021, 1, 31, 12, 0, 0, 0, time.UTC),
+			tier:                 &monthlyTier,
+			windowStart:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			shouldCallCountEdits: true,
+			expect: &models.Usage{
+				Limit:       100,
+				Remaining:   100,
+				WindowStart: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				WindowEnd:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "GetUsage/BillingCycle",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                  time.Date(2021, 1, 31, 12, 0, 0, 0, time.UTC),
+			tier:                 &billingCycleTier,
+			windowStart:          lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
+			billingPeriodStart:   lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
+			billingPeriodEnd:     lo.ToPtr(time.Date(2021, 2, 10, 0, 0, 0, 0, time.UTC)),
+			shouldCallCountEdits: true,
+			countEditsResponse:   40,
+			expect: &models.Usage{
+				Used:                40,
+				Limit:               100,
+				Remaining:           60,
+				WindowStart:         time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC),
+				WindowEnd:           lo.ToPtr(time.Date(2021, 2, 10, 0, 0, 0, 0, time.UTC)),
+				OldestEditExpiresIn: lo.ToPtr(228 * time.Hour),
+			},
+		},
+		{
+			// The renewal of the billing period is not recorded yet, so the end of the new cycle is unknown.
+			name: "GetUsage/BillingCycle/PeriodEnded",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                  time.Date(2021, 1, 31, 12, 0, 0, 0, time.UTC),
+			tier:                 &billingCycleTier,
+			windowStart:          lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
+			billingPeriodStart:   lo.ToPtr(time.Date(2020, 12, 10, 0, 0, 0, 0, time.UTC)),
+			billingPeriodEnd:     lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
+			shouldCallCountEdits: true,
+			countEditsResponse:   40,
+			expect: &models.Usage{
+				Used:        40,
+				Limit:       100,
+				Remaining:   60,
+				WindowStart: time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "GetUsage/Organization",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                     time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC),
+			organizationID:          &organizationID,
+			memberMaxEdits:          lo.ToPtr(2),
+			shouldCallCountEdits:    true,
+			countPoolResponse:       &dao.OrganizationNoteEditsCount{Total: 3, Member: 1},
+			shouldCallGetOldestEdit: true,
+			getOldestEditResponse: &entities.NoteEdit{
+				ID:               lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID:         "user-id-2",
+				OrganizationID:   &organizationID,
+				PublicIdentifier: "public-identifier-1",
+				Target:           entities.TargetUser,
+				CreatedAt:        lo.ToPtr(time.Date(2021, 1, 2, 18, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.Usage{
+				Used:                3,
+				Limit:               5,
+				Remaining:           1,
+				WindowStart:         time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC),
+				OldestEditExpiresIn: lo.ToPtr(6 * time.Hour),
+				OrganizationID:      &organizationID,
+				MemberUsed:          1,
+				MemberLimit:         lo.ToPtr(2),
+			},
+		},
+
+		// Local error cases.
+		{
+			name:      "GetUsage/InvalidRequest",
+			request:   &models.GetUsageRequest{},
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "GetOldestEditError",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                     time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC),
+			shouldCallCountEdits:    true,
+			countEditsResponse:      2,
+			shouldCallGetOldestEdit: true,
+			getOldestEditErr:        FooErr,
+			expectErr:               FooErr,
+		},
+		{
+			name: "QuotaOverrideError",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:              time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC),
+			quotaOverrideErr: FooErr,
+			expectErr:        FooErr,
+		},
+		{
+			name: "CountEditsError",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                  time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC),
+			shouldCallCountEdits: true,
+			countEditsErr:        FooErr,
+			expectErr:            FooErr,
+		},
+		{
+			name: "CountOrganizationEditsError",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                  time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC),
+			organizationID:       &organizationID,
+			shouldCallCountEdits: true,
+			countEditsErr:        FooErr,
+			expectErr:            FooErr,
+		},
+		{
+			name: "GetOldestOrganizationEditError",
+			request: &models.GetUsageRequest{
+				UserID: "user-id-1",
+			},
+			now:                     time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC),
+			organizationID:          &organizationID,
+			shouldCallCountEdits:    true,
+			countPoolResponse:       &dao.OrganizationNoteEditsCount{Total: 3, Member: 1},
+			shouldCallGetOldestEdit: true,
+			getOldestEditErr:        FooErr,
+			expectErr:               FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			countEditsRepository := daomocks.NewMockCountNoteEditsByAuthorRepository(t)
+			getOldestEditRepository := daomocks.NewMockGetOldestNoteEditByAuthorRepository(t)
+			getQuotaOverrideRepository := daomocks.NewMockGetQuotaOverrideByAuthorRepository(t)
+			countPoolRepository := daomocks.NewMockCountNoteEditsByOrganizationRepository(t)
+			getOldestPoolEditRepository := daomocks.NewMockGetOldestNoteEditByOrganizationRepository(t)
+
+			windowStart := tt.now.Add(-24 * time.Hour)
+			if tt.windowStart != nil {
+				windowStart = *tt.windowStart
+			}
+
+			resolvedTier := &models.ResolvedTier{
+				Name:            "free",
+				TierInformation: tier,
+				OrganizationID:  tt.organizationID,
+				MemberMaxEdits:  tt.memberMaxEdits,
+
+				BillingPeriodStart: tt.billingPeriodStart,
+				BillingPeriodEnd:   tt.billingPeriodEnd,
+			}
+			if tt.tier != nil {
+				resolvedTier.TierInformation = *tt.tier
+			}
+
+			// Quota overrides are looked up right before counting individual edits.
+			if tt.organizationID == nil && (tt.shouldCallCountEdits || tt.quotaOverrideErr != nil) {
+				quotaOverrideErr := tt.quotaOverrideErr
+				if tt.quotaOverrideResponse == nil && quotaOverrideErr == nil {
+					quotaOverrideErr = dao.ErrQuotaOverrideNotFound
+				}
+
+				getQuotaOverrideRepository.
+					On("GetQuotaOverrideByAuthor", context.TODO(), tt.request.UserID).
+					Return(tt.quotaOverrideResponse, quotaOverrideErr)
+			}
+
+			if tt.shouldCallCountEdits && tt.organizationID != nil {
+				countPoolRepository.
+					On("CountNoteEditsByOrganization", context.TODO(), *tt.organizationID, tt.request.UserID, &windowStart, tt.now).
+					Return(tt.countPoolResponse, tt.countEditsErr)
+			} else if tt.shouldCallCountEdits {
+				countEditsRepository.
+					On("CountNoteEditsByAuthor", context.TODO(), tt.request.UserID, &windowStart, tt.now).
+					Return(tt.countEditsResponse, tt.countEditsErr)
+			}
+
+			if tt.shouldCallGetOldestEdit && tt.organizationID != nil {
+				getOldestPoolEditRepository.
+					On("GetOldestNoteEditByOrganization", context.TODO(), *tt.organizationID, &windowStart, tt.now).
+					Return(tt.getOldestEditResponse, tt.getOldestEditErr)
+			} else if tt.shouldCallGetOldestEdit {
+				getOldestEditRepository.
+					On("GetOldestNoteEditByAuthor", context.TODO(), tt.request.UserID, &windowStart, tt.now).
+					Return(tt.getOldestEditResponse, tt.getOldestEditErr)
+			}
+
+			service := services.NewGetUsageService(
+				countEditsRepository,
+				getOldestEditRepository,
+				getQuotaOverrideRepository,
+				countPoolRepository,
+				getOldestPoolEditRepository,
+			)
+
+			usage, err := service.Exec(context.TODO(), tt.request, resolvedTier, tt.now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, usage)
+
+			countEditsRepository.AssertExpectations(t)
+			getOldestEditRepository.AssertExpectations(t)
+			getQuotaOverrideRepository.AssertExpectations(t)
+			countPoolRepository.AssertExpectations(t)
+			getOldestPoolEditRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/samber/lo"
+	"time"
+)
+
+const (
+	StripeEventSubscriptionCreated  = "customer.subscription.created"
+	StripeEventSubscriptionUpdated  = "customer.subscription.updated"
+	StripeEventSubscriptionDeleted  = "customer.subscription.deleted"
+	StripeEventInvoicePaymentFailed = "invoice.payment_failed"
+
+	// StripeUserIDMetadata is the metadata key, set on Stripe subscriptions at checkout, that holds the id of the
+	// subscribed user.
+	StripeUserIDMetadata = "user_id"
+)
+
+// HandleStripeEventService verifies a Stripe webhook payload, then applies its event to the subscription records.
+// Each event is processed at most once: events that were already handled, or whose type is not supported, are
+// ignored.
+type HandleStripeEventService interface {
+	Exec(ctx context.Context, payload []byte, signature string, now time.Time) error
+}
+
+type handleStripeEventServiceImpl struct {
+	createStripeEventRepository         dao.CreateStripeEventRepository
+	getSubscriptionByStripeIDRepository dao.GetSubscriptionByStripeIDRepository
+	createSubscriptionRepository        dao.CreateSubscriptionRepository
+	updateSubscriptionRepository        dao.UpdateSubscriptionRepository
+	lockSubscriptionsRepository         dao.LockSubscriptionsByUserRepository
+
+	runInTransactionRepository dao.RunInTransactionRepository
+
+	emitter events.Emitter
+	stripe  config.StripeInformation
+}
+
+func (s *handleStripeEventServiceImpl) Exec(ctx context.Context, payload []byte, signature string, now time.Time) error {
+	if err := verifyStripeSignature(payload, signature, s.stripe.WebhookSecret, s.stripe.SignatureTolerance, now); err != nil {
+		return err
+	}
+
+	event := new(models.StripeEvent)
+	if err := json.Unmarshal(payload, event); err != nil {
+		return errors.Join(ErrInvalidRequest, fmt.Errorf("decode event: %w", err))
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(event); err != nil {
+		return errors.Join(ErrInvalidRequest, err)
+	}
+
+	var apply func(ctx context.Context) error
+
+	switch event.Type {
+	case StripeEventSubscriptionCreated, StripeEventSubscriptionUpdated, StripeEventSubscriptionDeleted:
+		subscription := new(models.StripeSubscription)
+		if err := decodeStripeObject(validate, event, subscription); err != nil {
+			return err
+		}
+
+		apply = func(ctx context.Context) error {
+			return s.syncSubscription(ctx, event, subscription, now)
+		}
+	case StripeEventInvoicePaymentFailed:
+		invoice := new(models.StripeInvoice)
+		if err := decodeStripeObject(validate, event, invoice); err != nil {
+			return err
+		}
+
+		apply = func(ctx context.Context) error {
+			return s.recordPaymentFailure(ctx, event, invoice)
+		}
+	default:
+		// Stripe sends every event type the endpoint is subscribed to, only handle the ones we care about.
+		return nil
+	}
+
+	// Recording the event and applying it in the same transaction guarantees a failed event is not marked as
+	// processed, so Stripe can retry it.
+	return s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := s.createStripeEventRepository.CreateStripeEvent(ctx, event.ID, event.Type); err != nil {
+			if errors.Is(err, dao.ErrStripeEventAlreadyProcessed) {
+				return nil
+			}
+
+			return fmt.Errorf("create stripe event: %w", err)
+		}
+
+		return apply(ctx)
+	})
+}
+
+func (s *handleStripeEventServiceImpl) syncSubscription(
+	ctx context.Context, event *models.StripeEvent, stripeSubscription *models.StripeSubscription, now time.Time,
+) error {
+	deleted := event.Type == StripeEventSubscriptionDeleted
+
+	priceID := stripeSubscription.Items.Data[0].Price.ID
+	tier, ok := s.stripe.Prices[priceID]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownStripePrice, priceID)
+	}
+
+	eventTime := time.Unix(event.Created, 0).UTC()
+
+	status := stripeSubscriptionStatus(stripeSubscription.Status)
+	if deleted {
+		status = entities.SubscriptionStatusCanceled
+	}
+
+	// The grace period of overdue subscriptions starts with the first failed payment. The invoice.payment_failed
+	// event may arrive after the subscription update, so fall back to the time of this event.
+	var paymentFailedAt *time.Time
+	if status == entities.SubscriptionStatusPastDue || status == entities.SubscriptionStatusUnpaid {
+		paymentFailedAt = &eventTime
+	}
+
+	endsAt := unixToTime(stripeSubscription.EndedAt)
+	if endsAt == nil {
+		endsAt = unixToTime(stripeSubscription.CancelAt)
+	}
+	if endsAt == nil && deleted {
+		// Deleted subscriptions always carry an end date, but never let them apply indefinitely.
+		endsAt = &eventTime
+	}
+
+	subscription, err := s.getLockedSubscription(ctx, stripeSubscription.ID, stripeSubscription.Metadata[StripeUserIDMetadata])
+	if err != nil && !errors.Is(err, dao.ErrSubscriptionNotFound) {
+		return err
+	}
+
+	// First event for this subscription, create the record.
+	if subscription == nil {
+		userID := stripeSubscription.Metadata[StripeUserIDMetadata]
+		if userID == "" {
+			return errors.Join(ErrInvalidRequest, fmt.Errorf("stripe subscription %q has no %s metadata", stripeSubscription.ID, StripeUserIDMetadata))
+		}
+
+		created, err := s.createSubscriptionRepository.CreateSubscription(ctx, userID, &dao.CreateSubscriptionData{
+			Tier:               tier,
+			Status:             status,
+			StartedAt:          time.Unix(stripeSubscription.StartDate, 0).UTC(),
+			EndsAt:             endsAt,
+			CurrentPeriodStart: unixToTime(stripeSubscription.CurrentPeriodStart),
+			CurrentPeriodEnd:   unixToTime(stripeSubscription.CurrentPeriodEnd),
+			CancelAtPeriodEnd:  stripeSubscription.CancelAtPeriodEnd,
+			CanceledAt:         unixToTime(stripeSubscription.CanceledAt),
+
+			TrialEndsAt: unixToTime(stripeSubscription.TrialEnd),
+
+			StripeSubscriptionID: &stripeSubscription.ID,
+			PaymentFailedAt:      paymentFailedAt,
+			StripeEventCreatedAt: &eventTime,
+			StripeEventID:        &event.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("create subscription: %w", err)
+		}
+
+		if err := s.emitter.Emit(ctx, subscriptionChanged(created, "", now)); err != nil {
+			return fmt.Errorf("emit subscription changed: %w", err)
+		}
+
+		return nil
+	}
+
+	// Stripe does not guarantee the order of delivery. A late event would otherwise overwrite a newer state, for example
+	// re-activating a deleted subscription.
+	if isStaleStripeEvent(event, subscription) {
+		return nil
+	}
+
+	data := subscriptionUpdateData(subscription)
+	data.StripeEventCreatedAt = &eventTime
+	data.StripeEventID = &event.ID
+	data.Tier = tier
+	data.Status = status
+	data.EndsAt = endsAt
+	data.CurrentPeriodStart = unixToTime(stripeSubscription.CurrentPeriodStart)
+	data.CurrentPeriodEnd = unixToTime(stripeSubscription.CurrentPeriodEnd)
+	data.CancelAtPeriodEnd = stripeSubscription.CancelAtPeriodEnd
+	data.CanceledAt = unixToTime(stripeSubscription.CanceledAt)
+	data.TrialEndsAt = unixToTime(stripeSubscription.TrialEnd)
+
+	switch status {
+	case entities.SubscriptionStatusPastDue, entities.SubscriptionStatusUnpaid:
+		if data.PaymentFailedAt == nil {
+			data.PaymentFailedAt = paymentFailedAt
+		}
+	case entities.SubscriptionStatusActive, entities.SubscriptionStatusTrialing:
+		// Stripe only moves a subscription back to active once the outstanding invoice is paid.
+		data.PaymentFailedAt = nil
+	}
+
+	updated, err := s.updateSubscriptionRepository.UpdateSubscription(ctx, *subscription.ID, data)
+	if err != nil {
+		return fmt.Errorf("update subscription: %w", err)
+	}
+
+	// Stripe also sends an update on every renewal, only announce the ones that change what the user has access to.
+	if updated.Tier == subscription.Tier &&
+		updated.Status == subscription.Status &&
+		lo.FromPtr(updated.EndsAt).Equal(lo.FromPtr(subscription.EndsAt)) {
+		return nil
+	}
+
+	if err := s.emitter.Emit(ctx, subscriptionChanged(updated, subscription.Tier, now)); err != nil {
+		return fmt.Errorf("emit subscription changed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *handleStripeEventServiceImpl) recordPaymentFailure(
+	ctx context.Context, event *models.StripeEvent, invoice *models.StripeInvoice,
+) error {
+	// One-off invoices are not related to a subscription.
+	if invoice.Subscription == "" {
+		return nil
+	}
+
+	subscription, err := s.getLockedSubscription(ctx, invoice.Subscription, "")
+	if err != nil {
+		if errors.Is(err, dao.ErrSubscriptionNotFound) {
+			return errors.Join(ErrInvalidRequest, fmt.Errorf("unknown stripe subscription %q", invoice.Subscription))
+		}
+
+		return err
+	}
+
+	// A failure delivered after a newer subscription event is outdated, for example when the invoice was paid since.
+	// Payment retries must not extend the grace period that started with the first failure either.
+	if isStaleStripeEvent(event, subscription) || subscription.PaymentFailedAt != nil {
+		return nil
+	}
+
+	// The failure does not carry the state of the subscription, so the last applied event is left unchanged. It would
+	// otherwise hide the subscription events created in the same second.
+	data := subscriptionUpdateData(subscription)
+	data.PaymentFailedAt = lo.ToPtr(time.Unix(invoice.Created, 0).UTC())
+
+	if _, err = s.updateSubscriptionRepository.UpdateSubscription(ctx, *subscription.ID, data); err != nil {
+		return fmt.Errorf("update subscription: %w", err)
+	}
+
+	return nil
+}
+
+// getLockedSubscription locks the subscriptions of the user of a Stripe subscription, like every other service writing
+// subscriptions, then returns the subscription. userID is the user the Stripe subscription was created for, if known.
+// Otherwise, the subscription is looked up first to find its user.
+func (s *handleStripeEventServiceImpl) getLockedSubscription(
+	ctx context.Context, stripeSubscriptionID string, userID string,
+) (*entities.Subscription, error) {
+	if userID == "" {
+		subscription, err := s.getSubscriptionByStripeIDRepository.GetSubscriptionByStripeID(ctx, stripeSubscriptionID)
+		if err != nil {
+			if errors.Is(err, dao.ErrSubscriptionNotFound) {
+				return nil, err
+			}
+
+			return nil, fmt.Errorf("get subscription: %w", err)
+		}
+
+		userID = subscription.UserID
+	}
+
+	if err := s.lockSubscriptionsRepository.LockSubscriptionsByUser(ctx, userID); err != nil {
+		return nil, fmt.Errorf("lock subscriptions: %w", err)
+	}
+
+	// Read the subscription once locked, as it may have changed while waiting for the lock.
+	subscription, err := s.getSubscriptionByStripeIDRepository.GetSubscriptionByStripeID(ctx, stripeSubscriptionID)
+	if err != nil {
+		if errors.Is(err, dao.ErrSubscriptionNotFound) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("get subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// isStaleStripeEvent reports whether a newer event was already applied to the subscription. Events created in the same
+// second are ordered by id.
+func isStaleStripeEvent(event *models.StripeEvent, subscription *entities.Subscription) bool {
+	if subscription.StripeEventCreatedAt == nil {
+		return false
+	}
+
+	eventTime := time.Unix(event.Created, 0).UTC()
+	if !eventTime.Equal(*subscription.StripeEventCreatedAt) {
+		return eventTime.Before(*subscription.StripeEventCreatedAt)
+	}
+
+	return subscription.StripeEventID != nil && event.ID < *subscription.StripeEventID
+}
+
+func decodeStripeObject(validate *validator.Validate, event *models.StripeEvent, object interface{}) error {
+	if err := json.Unmarshal(event.Data.Object, object); err != nil {
+		return errors.Join(ErrInvalidRequest, fmt.Errorf("decode %s object: %w", event.Type, err))
+	}
+
+	if err := validate.Struct(object); err != nil {
+		return errors.Join(ErrInvalidRequest, err)
+	}
+
+	return nil
+}
+
+// stripeSubscriptionStatus converts the status of a Stripe subscription. Subscriptions that are paid for, or still
+// being collected, keep granting their tier.
+func stripeSubscriptionStatus(status string) entities.SubscriptionStatus {
+	switch status {
+	case "active":
+		return entities.SubscriptionStatusActive
+	case "trialing":
+		return entities.SubscriptionStatusTrialing
+	case "past_due":
+		return entities.SubscriptionStatusPastDue
+	case "unpaid":
+		return entities.SubscriptionStatusUnpaid
+	default:
+		return entities.SubscriptionStatusCanceled
+	}
+}
+
+func unixToTime(timestamp *int64) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+
+	return lo.ToPtr(time.Unix(*timestamp, 0).UTC())
+}
+
+func NewHandleStripeEventService(
+	createStripeEventRepository dao.CreateStripeEventRepository,
+	getSubscriptionByStripeIDRepository dao.GetSubscriptionByStripeIDRepository,
+	createSubscriptionRepository dao.CreateSubscriptionRepository,
+	updateSubscriptionRepository dao.UpdateSubscriptionRepository,
+	lockSubscriptionsRepository dao.LockSubscriptionsByUserRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+	emitter events.Emitter,
+	stripe config.StripeInformation,
+) HandleStripeEventService {
+	return &handleStripeEventServiceImpl{
+		createStripeEventRepository:         createStripeEventRepository,
+		getSubscriptionByStripeIDRepository: getSubscriptionByStripeIDRepository,
+		createSubscriptionRepository:        createSubscriptionRepository,
+		updateSubscriptionRepository:        updateSubscriptionRepository,
+		lockSubscriptionsRepository:         lockSubscriptionsRepository,
+		runInTransactionRepository:          runInTransactionRepository,
+		emitter:                             emitter,
+		stripe:                              stripe,
+	}
+}
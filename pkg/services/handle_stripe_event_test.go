@@ -0,0 +1,776 @@
+package services_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	eventsmocks "github.com/in-rich/uservice-subscription/pkg/events/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const stripeWebhookSecret = "whsec_test_secret"
+
+var stripeInformation = config.StripeInformation{
+	WebhookSecret:      stripeWebhookSecret,
+	SignatureTolerance: 5 * time.Minute,
+	Prices: map[string]string{
+		"price_1Q3xTqLkdIwHu7ixPro0Mnth": "pro",
+		"price_1Q3xUdLkdIwHu7ixTeamMnth": "team",
+	},
+}
+
+// loadStripeFixture returns a webhook payload recorded from the Stripe test mode.
+func loadStripeFixture(t *testing.T, name string) []byte {
+	payload, err := os.ReadFile(filepath.Join("testdata", "stripe", name))
+	require.NoError(t, err)
+	return payload
+}
+
+func signStripePayload(payload []byte, secret string, signedAt time.Time) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%d.", signedAt.Unix())))
+	mac.Write(payload)
+	return fmt.Sprintf("t=%d,v1=%s", signedAt.Unix(), hex.EncodeToString(mac.Sum(nil)))
+}
+
+func TestHandleStripeEvent(t *testing.T) {
+	created := loadStripeFixture(t, "customer_subscription_created.json")
+	updated := loadStripeFixture(t, "customer_subscription_updated.json")
+	deleted := loadStripeFixture(t, "customer_subscription_deleted.json")
+	paymentFailed := loadStripeFixture(t, "invoice_payment_failed.json")
+	unsupported := loadStripeFixture(t, "charge_succeeded.json")
+	pastDue := []byte(strings.Replace(string(updated), `"status": "active"`, `"status": "past_due"`, 1))
+	trialing := []byte(strings.NewReplacer(
+		`"status": "active"`, `"status": "trialing"`,
+		`"trial_end": null`, `"trial_end": 1730278400`,
+		`"trial_start": null`, `"trial_start": 1727600000`,
+	).Replace(string(created)))
+
+	now := time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)
+
+	existingSubscription := &entities.Subscription{
+		ID:                   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		UserID:               "user-id-1",
+		Tier:                 "pro",
+		Status:               entities.SubscriptionStatusActive,
+		StartedAt:            lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+		CurrentPeriodStart:   lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+		CurrentPeriodEnd:     lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+		StripeSubscriptionID: lo.ToPtr("sub_1Q3xWaLkdIwHu7ixG2d8Zk9P"),
+	}
+
+	overdueSubscription := &entities.Subscription{
+		ID:                   existingSubscription.ID,
+		UserID:               "user-id-1",
+		Tier:                 "pro",
+		Status:               entities.SubscriptionStatusPastDue,
+		StartedAt:            existingSubscription.StartedAt,
+		CurrentPeriodStart:   existingSubscription.CurrentPeriodStart,
+		CurrentPeriodEnd:     existingSubscription.CurrentPeriodEnd,
+		StripeSubscriptionID: existingSubscription.StripeSubscriptionID,
+		PaymentFailedAt:      lo.ToPtr(time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)),
+	}
+
+	deletedSubscription := &entities.Subscription{
+		ID:                   existingSubscription.ID,
+		UserID:               "user-id-1",
+		Tier:                 "team",
+		Status:               entities.SubscriptionStatusCanceled,
+		StartedAt:            existingSubscription.StartedAt,
+		EndsAt:               lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+		StripeSubscriptionID: existingSubscription.StripeSubscriptionID,
+		StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 21, 0, time.UTC)),
+	}
+
+	// Subscription to which an event created in the same second as the update event was applied.
+	sameSecondSubscription := func(eventID string) *entities.Subscription {
+		return &entities.Subscription{
+			ID:                   existingSubscription.ID,
+			UserID:               "user-id-1",
+			Tier:                 "pro",
+			Status:               entities.SubscriptionStatusActive,
+			StartedAt:            existingSubscription.StartedAt,
+			CurrentPeriodStart:   existingSubscription.CurrentPeriodStart,
+			CurrentPeriodEnd:     existingSubscription.CurrentPeriodEnd,
+			StripeSubscriptionID: existingSubscription.StripeSubscriptionID,
+			StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+			StripeEventID:        lo.ToPtr(eventID),
+		}
+	}
+
+	// Subscription that recovered after the payment failure was sent.
+	recoveredSubscription := &entities.Subscription{
+		ID:                   existingSubscription.ID,
+		UserID:               "user-id-1",
+		Tier:                 "pro",
+		Status:               entities.SubscriptionStatusActive,
+		StartedAt:            existingSubscription.StartedAt,
+		CurrentPeriodStart:   lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+		CurrentPeriodEnd:     lo.ToPtr(time.Date(2024, 11, 30, 8, 53, 20, 0, time.UTC)),
+		StripeSubscriptionID: existingSubscription.StripeSubscriptionID,
+		StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 10, 31, 0, 0, 0, 0, time.UTC)),
+		StripeEventID:        lo.ToPtr("evt_1Q6nR4LkdIwHu7ixW2bQ0sTf"),
+	}
+
+	testData := []struct {
+		name string
+
+		payload   []byte
+		signature string
+		stripe    config.StripeInformation
+
+		shouldCallCreateEvent bool
+		createEventID         string
+		createEventType       string
+		createEventErr        error
+
+		// The lock is taken on the user of the subscription.
+		shouldCallLockSubscriptions bool
+		lockSubscriptionsErr        error
+
+		shouldCallGetSubscription bool
+		getSubscriptionResponse   *entities.Subscription
+		getSubscriptionErr        error
+
+		shouldCallCreateSubscription bool
+		createSubscriptionData       *dao.CreateSubscriptionData
+		createSubscriptionErr        error
+
+		shouldCallUpdateSubscription bool
+		updateSubscriptionData       *dao.UpdateSubscriptionData
+		updateSubscriptionErr        error
+
+		emitErr error
+
+		expectErr error
+	}{
+		{
+			name:                         "SubscriptionCreated",
+			payload:                      created,
+			signature:                    signStripePayload(created, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q3xWbLkdIwHu7ixq4OvLx0A",
+			createEventType:              "customer.subscription.created",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionErr:           dao.ErrSubscriptionNotFound,
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:                 "pro",
+				Status:               entities.SubscriptionStatusActive,
+				StartedAt:            time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC),
+				CurrentPeriodStart:   lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:     lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				StripeSubscriptionID: lo.ToPtr("sub_1Q3xWaLkdIwHu7ixG2d8Zk9P"),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1Q3xWbLkdIwHu7ixq4OvLx0A"),
+			},
+		},
+		{
+			name:                         "SubscriptionCreated/Trialing",
+			payload:                      trialing,
+			signature:                    signStripePayload(trialing, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q3xWbLkdIwHu7ixq4OvLx0A",
+			createEventType:              "customer.subscription.created",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionErr:           dao.ErrSubscriptionNotFound,
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:                 "pro",
+				Status:               entities.SubscriptionStatusTrialing,
+				StartedAt:            time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC),
+				CurrentPeriodStart:   lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:     lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				TrialEndsAt:          lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				StripeSubscriptionID: lo.ToPtr("sub_1Q3xWaLkdIwHu7ixG2d8Zk9P"),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1Q3xWbLkdIwHu7ixq4OvLx0A"),
+			},
+		},
+		{
+			name:                         "SubscriptionUpdated",
+			payload:                      updated,
+			signature:                    signStripePayload(updated, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q4bT2LkdIwHu7ixH8c1aZpQ",
+			createEventType:              "customer.subscription.updated",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      existingSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:                 "team",
+				Status:               entities.SubscriptionStatusActive,
+				EndsAt:               lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodStart:   lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:     lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CancelAtPeriodEnd:    true,
+				CanceledAt:           lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1Q4bT2LkdIwHu7ixH8c1aZpQ"),
+			},
+		},
+		{
+			name:                         "SubscriptionUpdated/PastDue",
+			payload:                      pastDue,
+			signature:                    signStripePayload(pastDue, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q4bT2LkdIwHu7ixH8c1aZpQ",
+			createEventType:              "customer.subscription.updated",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      existingSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:               "team",
+				Status:             entities.SubscriptionStatusPastDue,
+				EndsAt:             lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodStart: lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:   lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CancelAtPeriodEnd:  true,
+				CanceledAt:         lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				// The payment failure was not received yet, the grace period starts with this event.
+				PaymentFailedAt:      lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1Q4bT2LkdIwHu7ixH8c1aZpQ"),
+			},
+		},
+		{
+			name:                         "SubscriptionUpdated/PastDue/PaymentFailureRecorded",
+			payload:                      pastDue,
+			signature:                    signStripePayload(pastDue, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q4bT2LkdIwHu7ixH8c1aZpQ",
+			createEventType:              "customer.subscription.updated",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      overdueSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:                 "team",
+				Status:               entities.SubscriptionStatusPastDue,
+				EndsAt:               lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodStart:   lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:     lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CancelAtPeriodEnd:    true,
+				CanceledAt:           lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				PaymentFailedAt:      lo.ToPtr(time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1Q4bT2LkdIwHu7ixH8c1aZpQ"),
+			},
+		},
+		{
+			// Paying the outstanding invoice ends the grace period.
+			name:                         "SubscriptionUpdated/Recovered",
+			payload:                      updated,
+			signature:                    signStripePayload(updated, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q4bT2LkdIwHu7ixH8c1aZpQ",
+			createEventType:              "customer.subscription.updated",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      overdueSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:                 "team",
+				Status:               entities.SubscriptionStatusActive,
+				EndsAt:               lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodStart:   lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:     lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CancelAtPeriodEnd:    true,
+				CanceledAt:           lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1Q4bT2LkdIwHu7ixH8c1aZpQ"),
+			},
+		},
+		{
+			name:                         "SubscriptionDeleted",
+			payload:                      deleted,
+			signature:                    signStripePayload(deleted, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q6kL9LkdIwHu7ixc0DlZ2rT",
+			createEventType:              "customer.subscription.deleted",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      existingSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:                 "team",
+				Status:               entities.SubscriptionStatusCanceled,
+				EndsAt:               lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodStart:   lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:     lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CancelAtPeriodEnd:    true,
+				CanceledAt:           lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 21, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1Q6kL9LkdIwHu7ixc0DlZ2rT"),
+			},
+		},
+		{
+			// Events delivered after a newer one are ignored.
+			name:                        "SubscriptionUpdated/OutOfOrder",
+			payload:                     updated,
+			signature:                   signStripePayload(updated, stripeWebhookSecret, now),
+			stripe:                      stripeInformation,
+			shouldCallCreateEvent:       true,
+			createEventID:               "evt_1Q4bT2LkdIwHu7ixH8c1aZpQ",
+			createEventType:             "customer.subscription.updated",
+			shouldCallLockSubscriptions: true,
+			shouldCallGetSubscription:   true,
+			getSubscriptionResponse:     deletedSubscription,
+		},
+		{
+			// Events created in the same second are ordered by id.
+			name:                         "SubscriptionUpdated/SameSecond",
+			payload:                      updated,
+			signature:                    signStripePayload(updated, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q4bT2LkdIwHu7ixH8c1aZpQ",
+			createEventType:              "customer.subscription.updated",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      sameSecondSubscription("evt_1Q4bT1LkdIwHu7ixZr0pQ2Lm"),
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:                 "team",
+				Status:               entities.SubscriptionStatusActive,
+				EndsAt:               lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodStart:   lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:     lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				CancelAtPeriodEnd:    true,
+				CanceledAt:           lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 10, 1, 16, 26, 40, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1Q4bT2LkdIwHu7ixH8c1aZpQ"),
+			},
+		},
+		{
+			name:                        "SubscriptionUpdated/SameSecond/OutOfOrder",
+			payload:                     updated,
+			signature:                   signStripePayload(updated, stripeWebhookSecret, now),
+			stripe:                      stripeInformation,
+			shouldCallCreateEvent:       true,
+			createEventID:               "evt_1Q4bT2LkdIwHu7ixH8c1aZpQ",
+			createEventType:             "customer.subscription.updated",
+			shouldCallLockSubscriptions: true,
+			shouldCallGetSubscription:   true,
+			getSubscriptionResponse:     sameSecondSubscription("evt_1Q4bT3LkdIwHu7ixMv5cR8Yd"),
+		},
+		{
+			name:                         "InvoicePaymentFailed",
+			payload:                      paymentFailed,
+			signature:                    signStripePayload(paymentFailed, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q5fA1LkdIwHu7ixdY3Ue8Qm",
+			createEventType:              "invoice.payment_failed",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      existingSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:               "pro",
+				Status:             entities.SubscriptionStatusActive,
+				CurrentPeriodStart: lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:   lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				PaymentFailedAt:    lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+			},
+		},
+		{
+			// Payment retries do not extend the grace period.
+			name:                        "InvoicePaymentFailed/AlreadyRecorded",
+			payload:                     paymentFailed,
+			signature:                   signStripePayload(paymentFailed, stripeWebhookSecret, now),
+			stripe:                      stripeInformation,
+			shouldCallCreateEvent:       true,
+			createEventID:               "evt_1Q5fA1LkdIwHu7ixdY3Ue8Qm",
+			createEventType:             "invoice.payment_failed",
+			shouldCallLockSubscriptions: true,
+			shouldCallGetSubscription:   true,
+			getSubscriptionResponse:     overdueSubscription,
+		},
+		{
+			// The invoice was paid since, the failure must not start a grace period.
+			name:                        "InvoicePaymentFailed/OutOfOrder",
+			payload:                     paymentFailed,
+			signature:                   signStripePayload(paymentFailed, stripeWebhookSecret, now),
+			stripe:                      stripeInformation,
+			shouldCallCreateEvent:       true,
+			createEventID:               "evt_1Q5fA1LkdIwHu7ixdY3Ue8Qm",
+			createEventType:             "invoice.payment_failed",
+			shouldCallLockSubscriptions: true,
+			shouldCallGetSubscription:   true,
+			getSubscriptionResponse:     recoveredSubscription,
+		},
+		{
+			name:                  "AlreadyProcessed",
+			payload:               created,
+			signature:             signStripePayload(created, stripeWebhookSecret, now),
+			stripe:                stripeInformation,
+			shouldCallCreateEvent: true,
+			createEventID:         "evt_1Q3xWbLkdIwHu7ixq4OvLx0A",
+			createEventType:       "customer.subscription.created",
+			createEventErr:        dao.ErrStripeEventAlreadyProcessed,
+		},
+		{
+			name:      "UnsupportedEvent",
+			payload:   unsupported,
+			signature: signStripePayload(unsupported, stripeWebhookSecret, now),
+			stripe:    stripeInformation,
+		},
+		{
+			// Signatures are recomputed with every active secret during a secret rotation.
+			name:    "MultipleSignatures",
+			payload: paymentFailed,
+			signature: fmt.Sprintf(
+				"%s,%s",
+				signStripePayload(paymentFailed, "whsec_old_secret", now),
+				strings.SplitN(signStripePayload(paymentFailed, stripeWebhookSecret, now), ",", 2)[1],
+			),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q5fA1LkdIwHu7ixdY3Ue8Qm",
+			createEventType:              "invoice.payment_failed",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      existingSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:               "pro",
+				Status:             entities.SubscriptionStatusActive,
+				CurrentPeriodStart: lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:   lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				PaymentFailedAt:    lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+			},
+		},
+
+		// Local error cases.
+		{
+			name:      "InvalidSignature",
+			payload:   created,
+			signature: signStripePayload(created, "whsec_other_secret", now),
+			stripe:    stripeInformation,
+			expectErr: services.ErrInvalidStripeSignature,
+		},
+		{
+			name:      "InvalidSignature/TamperedPayload",
+			payload:   []byte(strings.Replace(string(created), "user-id-1", "user-id-2", 1)),
+			signature: signStripePayload(created, stripeWebhookSecret, now),
+			stripe:    stripeInformation,
+			expectErr: services.ErrInvalidStripeSignature,
+		},
+		{
+			name:      "InvalidSignature/Expired",
+			payload:   created,
+			signature: signStripePayload(created, stripeWebhookSecret, now.Add(-10*time.Minute)),
+			stripe:    stripeInformation,
+			expectErr: services.ErrInvalidStripeSignature,
+		},
+		{
+			name:      "InvalidSignature/Malformed",
+			payload:   created,
+			signature: "v1=abc",
+			stripe:    stripeInformation,
+			expectErr: services.ErrInvalidStripeSignature,
+		},
+		{
+			name:      "InvalidSignature/NoSecret",
+			payload:   created,
+			signature: signStripePayload(created, "", now),
+			stripe:    config.StripeInformation{Prices: stripeInformation.Prices},
+			expectErr: services.ErrInvalidStripeSignature,
+		},
+		{
+			name:      "InvalidPayload",
+			payload:   []byte(`{"id": "evt_1", "type": "customer.subscription.created"`),
+			signature: signStripePayload([]byte(`{"id": "evt_1", "type": "customer.subscription.created"`), stripeWebhookSecret, now),
+			stripe:    stripeInformation,
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "MissingUserID",
+			payload: []byte(strings.Replace(
+				string(created), `"user_id": "user-id-1"`, `"plan": "pro"`, 1,
+			)),
+			signature: signStripePayload([]byte(strings.Replace(
+				string(created), `"user_id": "user-id-1"`, `"plan": "pro"`, 1,
+			)), stripeWebhookSecret, now),
+			stripe:                    stripeInformation,
+			shouldCallCreateEvent:     true,
+			createEventID:             "evt_1Q3xWbLkdIwHu7ixq4OvLx0A",
+			createEventType:           "customer.subscription.created",
+			shouldCallGetSubscription: true,
+			getSubscriptionErr:        dao.ErrSubscriptionNotFound,
+			expectErr:                 services.ErrInvalidRequest,
+		},
+		{
+			name:      "UnknownPrice",
+			payload:   created,
+			signature: signStripePayload(created, stripeWebhookSecret, now),
+			stripe: config.StripeInformation{
+				WebhookSecret:      stripeWebhookSecret,
+				SignatureTolerance: 5 * time.Minute,
+			},
+			shouldCallCreateEvent: true,
+			createEventID:         "evt_1Q3xWbLkdIwHu7ixq4OvLx0A",
+			createEventType:       "customer.subscription.created",
+			expectErr:             services.ErrUnknownStripePrice,
+		},
+
+		// Dependency error cases.
+		{
+			name:                         "UpdateSubscriptionError",
+			payload:                      paymentFailed,
+			signature:                    signStripePayload(paymentFailed, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q5fA1LkdIwHu7ixdY3Ue8Qm",
+			createEventType:              "invoice.payment_failed",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionResponse:      existingSubscription,
+			shouldCallUpdateSubscription: true,
+			updateSubscriptionData: &dao.UpdateSubscriptionData{
+				Tier:               "pro",
+				Status:             entities.SubscriptionStatusActive,
+				CurrentPeriodStart: lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:   lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				PaymentFailedAt:    lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+			},
+			updateSubscriptionErr: FooErr,
+			expectErr:             FooErr,
+		},
+		{
+			name:                         "EmitError",
+			payload:                      created,
+			signature:                    signStripePayload(created, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q3xWbLkdIwHu7ixq4OvLx0A",
+			createEventType:              "customer.subscription.created",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionErr:           dao.ErrSubscriptionNotFound,
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:                 "pro",
+				Status:               entities.SubscriptionStatusActive,
+				StartedAt:            time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC),
+				CurrentPeriodStart:   lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:     lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				StripeSubscriptionID: lo.ToPtr("sub_1Q3xWaLkdIwHu7ixG2d8Zk9P"),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1Q3xWbLkdIwHu7ixq4OvLx0A"),
+			},
+			emitErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:                         "CreateSubscriptionError",
+			payload:                      created,
+			signature:                    signStripePayload(created, stripeWebhookSecret, now),
+			stripe:                       stripeInformation,
+			shouldCallCreateEvent:        true,
+			createEventID:                "evt_1Q3xWbLkdIwHu7ixq4OvLx0A",
+			createEventType:              "customer.subscription.created",
+			shouldCallLockSubscriptions:  true,
+			shouldCallGetSubscription:    true,
+			getSubscriptionErr:           dao.ErrSubscriptionNotFound,
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:                 "pro",
+				Status:               entities.SubscriptionStatusActive,
+				StartedAt:            time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC),
+				CurrentPeriodStart:   lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				CurrentPeriodEnd:     lo.ToPtr(time.Date(2024, 10, 30, 8, 53, 20, 0, time.UTC)),
+				StripeSubscriptionID: lo.ToPtr("sub_1Q3xWaLkdIwHu7ixG2d8Zk9P"),
+				StripeEventCreatedAt: lo.ToPtr(time.Date(2024, 9, 29, 8, 53, 20, 0, time.UTC)),
+				StripeEventID:        lo.ToPtr("evt_1Q3xWbLkdIwHu7ixq4OvLx0A"),
+			},
+			createSubscriptionErr: FooErr,
+			expectErr:             FooErr,
+		},
+		{
+			name:                        "LockSubscriptionsError",
+			payload:                     created,
+			signature:                   signStripePayload(created, stripeWebhookSecret, now),
+			stripe:                      stripeInformation,
+			shouldCallCreateEvent:       true,
+			createEventID:               "evt_1Q3xWbLkdIwHu7ixq4OvLx0A",
+			createEventType:             "customer.subscription.created",
+			shouldCallLockSubscriptions: true,
+			lockSubscriptionsErr:        FooErr,
+			expectErr:                   FooErr,
+		},
+		{
+			name:                        "GetSubscriptionError",
+			payload:                     created,
+			signature:                   signStripePayload(created, stripeWebhookSecret, now),
+			stripe:                      stripeInformation,
+			shouldCallCreateEvent:       true,
+			createEventID:               "evt_1Q3xWbLkdIwHu7ixq4OvLx0A",
+			createEventType:             "customer.subscription.created",
+			shouldCallLockSubscriptions: true,
+			shouldCallGetSubscription:   true,
+			getSubscriptionErr:          FooErr,
+			expectErr:                   FooErr,
+		},
+		{
+			name:                  "CreateStripeEventError",
+			payload:               created,
+			signature:             signStripePayload(created, stripeWebhookSecret, now),
+			stripe:                stripeInformation,
+			shouldCallCreateEvent: true,
+			createEventID:         "evt_1Q3xWbLkdIwHu7ixq4OvLx0A",
+			createEventType:       "customer.subscription.created",
+			createEventErr:        FooErr,
+			expectErr:             FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			createStripeEventRepository := daomocks.NewMockCreateStripeEventRepository(t)
+			getSubscriptionRepository := daomocks.NewMockGetSubscriptionByStripeIDRepository(t)
+			createSubscriptionRepository := daomocks.NewMockCreateSubscriptionRepository(t)
+			updateSubscriptionRepository := daomocks.NewMockUpdateSubscriptionRepository(t)
+			lockSubscriptionsRepository := daomocks.NewMockLockSubscriptionsByUserRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+			emitter := eventsmocks.NewMockEmitter(t)
+
+			if tt.shouldCallCreateEvent {
+				runInTransactionRepository.
+					On("RunInTransaction", context.TODO(), mock.Anything).
+					Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				createStripeEventRepository.
+					On("CreateStripeEvent", context.TODO(), tt.createEventID, tt.createEventType).
+					Return(tt.createEventErr)
+			}
+
+			if tt.shouldCallLockSubscriptions {
+				lockSubscriptionsRepository.
+					On("LockSubscriptionsByUser", context.TODO(), "user-id-1").
+					Return(tt.lockSubscriptionsErr)
+			}
+
+			if tt.shouldCallGetSubscription {
+				getSubscriptionRepository.
+					On("GetSubscriptionByStripeID", context.TODO(), "sub_1Q3xWaLkdIwHu7ixG2d8Zk9P").
+					Return(tt.getSubscriptionResponse, tt.getSubscriptionErr)
+			}
+
+			if tt.shouldCallCreateSubscription {
+				var createdSubscription *entities.Subscription
+				if tt.createSubscriptionErr == nil {
+					createdSubscription = &entities.Subscription{
+						ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+						UserID: "user-id-1",
+						Tier:   tt.createSubscriptionData.Tier,
+						Status: tt.createSubscriptionData.Status,
+						EndsAt: tt.createSubscriptionData.EndsAt,
+					}
+
+					emitter.
+						On("Emit", context.TODO(), &events.SubscriptionChanged{
+							SubscriptionID: *createdSubscription.ID,
+							UserID:         createdSubscription.UserID,
+							Tier:           createdSubscription.Tier,
+							Status:         string(createdSubscription.Status),
+							EndsAt:         createdSubscription.EndsAt,
+							ChangedAt:      now,
+						}).
+						Return(tt.emitErr)
+				}
+
+				createSubscriptionRepository.
+					On("CreateSubscription", context.TODO(), "user-id-1", tt.createSubscriptionData).
+					Return(createdSubscription, tt.createSubscriptionErr)
+			}
+
+			if tt.shouldCallUpdateSubscription {
+				var updatedSubscription *entities.Subscription
+				if tt.updateSubscriptionErr == nil {
+					updatedSubscription = &entities.Subscription{
+						ID:     existingSubscription.ID,
+						UserID: tt.getSubscriptionResponse.UserID,
+						Tier:   tt.updateSubscriptionData.Tier,
+						Status: tt.updateSubscriptionData.Status,
+						EndsAt: tt.updateSubscriptionData.EndsAt,
+					}
+
+					// Renewals and payment updates do not change the access of the user, and are not announced.
+					if updatedSubscription.Tier != tt.getSubscriptionResponse.Tier ||
+						updatedSubscription.Status != tt.getSubscriptionResponse.Status ||
+						!lo.FromPtr(updatedSubscription.EndsAt).Equal(lo.FromPtr(tt.getSubscriptionResponse.EndsAt)) {
+						emitter.
+							On("Emit", context.TODO(), &events.SubscriptionChanged{
+								SubscriptionID: *updatedSubscription.ID,
+								UserID:         updatedSubscription.UserID,
+								Tier:           updatedSubscription.Tier,
+								PreviousTier:   tt.getSubscriptionResponse.Tier,
+								Status:         string(updatedSubscription.Status),
+								EndsAt:         updatedSubscription.EndsAt,
+								ChangedAt:      now,
+							}).
+							Return(tt.emitErr)
+					}
+				}
+
+				updateSubscriptionRepository.
+					On("UpdateSubscription", context.TODO(), *existingSubscription.ID, tt.updateSubscriptionData).
+					Return(updatedSubscription, tt.updateSubscriptionErr)
+			}
+
+			service := services.NewHandleStripeEventService(
+				createStripeEventRepository,
+				getSubscriptionRepository,
+				createSubscriptionRepository,
+				updateSubscriptionRepository,
+				lockSubscriptionsRepository,
+				runInTransactionRepository,
+				emitter,
+				tt.stripe,
+			)
+
+			err := service.Exec(context.TODO(), tt.payload, tt.signature, now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			createStripeEventRepository.AssertExpectations(t)
+			getSubscriptionRepository.AssertExpectations(t)
+			createSubscriptionRepository.AssertExpectations(t)
+			updateSubscriptionRepository.AssertExpectations(t)
+			lockSubscriptionsRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+			emitter.AssertExpectations(t)
+		})
+	}
+}
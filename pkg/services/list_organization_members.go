@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+)
+
+type ListOrganizationMembersService interface {
+	Exec(ctx context.Context, request *models.ListOrganizationMembersRequest) ([]*entities.OrganizationMember, error)
+}
+
+type listOrganizationMembersServiceImpl struct {
+	getOrganizationRepository         dao.GetOrganizationRepository
+	listOrganizationMembersRepository dao.ListOrganizationMembersRepository
+}
+
+func (s *listOrganizationMembersServiceImpl) Exec(
+	ctx context.Context, request *models.ListOrganizationMembersRequest,
+) ([]*entities.OrganizationMember, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	organizationID := uuid.MustParse(request.OrganizationID)
+
+	// Tell unknown organizations apart from empty ones.
+	if _, err := s.getOrganizationRepository.GetOrganization(ctx, organizationID); err != nil {
+		if errors.Is(err, dao.ErrOrganizationNotFound) {
+			return nil, ErrOrganizationNotFound
+		}
+
+		return nil, fmt.Errorf("get organization: %w", err)
+	}
+
+	members, err := s.listOrganizationMembersRepository.ListOrganizationMembers(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("list organization members: %w", err)
+	}
+
+	return members, nil
+}
+
+func NewListOrganizationMembersService(
+	getOrganizationRepository dao.GetOrganizationRepository,
+	listOrganizationMembersRepository dao.ListOrganizationMembersRepository,
+) ListOrganizationMembersService {
+	return &listOrganizationMembersServiceImpl{
+		getOrganizationRepository:         getOrganizationRepository,
+		listOrganizationMembersRepository: listOrganizationMembersRepository,
+	}
+}
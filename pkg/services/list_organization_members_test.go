@@ -0,0 +1,117 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListOrganizationMembers(t *testing.T) {
+	organizationID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+
+	members := []*entities.OrganizationMember{
+		{OrganizationID: &organizationID, UserID: "user-id-1"},
+		{OrganizationID: &organizationID, UserID: "user-id-2", MaxEdits: lo.ToPtr(5)},
+	}
+
+	testData := []struct {
+		name string
+
+		request *models.ListOrganizationMembersRequest
+
+		shouldCallGetOrganization bool
+		getOrganizationErr        error
+
+		shouldCallList bool
+		listResponse   []*entities.OrganizationMember
+		listErr        error
+
+		expect    []*entities.OrganizationMember
+		expectErr error
+	}{
+		// Success cases.
+		{
+			name: "ListOrganizationMembers",
+			request: &models.ListOrganizationMembersRequest{
+				OrganizationID: organizationID.String(),
+			},
+			shouldCallGetOrganization: true,
+			shouldCallList:            true,
+			listResponse:              members,
+			expect:                    members,
+		},
+
+		// Local error cases.
+		{
+			name: "ListOrganizationMembers/OrganizationNotFound",
+			request: &models.ListOrganizationMembersRequest{
+				OrganizationID: organizationID.String(),
+			},
+			shouldCallGetOrganization: true,
+			getOrganizationErr:        dao.ErrOrganizationNotFound,
+			expectErr:                 services.ErrOrganizationNotFound,
+		},
+		{
+			name:      "ListOrganizationMembers/InvalidRequest",
+			request:   &models.ListOrganizationMembersRequest{},
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "GetOrganizationError",
+			request: &models.ListOrganizationMembersRequest{
+				OrganizationID: organizationID.String(),
+			},
+			shouldCallGetOrganization: true,
+			getOrganizationErr:        FooErr,
+			expectErr:                 FooErr,
+		},
+		{
+			name: "ListOrganizationMembersError",
+			request: &models.ListOrganizationMembersRequest{
+				OrganizationID: organizationID.String(),
+			},
+			shouldCallGetOrganization: true,
+			shouldCallList:            true,
+			listErr:                   FooErr,
+			expectErr:                 FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			getOrganizationRepository := daomocks.NewMockGetOrganizationRepository(t)
+			listOrganizationMembersRepository := daomocks.NewMockListOrganizationMembersRepository(t)
+
+			if tt.shouldCallGetOrganization {
+				getOrganizationRepository.
+					On("GetOrganization", context.TODO(), organizationID).
+					Return(&entities.Organization{ID: &organizationID}, tt.getOrganizationErr)
+			}
+
+			if tt.shouldCallList {
+				listOrganizationMembersRepository.
+					On("ListOrganizationMembers", context.TODO(), organizationID).
+					Return(tt.listResponse, tt.listErr)
+			}
+
+			service := services.NewListOrganizationMembersService(getOrganizationRepository, listOrganizationMembersRepository)
+
+			result, err := service.Exec(context.TODO(), tt.request)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, result)
+
+			getOrganizationRepository.AssertExpectations(t)
+			listOrganizationMembersRepository.AssertExpectations(t)
+		})
+	}
+}
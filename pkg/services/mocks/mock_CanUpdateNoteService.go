@@ -5,11 +5,8 @@ package servicesmocks
 import (
 	context "context"
 
-	config "github.com/in-rich/uservice-subscription/config"
-
-	mock "github.com/stretchr/testify/mock"
-
 	models "github.com/in-rich/uservice-subscription/pkg/models"
+	mock "github.com/stretchr/testify/mock"
 
 	time "time"
 )
@@ -28,25 +25,27 @@ func (_m *MockCanUpdateNoteService) EXPECT() *MockCanUpdateNoteService_Expecter
 }
 
 // Exec provides a mock function with given fields: ctx, canUpdateRequest, tier, now
-func (_m *MockCanUpdateNoteService) Exec(ctx context.Context, canUpdateRequest *models.CanUpdateNoteRequest, tier config.TierInformation, now time.Time) (int, error) {
+func (_m *MockCanUpdateNoteService) Exec(ctx context.Context, canUpdateRequest *models.CanUpdateNoteRequest, tier *models.ResolvedTier, now time.Time) (*models.CanUpdateNoteResult, error) {
 	ret := _m.Called(ctx, canUpdateRequest, tier, now)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 int
+	var r0 *models.CanUpdateNoteResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.CanUpdateNoteRequest, config.TierInformation, time.Time) (int, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CanUpdateNoteRequest, *models.ResolvedTier, time.Time) (*models.CanUpdateNoteResult, error)); ok {
 		return rf(ctx, canUpdateRequest, tier, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *models.CanUpdateNoteRequest, config.TierInformation, time.Time) int); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CanUpdateNoteRequest, *models.ResolvedTier, time.Time) *models.CanUpdateNoteResult); ok {
 		r0 = rf(ctx, canUpdateRequest, tier, now)
 	} else {
-		r0 = ret.Get(0).(int)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CanUpdateNoteResult)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *models.CanUpdateNoteRequest, config.TierInformation, time.Time) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *models.CanUpdateNoteRequest, *models.ResolvedTier, time.Time) error); ok {
 		r1 = rf(ctx, canUpdateRequest, tier, now)
 	} else {
 		r1 = ret.Error(1)
@@ -63,25 +62,25 @@ type MockCanUpdateNoteService_Exec_Call struct {
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
 //   - canUpdateRequest *models.CanUpdateNoteRequest
-//   - tier config.TierInformation
+//   - tier *models.ResolvedTier
 //   - now time.Time
 func (_e *MockCanUpdateNoteService_Expecter) Exec(ctx interface{}, canUpdateRequest interface{}, tier interface{}, now interface{}) *MockCanUpdateNoteService_Exec_Call {
 	return &MockCanUpdateNoteService_Exec_Call{Call: _e.mock.On("Exec", ctx, canUpdateRequest, tier, now)}
 }
 
-func (_c *MockCanUpdateNoteService_Exec_Call) Run(run func(ctx context.Context, canUpdateRequest *models.CanUpdateNoteRequest, tier config.TierInformation, now time.Time)) *MockCanUpdateNoteService_Exec_Call {
+func (_c *MockCanUpdateNoteService_Exec_Call) Run(run func(ctx context.Context, canUpdateRequest *models.CanUpdateNoteRequest, tier *models.ResolvedTier, now time.Time)) *MockCanUpdateNoteService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.CanUpdateNoteRequest), args[2].(config.TierInformation), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(*models.CanUpdateNoteRequest), args[2].(*models.ResolvedTier), args[3].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockCanUpdateNoteService_Exec_Call) Return(_a0 int, _a1 error) *MockCanUpdateNoteService_Exec_Call {
+func (_c *MockCanUpdateNoteService_Exec_Call) Return(_a0 *models.CanUpdateNoteResult, _a1 error) *MockCanUpdateNoteService_Exec_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCanUpdateNoteService_Exec_Call) RunAndReturn(run func(context.Context, *models.CanUpdateNoteRequest, config.TierInformation, time.Time) (int, error)) *MockCanUpdateNoteService_Exec_Call {
+func (_c *MockCanUpdateNoteService_Exec_Call) RunAndReturn(run func(context.Context, *models.CanUpdateNoteRequest, *models.ResolvedTier, time.Time) (*models.CanUpdateNoteResult, error)) *MockCanUpdateNoteService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockCanUpdateNotesService is an autogenerated mock type for the CanUpdateNotesService type
+type MockCanUpdateNotesService struct {
+	mock.Mock
+}
+
+type MockCanUpdateNotesService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCanUpdateNotesService) EXPECT() *MockCanUpdateNotesService_Expecter {
+	return &MockCanUpdateNotesService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request, tier, now
+func (_m *MockCanUpdateNotesService) Exec(ctx context.Context, request *models.CanUpdateNotesRequest, tier *models.ResolvedTier, now time.Time) (*models.CanUpdateNotesResult, error) {
+	ret := _m.Called(ctx, request, tier, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.CanUpdateNotesResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CanUpdateNotesRequest, *models.ResolvedTier, time.Time) (*models.CanUpdateNotesResult, error)); ok {
+		return rf(ctx, request, tier, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CanUpdateNotesRequest, *models.ResolvedTier, time.Time) *models.CanUpdateNotesResult); ok {
+		r0 = rf(ctx, request, tier, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CanUpdateNotesResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.CanUpdateNotesRequest, *models.ResolvedTier, time.Time) error); ok {
+		r1 = rf(ctx, request, tier, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCanUpdateNotesService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCanUpdateNotesService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.CanUpdateNotesRequest
+//   - tier *models.ResolvedTier
+//   - now time.Time
+func (_e *MockCanUpdateNotesService_Expecter) Exec(ctx interface{}, request interface{}, tier interface{}, now interface{}) *MockCanUpdateNotesService_Exec_Call {
+	return &MockCanUpdateNotesService_Exec_Call{Call: _e.mock.On("Exec", ctx, request, tier, now)}
+}
+
+func (_c *MockCanUpdateNotesService_Exec_Call) Run(run func(ctx context.Context, request *models.CanUpdateNotesRequest, tier *models.ResolvedTier, now time.Time)) *MockCanUpdateNotesService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.CanUpdateNotesRequest), args[2].(*models.ResolvedTier), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCanUpdateNotesService_Exec_Call) Return(_a0 *models.CanUpdateNotesResult, _a1 error) *MockCanUpdateNotesService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCanUpdateNotesService_Exec_Call) RunAndReturn(run func(context.Context, *models.CanUpdateNotesRequest, *models.ResolvedTier, time.Time) (*models.CanUpdateNotesResult, error)) *MockCanUpdateNotesService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCanUpdateNotesService creates a new instance of MockCanUpdateNotesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCanUpdateNotesService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCanUpdateNotesService {
+	mock := &MockCanUpdateNotesService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+
+	time "time"
+)
+
+// MockChangeSubscriptionTierService is an autogenerated mock type for the ChangeSubscriptionTierService type
+type MockChangeSubscriptionTierService struct {
+	mock.Mock
+}
+
+type MockChangeSubscriptionTierService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChangeSubscriptionTierService) EXPECT() *MockChangeSubscriptionTierService_Expecter {
+	return &MockChangeSubscriptionTierService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request, now
+func (_m *MockChangeSubscriptionTierService) Exec(ctx context.Context, request *models.ChangeSubscriptionTierRequest, now time.Time) (*entities.Subscription, error) {
+	ret := _m.Called(ctx, request, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *entities.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ChangeSubscriptionTierRequest, time.Time) (*entities.Subscription, error)); ok {
+		return rf(ctx, request, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ChangeSubscriptionTierRequest, time.Time) *entities.Subscription); ok {
+		r0 = rf(ctx, request, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ChangeSubscriptionTierRequest, time.Time) error); ok {
+		r1 = rf(ctx, request, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChangeSubscriptionTierService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockChangeSubscriptionTierService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.ChangeSubscriptionTierRequest
+//   - now time.Time
+func (_e *MockChangeSubscriptionTierService_Expecter) Exec(ctx interface{}, request interface{}, now interface{}) *MockChangeSubscriptionTierService_Exec_Call {
+	return &MockChangeSubscriptionTierService_Exec_Call{Call: _e.mock.On("Exec", ctx, request, now)}
+}
+
+func (_c *MockChangeSubscriptionTierService_Exec_Call) Run(run func(ctx context.Context, request *models.ChangeSubscriptionTierRequest, now time.Time)) *MockChangeSubscriptionTierService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ChangeSubscriptionTierRequest), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockChangeSubscriptionTierService_Exec_Call) Return(_a0 *entities.Subscription, _a1 error) *MockChangeSubscriptionTierService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChangeSubscriptionTierService_Exec_Call) RunAndReturn(run func(context.Context, *models.ChangeSubscriptionTierRequest, time.Time) (*entities.Subscription, error)) *MockChangeSubscriptionTierService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockChangeSubscriptionTierService creates a new instance of MockChangeSubscriptionTierService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChangeSubscriptionTierService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChangeSubscriptionTierService {
+	mock := &MockChangeSubscriptionTierService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
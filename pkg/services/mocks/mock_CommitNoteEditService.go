@@ -0,0 +1,87 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockCommitNoteEditService is an autogenerated mock type for the CommitNoteEditService type
+type MockCommitNoteEditService struct {
+	mock.Mock
+}
+
+type MockCommitNoteEditService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCommitNoteEditService) EXPECT() *MockCommitNoteEditService_Expecter {
+	return &MockCommitNoteEditService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request, now
+func (_m *MockCommitNoteEditService) Exec(ctx context.Context, request *models.CommitNoteEditRequest, now time.Time) error {
+	ret := _m.Called(ctx, request, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CommitNoteEditRequest, time.Time) error); ok {
+		r0 = rf(ctx, request, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommitNoteEditService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCommitNoteEditService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.CommitNoteEditRequest
+//   - now time.Time
+func (_e *MockCommitNoteEditService_Expecter) Exec(ctx interface{}, request interface{}, now interface{}) *MockCommitNoteEditService_Exec_Call {
+	return &MockCommitNoteEditService_Exec_Call{Call: _e.mock.On("Exec", ctx, request, now)}
+}
+
+func (_c *MockCommitNoteEditService_Exec_Call) Run(run func(ctx context.Context, request *models.CommitNoteEditRequest, now time.Time)) *MockCommitNoteEditService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.CommitNoteEditRequest), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCommitNoteEditService_Exec_Call) Return(_a0 error) *MockCommitNoteEditService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommitNoteEditService_Exec_Call) RunAndReturn(run func(context.Context, *models.CommitNoteEditRequest, time.Time) error) *MockCommitNoteEditService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCommitNoteEditService creates a new instance of MockCommitNoteEditService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCommitNoteEditService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCommitNoteEditService {
+	mock := &MockCommitNoteEditService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
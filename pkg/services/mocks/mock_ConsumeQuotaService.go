@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockConsumeQuotaService is an autogenerated mock type for the ConsumeQuotaService type
+type MockConsumeQuotaService struct {
+	mock.Mock
+}
+
+type MockConsumeQuotaService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConsumeQuotaService) EXPECT() *MockConsumeQuotaService_Expecter {
+	return &MockConsumeQuotaService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request, tier, now
+func (_m *MockConsumeQuotaService) Exec(ctx context.Context, request *models.ConsumeQuotaRequest, tier *models.ResolvedTier, now time.Time) (int, error) {
+	ret := _m.Called(ctx, request, tier, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ConsumeQuotaRequest, *models.ResolvedTier, time.Time) (int, error)); ok {
+		return rf(ctx, request, tier, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ConsumeQuotaRequest, *models.ResolvedTier, time.Time) int); ok {
+		r0 = rf(ctx, request, tier, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ConsumeQuotaRequest, *models.ResolvedTier, time.Time) error); ok {
+		r1 = rf(ctx, request, tier, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConsumeQuotaService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockConsumeQuotaService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.ConsumeQuotaRequest
+//   - tier *models.ResolvedTier
+//   - now time.Time
+func (_e *MockConsumeQuotaService_Expecter) Exec(ctx interface{}, request interface{}, tier interface{}, now interface{}) *MockConsumeQuotaService_Exec_Call {
+	return &MockConsumeQuotaService_Exec_Call{Call: _e.mock.On("Exec", ctx, request, tier, now)}
+}
+
+func (_c *MockConsumeQuotaService_Exec_Call) Run(run func(ctx context.Context, request *models.ConsumeQuotaRequest, tier *models.ResolvedTier, now time.Time)) *MockConsumeQuotaService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ConsumeQuotaRequest), args[2].(*models.ResolvedTier), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockConsumeQuotaService_Exec_Call) Return(_a0 int, _a1 error) *MockConsumeQuotaService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConsumeQuotaService_Exec_Call) RunAndReturn(run func(context.Context, *models.ConsumeQuotaRequest, *models.ResolvedTier, time.Time) (int, error)) *MockConsumeQuotaService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConsumeQuotaService creates a new instance of MockConsumeQuotaService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConsumeQuotaService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConsumeQuotaService {
+	mock := &MockConsumeQuotaService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockExpireNoteEditsService is an autogenerated mock type for the ExpireNoteEditsService type
+type MockExpireNoteEditsService struct {
+	mock.Mock
+}
+
+type MockExpireNoteEditsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpireNoteEditsService) EXPECT() *MockExpireNoteEditsService_Expecter {
+	return &MockExpireNoteEditsService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, now
+func (_m *MockExpireNoteEditsService) Exec(ctx context.Context, now time.Time) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpireNoteEditsService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockExpireNoteEditsService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockExpireNoteEditsService_Expecter) Exec(ctx interface{}, now interface{}) *MockExpireNoteEditsService_Exec_Call {
+	return &MockExpireNoteEditsService_Exec_Call{Call: _e.mock.On("Exec", ctx, now)}
+}
+
+func (_c *MockExpireNoteEditsService_Exec_Call) Run(run func(ctx context.Context, now time.Time)) *MockExpireNoteEditsService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockExpireNoteEditsService_Exec_Call) Return(_a0 int, _a1 error) *MockExpireNoteEditsService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpireNoteEditsService_Exec_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *MockExpireNoteEditsService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpireNoteEditsService creates a new instance of MockExpireNoteEditsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpireNoteEditsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpireNoteEditsService {
+	mock := &MockExpireNoteEditsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,103 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	config "github.com/in-rich/uservice-subscription/config"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+
+	time "time"
+)
+
+// MockGetEntitlementsService is an autogenerated mock type for the GetEntitlementsService type
+type MockGetEntitlementsService struct {
+	mock.Mock
+}
+
+type MockGetEntitlementsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetEntitlementsService) EXPECT() *MockGetEntitlementsService_Expecter {
+	return &MockGetEntitlementsService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request, tier, now
+func (_m *MockGetEntitlementsService) Exec(ctx context.Context, request *models.GetEntitlementsRequest, tier *models.ResolvedTier, now time.Time) (map[string]config.Entitlement, error) {
+	ret := _m.Called(ctx, request, tier, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 map[string]config.Entitlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.GetEntitlementsRequest, *models.ResolvedTier, time.Time) (map[string]config.Entitlement, error)); ok {
+		return rf(ctx, request, tier, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.GetEntitlementsRequest, *models.ResolvedTier, time.Time) map[string]config.Entitlement); ok {
+		r0 = rf(ctx, request, tier, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]config.Entitlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.GetEntitlementsRequest, *models.ResolvedTier, time.Time) error); ok {
+		r1 = rf(ctx, request, tier, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetEntitlementsService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGetEntitlementsService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.GetEntitlementsRequest
+//   - tier *models.ResolvedTier
+//   - now time.Time
+func (_e *MockGetEntitlementsService_Expecter) Exec(ctx interface{}, request interface{}, tier interface{}, now interface{}) *MockGetEntitlementsService_Exec_Call {
+	return &MockGetEntitlementsService_Exec_Call{Call: _e.mock.On("Exec", ctx, request, tier, now)}
+}
+
+func (_c *MockGetEntitlementsService_Exec_Call) Run(run func(ctx context.Context, request *models.GetEntitlementsRequest, tier *models.ResolvedTier, now time.Time)) *MockGetEntitlementsService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.GetEntitlementsRequest), args[2].(*models.ResolvedTier), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGetEntitlementsService_Exec_Call) Return(_a0 map[string]config.Entitlement, _a1 error) *MockGetEntitlementsService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetEntitlementsService_Exec_Call) RunAndReturn(run func(context.Context, *models.GetEntitlementsRequest, *models.ResolvedTier, time.Time) (map[string]config.Entitlement, error)) *MockGetEntitlementsService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetEntitlementsService creates a new instance of MockGetEntitlementsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetEntitlementsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetEntitlementsService {
+	mock := &MockGetEntitlementsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+)
+
+// MockGetSubscriptionService is an autogenerated mock type for the GetSubscriptionService type
+type MockGetSubscriptionService struct {
+	mock.Mock
+}
+
+type MockGetSubscriptionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetSubscriptionService) EXPECT() *MockGetSubscriptionService_Expecter {
+	return &MockGetSubscriptionService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request
+func (_m *MockGetSubscriptionService) Exec(ctx context.Context, request *models.GetSubscriptionRequest) (*entities.Subscription, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *entities.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.GetSubscriptionRequest) (*entities.Subscription, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.GetSubscriptionRequest) *entities.Subscription); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.GetSubscriptionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetSubscriptionService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGetSubscriptionService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.GetSubscriptionRequest
+func (_e *MockGetSubscriptionService_Expecter) Exec(ctx interface{}, request interface{}) *MockGetSubscriptionService_Exec_Call {
+	return &MockGetSubscriptionService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGetSubscriptionService_Exec_Call) Run(run func(ctx context.Context, request *models.GetSubscriptionRequest)) *MockGetSubscriptionService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.GetSubscriptionRequest))
+	})
+	return _c
+}
+
+func (_c *MockGetSubscriptionService_Exec_Call) Return(_a0 *entities.Subscription, _a1 error) *MockGetSubscriptionService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetSubscriptionService_Exec_Call) RunAndReturn(run func(context.Context, *models.GetSubscriptionRequest) (*entities.Subscription, error)) *MockGetSubscriptionService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetSubscriptionService creates a new instance of MockGetSubscriptionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetSubscriptionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetSubscriptionService {
+	mock := &MockGetSubscriptionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockGetUsageService is an autogenerated mock type for the GetUsageService type
+type MockGetUsageService struct {
+	mock.Mock
+}
+
+type MockGetUsageService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetUsageService) EXPECT() *MockGetUsageService_Expecter {
+	return &MockGetUsageService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request, tier, now
+func (_m *MockGetUsageService) Exec(ctx context.Context, request *models.GetUsageRequest, tier *models.ResolvedTier, now time.Time) (*models.Usage, error) {
+	ret := _m.Called(ctx, request, tier, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.Usage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.GetUsageRequest, *models.ResolvedTier, time.Time) (*models.Usage, error)); ok {
+		return rf(ctx, request, tier, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.GetUsageRequest, *models.ResolvedTier, time.Time) *models.Usage); ok {
+		r0 = rf(ctx, request, tier, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Usage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.GetUsageRequest, *models.ResolvedTier, time.Time) error); ok {
+		r1 = rf(ctx, request, tier, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetUsageService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGetUsageService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.GetUsageRequest
+//   - tier *models.ResolvedTier
+//   - now time.Time
+func (_e *MockGetUsageService_Expecter) Exec(ctx interface{}, request interface{}, tier interface{}, now interface{}) *MockGetUsageService_Exec_Call {
+	return &MockGetUsageService_Exec_Call{Call: _e.mock.On("Exec", ctx, request, tier, now)}
+}
+
+func (_c *MockGetUsageService_Exec_Call) Run(run func(ctx context.Context, request *models.GetUsageRequest, tier *models.ResolvedTier, now time.Time)) *MockGetUsageService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.GetUsageRequest), args[2].(*models.ResolvedTier), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockGetUsageService_Exec_Call) Return(_a0 *models.Usage, _a1 error) *MockGetUsageService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetUsageService_Exec_Call) RunAndReturn(run func(context.Context, *models.GetUsageRequest, *models.ResolvedTier, time.Time) (*models.Usage, error)) *MockGetUsageService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetUsageService creates a new instance of MockGetUsageService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetUsageService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetUsageService {
+	mock := &MockGetUsageService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
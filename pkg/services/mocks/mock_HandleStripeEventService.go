@@ -0,0 +1,87 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockHandleStripeEventService is an autogenerated mock type for the HandleStripeEventService type
+type MockHandleStripeEventService struct {
+	mock.Mock
+}
+
+type MockHandleStripeEventService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHandleStripeEventService) EXPECT() *MockHandleStripeEventService_Expecter {
+	return &MockHandleStripeEventService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, payload, signature, now
+func (_m *MockHandleStripeEventService) Exec(ctx context.Context, payload []byte, signature string, now time.Time) error {
+	ret := _m.Called(ctx, payload, signature, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string, time.Time) error); ok {
+		r0 = rf(ctx, payload, signature, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockHandleStripeEventService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockHandleStripeEventService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payload []byte
+//   - signature string
+//   - now time.Time
+func (_e *MockHandleStripeEventService_Expecter) Exec(ctx interface{}, payload interface{}, signature interface{}, now interface{}) *MockHandleStripeEventService_Exec_Call {
+	return &MockHandleStripeEventService_Exec_Call{Call: _e.mock.On("Exec", ctx, payload, signature, now)}
+}
+
+func (_c *MockHandleStripeEventService_Exec_Call) Run(run func(ctx context.Context, payload []byte, signature string, now time.Time)) *MockHandleStripeEventService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockHandleStripeEventService_Exec_Call) Return(_a0 error) *MockHandleStripeEventService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHandleStripeEventService_Exec_Call) RunAndReturn(run func(context.Context, []byte, string, time.Time) error) *MockHandleStripeEventService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHandleStripeEventService creates a new instance of MockHandleStripeEventService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHandleStripeEventService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHandleStripeEventService {
+	mock := &MockHandleStripeEventService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+)
+
+// MockListOrganizationMembersService is an autogenerated mock type for the ListOrganizationMembersService type
+type MockListOrganizationMembersService struct {
+	mock.Mock
+}
+
+type MockListOrganizationMembersService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListOrganizationMembersService) EXPECT() *MockListOrganizationMembersService_Expecter {
+	return &MockListOrganizationMembersService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request
+func (_m *MockListOrganizationMembersService) Exec(ctx context.Context, request *models.ListOrganizationMembersRequest) ([]*entities.OrganizationMember, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*entities.OrganizationMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ListOrganizationMembersRequest) ([]*entities.OrganizationMember, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ListOrganizationMembersRequest) []*entities.OrganizationMember); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrganizationMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ListOrganizationMembersRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListOrganizationMembersService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockListOrganizationMembersService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.ListOrganizationMembersRequest
+func (_e *MockListOrganizationMembersService_Expecter) Exec(ctx interface{}, request interface{}) *MockListOrganizationMembersService_Exec_Call {
+	return &MockListOrganizationMembersService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockListOrganizationMembersService_Exec_Call) Run(run func(ctx context.Context, request *models.ListOrganizationMembersRequest)) *MockListOrganizationMembersService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ListOrganizationMembersRequest))
+	})
+	return _c
+}
+
+func (_c *MockListOrganizationMembersService_Exec_Call) Return(_a0 []*entities.OrganizationMember, _a1 error) *MockListOrganizationMembersService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListOrganizationMembersService_Exec_Call) RunAndReturn(run func(context.Context, *models.ListOrganizationMembersRequest) ([]*entities.OrganizationMember, error)) *MockListOrganizationMembersService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListOrganizationMembersService creates a new instance of MockListOrganizationMembersService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListOrganizationMembersService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListOrganizationMembersService {
+	mock := &MockListOrganizationMembersService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
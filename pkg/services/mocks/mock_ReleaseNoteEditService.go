@@ -0,0 +1,87 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockReleaseNoteEditService is an autogenerated mock type for the ReleaseNoteEditService type
+type MockReleaseNoteEditService struct {
+	mock.Mock
+}
+
+type MockReleaseNoteEditService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReleaseNoteEditService) EXPECT() *MockReleaseNoteEditService_Expecter {
+	return &MockReleaseNoteEditService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request, now
+func (_m *MockReleaseNoteEditService) Exec(ctx context.Context, request *models.ReleaseNoteEditRequest, now time.Time) error {
+	ret := _m.Called(ctx, request, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ReleaseNoteEditRequest, time.Time) error); ok {
+		r0 = rf(ctx, request, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReleaseNoteEditService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockReleaseNoteEditService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.ReleaseNoteEditRequest
+//   - now time.Time
+func (_e *MockReleaseNoteEditService_Expecter) Exec(ctx interface{}, request interface{}, now interface{}) *MockReleaseNoteEditService_Exec_Call {
+	return &MockReleaseNoteEditService_Exec_Call{Call: _e.mock.On("Exec", ctx, request, now)}
+}
+
+func (_c *MockReleaseNoteEditService_Exec_Call) Run(run func(ctx context.Context, request *models.ReleaseNoteEditRequest, now time.Time)) *MockReleaseNoteEditService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ReleaseNoteEditRequest), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockReleaseNoteEditService_Exec_Call) Return(_a0 error) *MockReleaseNoteEditService_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReleaseNoteEditService_Exec_Call) RunAndReturn(run func(context.Context, *models.ReleaseNoteEditRequest, time.Time) error) *MockReleaseNoteEditService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReleaseNoteEditService creates a new instance of MockReleaseNoteEditService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReleaseNoteEditService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReleaseNoteEditService {
+	mock := &MockReleaseNoteEditService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
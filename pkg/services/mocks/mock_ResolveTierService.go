@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockResolveTierService is an autogenerated mock type for the ResolveTierService type
+type MockResolveTierService struct {
+	mock.Mock
+}
+
+type MockResolveTierService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockResolveTierService) EXPECT() *MockResolveTierService_Expecter {
+	return &MockResolveTierService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, authorID, now
+func (_m *MockResolveTierService) Exec(ctx context.Context, authorID string, now time.Time) (*models.ResolvedTier, error) {
+	ret := _m.Called(ctx, authorID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *models.ResolvedTier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (*models.ResolvedTier, error)); ok {
+		return rf(ctx, authorID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) *models.ResolvedTier); ok {
+		r0 = rf(ctx, authorID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResolvedTier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, authorID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockResolveTierService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockResolveTierService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID string
+//   - now time.Time
+func (_e *MockResolveTierService_Expecter) Exec(ctx interface{}, authorID interface{}, now interface{}) *MockResolveTierService_Exec_Call {
+	return &MockResolveTierService_Exec_Call{Call: _e.mock.On("Exec", ctx, authorID, now)}
+}
+
+func (_c *MockResolveTierService_Exec_Call) Run(run func(ctx context.Context, authorID string, now time.Time)) *MockResolveTierService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockResolveTierService_Exec_Call) Return(_a0 *models.ResolvedTier, _a1 error) *MockResolveTierService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockResolveTierService_Exec_Call) RunAndReturn(run func(context.Context, string, time.Time) (*models.ResolvedTier, error)) *MockResolveTierService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockResolveTierService creates a new instance of MockResolveTierService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockResolveTierService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockResolveTierService {
+	mock := &MockResolveTierService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
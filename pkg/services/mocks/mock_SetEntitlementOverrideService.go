@@ -0,0 +1,101 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+
+	time "time"
+)
+
+// MockSetEntitlementOverrideService is an autogenerated mock type for the SetEntitlementOverrideService type
+type MockSetEntitlementOverrideService struct {
+	mock.Mock
+}
+
+type MockSetEntitlementOverrideService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSetEntitlementOverrideService) EXPECT() *MockSetEntitlementOverrideService_Expecter {
+	return &MockSetEntitlementOverrideService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request, now
+func (_m *MockSetEntitlementOverrideService) Exec(ctx context.Context, request *models.SetEntitlementOverrideRequest, now time.Time) (*entities.EntitlementOverride, error) {
+	ret := _m.Called(ctx, request, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *entities.EntitlementOverride
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SetEntitlementOverrideRequest, time.Time) (*entities.EntitlementOverride, error)); ok {
+		return rf(ctx, request, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SetEntitlementOverrideRequest, time.Time) *entities.EntitlementOverride); ok {
+		r0 = rf(ctx, request, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.EntitlementOverride)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.SetEntitlementOverrideRequest, time.Time) error); ok {
+		r1 = rf(ctx, request, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSetEntitlementOverrideService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSetEntitlementOverrideService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.SetEntitlementOverrideRequest
+//   - now time.Time
+func (_e *MockSetEntitlementOverrideService_Expecter) Exec(ctx interface{}, request interface{}, now interface{}) *MockSetEntitlementOverrideService_Exec_Call {
+	return &MockSetEntitlementOverrideService_Exec_Call{Call: _e.mock.On("Exec", ctx, request, now)}
+}
+
+func (_c *MockSetEntitlementOverrideService_Exec_Call) Run(run func(ctx context.Context, request *models.SetEntitlementOverrideRequest, now time.Time)) *MockSetEntitlementOverrideService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.SetEntitlementOverrideRequest), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSetEntitlementOverrideService_Exec_Call) Return(_a0 *entities.EntitlementOverride, _a1 error) *MockSetEntitlementOverrideService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSetEntitlementOverrideService_Exec_Call) RunAndReturn(run func(context.Context, *models.SetEntitlementOverrideRequest, time.Time) (*entities.EntitlementOverride, error)) *MockSetEntitlementOverrideService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSetEntitlementOverrideService creates a new instance of MockSetEntitlementOverrideService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSetEntitlementOverrideService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSetEntitlementOverrideService {
+	mock := &MockSetEntitlementOverrideService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+)
+
+// MockSetOrganizationMemberService is an autogenerated mock type for the SetOrganizationMemberService type
+type MockSetOrganizationMemberService struct {
+	mock.Mock
+}
+
+type MockSetOrganizationMemberService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSetOrganizationMemberService) EXPECT() *MockSetOrganizationMemberService_Expecter {
+	return &MockSetOrganizationMemberService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request, organizationTier
+func (_m *MockSetOrganizationMemberService) Exec(ctx context.Context, request *models.SetOrganizationMemberRequest, organizationTier *models.ResolvedTier) (*entities.OrganizationMember, error) {
+	ret := _m.Called(ctx, request, organizationTier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *entities.OrganizationMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SetOrganizationMemberRequest, *models.ResolvedTier) (*entities.OrganizationMember, error)); ok {
+		return rf(ctx, request, organizationTier)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SetOrganizationMemberRequest, *models.ResolvedTier) *entities.OrganizationMember); ok {
+		r0 = rf(ctx, request, organizationTier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrganizationMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.SetOrganizationMemberRequest, *models.ResolvedTier) error); ok {
+		r1 = rf(ctx, request, organizationTier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSetOrganizationMemberService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSetOrganizationMemberService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.SetOrganizationMemberRequest
+//   - organizationTier *models.ResolvedTier
+func (_e *MockSetOrganizationMemberService_Expecter) Exec(ctx interface{}, request interface{}, organizationTier interface{}) *MockSetOrganizationMemberService_Exec_Call {
+	return &MockSetOrganizationMemberService_Exec_Call{Call: _e.mock.On("Exec", ctx, request, organizationTier)}
+}
+
+func (_c *MockSetOrganizationMemberService_Exec_Call) Run(run func(ctx context.Context, request *models.SetOrganizationMemberRequest, organizationTier *models.ResolvedTier)) *MockSetOrganizationMemberService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.SetOrganizationMemberRequest), args[2].(*models.ResolvedTier))
+	})
+	return _c
+}
+
+func (_c *MockSetOrganizationMemberService_Exec_Call) Return(_a0 *entities.OrganizationMember, _a1 error) *MockSetOrganizationMemberService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSetOrganizationMemberService_Exec_Call) RunAndReturn(run func(context.Context, *models.SetOrganizationMemberRequest, *models.ResolvedTier) (*entities.OrganizationMember, error)) *MockSetOrganizationMemberService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSetOrganizationMemberService creates a new instance of MockSetOrganizationMemberService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSetOrganizationMemberService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSetOrganizationMemberService {
+	mock := &MockSetOrganizationMemberService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
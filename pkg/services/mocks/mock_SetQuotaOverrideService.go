@@ -0,0 +1,101 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package servicesmocks
+
+import (
+	context "context"
+
+	entities "github.com/in-rich/uservice-subscription/pkg/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/in-rich/uservice-subscription/pkg/models"
+
+	time "time"
+)
+
+// MockSetQuotaOverrideService is an autogenerated mock type for the SetQuotaOverrideService type
+type MockSetQuotaOverrideService struct {
+	mock.Mock
+}
+
+type MockSetQuotaOverrideService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSetQuotaOverrideService) EXPECT() *MockSetQuotaOverrideService_Expecter {
+	return &MockSetQuotaOverrideService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function with given fields: ctx, request, now
+func (_m *MockSetQuotaOverrideService) Exec(ctx context.Context, request *models.SetQuotaOverrideRequest, now time.Time) (*entities.QuotaOverride, error) {
+	ret := _m.Called(ctx, request, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *entities.QuotaOverride
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SetQuotaOverrideRequest, time.Time) (*entities.QuotaOverride, error)); ok {
+		return rf(ctx, request, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SetQuotaOverrideRequest, time.Time) *entities.QuotaOverride); ok {
+		r0 = rf(ctx, request, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.QuotaOverride)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.SetQuotaOverrideRequest, time.Time) error); ok {
+		r1 = rf(ctx, request, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSetQuotaOverrideService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSetQuotaOverrideService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *models.SetQuotaOverrideRequest
+//   - now time.Time
+func (_e *MockSetQuotaOverrideService_Expecter) Exec(ctx interface{}, request interface{}, now interface{}) *MockSetQuotaOverrideService_Exec_Call {
+	return &MockSetQuotaOverrideService_Exec_Call{Call: _e.mock.On("Exec", ctx, request, now)}
+}
+
+func (_c *MockSetQuotaOverrideService_Exec_Call) Run(run func(ctx context.Context, request *models.SetQuotaOverrideRequest, now time.Time)) *MockSetQuotaOverrideService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.SetQuotaOverrideRequest), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSetQuotaOverrideService_Exec_Call) Return(_a0 *entities.QuotaOverride, _a1 error) *MockSetQuotaOverrideService_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSetQuotaOverrideService_Exec_Call) RunAndReturn(run func(context.Context, *models.SetQuotaOverrideRequest, time.Time) (*entities.QuotaOverride, error)) *MockSetQuotaOverrideService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSetQuotaOverrideService creates a new instance of MockSetQuotaOverrideService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSetQuotaOverrideService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSetQuotaOverrideService {
+	mock := &MockSetQuotaOverrideService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/samber/lo"
+	"time"
+)
+
+// remainingUses returns the number of uses left in a quota.
+func remainingUses(maxUses, used int) int {
+	// Avoid discrepancies if the limit has been overflowed.
+	return lo.Max([]int{maxUses - used, 0})
+}
+
+// remainingPooledUses returns the number of note edits left to a member of an organization: the edits left in the pool
+// of the organization, capped by the sub-limit of the member, if any.
+func remainingPooledUses(tier *models.ResolvedTier, count *dao.OrganizationNoteEditsCount) int {
+	remaining := remainingUses(tier.NoteEdits().MaxUses, count.Total)
+	if tier.MemberMaxEdits != nil {
+		remaining = min(remaining, remainingUses(*tier.MemberMaxEdits, count.Member))
+	}
+
+	return remaining
+}
+
+// applyNoteQuotaOverride returns the tier the note edits of an author are checked against, with the active quota
+// override of the author, if any, applied on top of it. Quota overrides only apply to individual quotas.
+func applyNoteQuotaOverride(
+	ctx context.Context,
+	getQuotaOverrideRepository dao.GetQuotaOverrideByAuthorRepository,
+	author string,
+	tier *models.ResolvedTier,
+	now time.Time,
+) (*models.ResolvedTier, error) {
+	if tier.OrganizationID != nil {
+		return tier, nil
+	}
+
+	tierInformation, err := applyQuotaOverride(ctx, getQuotaOverrideRepository, author, tier.TierInformation, now)
+	if err != nil {
+		return nil, err
+	}
+
+	overridden := *tier
+	overridden.TierInformation = tierInformation
+
+	return &overridden, nil
+}
+
+// countRemainingNoteEdits returns the number of note edits left to an author in the current window. Members of an
+// organization draw their edits from the pool of the organization. The tier must already have the quota override of
+// the author applied.
+func countRemainingNoteEdits(
+	ctx context.Context,
+	countEditsRepository dao.CountNoteEditsByAuthorRepository,
+	countOrganizationEditsRepository dao.CountNoteEditsByOrganizationRepository,
+	author string,
+	tier *models.ResolvedTier,
+	now time.Time,
+) (int, error) {
+	editsSince, _ := tier.NoteEdits().CountWindow(now, tier.BillingPeriodStart, tier.BillingPeriodEnd)
+
+	if tier.OrganizationID != nil {
+		editsCount, err := countOrganizationEditsRepository.CountNoteEditsByOrganization(
+			ctx, *tier.OrganizationID, author, &editsSince, now,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("count organization note edits: %w", err)
+		}
+
+		return remainingPooledUses(tier, editsCount), nil
+	}
+
+	editsCount, err := countEditsRepository.CountNoteEditsByAuthor(ctx, author, &editsSince, now)
+	if err != nil {
+		return 0, fmt.Errorf("count note edits: %w", err)
+	}
+
+	return remainingUses(tier.NoteEdits().MaxUses, editsCount), nil
+}
+
+// noteSessionActivity returns the time the edit session of a note is measured from, given the latest edit of the
+// note, if any.
+func noteSessionActivity(latestEdit *entities.NoteEdit, notes config.NoteTierInformation) *time.Time {
+	if latestEdit == nil {
+		return nil
+	}
+
+	// Sliding sessions are measured from the last activity on the note.
+	if notes.SlidingEditSession && latestEdit.LastActivityAt != nil {
+		return latestEdit.LastActivityAt
+	}
+
+	return latestEdit.CreatedAt
+}
+
+// withinBuffer reports whether a key last used at latestUse can be used again, without it counting as a new use.
+func withinBuffer(latestUse *time.Time, bufferTime time.Duration, now time.Time) bool {
+	return latestUse != nil && latestUse.After(now.UTC().Add(-bufferTime))
+}
+
+// consumeQuota records a new use of a quota, unless the same key was last used within the buffer time. latestUse is
+// the time the key was last used, if ever. It returns the number of uses left once the use is recorded.
+//
+// It must run in a transaction that holds the lock of the quota, so parallel requests cannot overdraw it.
+func consumeQuota(
+	remaining int,
+	latestUse *time.Time,
+	bufferTime time.Duration,
+	now time.Time,
+	exhaustedErr error,
+	record func() error,
+) (int, error) {
+	// Use is recent, nothing to do.
+	if withinBuffer(latestUse, bufferTime, now) {
+		return remaining, nil
+	}
+
+	// No more uses remaining, throw.
+	if remaining == 0 {
+		return 0, exhaustedErr
+	}
+
+	if err := record(); err != nil {
+		return 0, err
+	}
+
+	return remaining - 1, nil
+}
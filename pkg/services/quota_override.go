@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/samber/lo"
+	"math"
+	"time"
+)
+
+// UnlimitedEdits is the limit applied to authors whose quota has been disabled by an override.
+const UnlimitedEdits = math.MaxInt32
+
+// applyQuotaOverride returns the tier with the active quota override of the author, if any, applied on top of it.
+func applyQuotaOverride(
+	ctx context.Context,
+	getQuotaOverrideRepository dao.GetQuotaOverrideByAuthorRepository,
+	author string,
+	tier config.TierInformation,
+	now time.Time,
+) (config.TierInformation, error) {
+	override, err := getQuotaOverrideRepository.GetQuotaOverrideByAuthor(ctx, author)
+	if err != nil {
+		if errors.Is(err, dao.ErrQuotaOverrideNotFound) {
+			return tier, nil
+		}
+
+		return config.TierInformation{}, fmt.Errorf("get quota override: %w", err)
+	}
+
+	if !override.IsActive(now) {
+		return tier, nil
+	}
+
+	noteEdits := tier.NoteEdits()
+
+	switch override.Kind {
+	case entities.QuotaOverrideKindAdd:
+		noteEdits.MaxUses = min(noteEdits.MaxUses+override.MaxEdits, UnlimitedEdits)
+	case entities.QuotaOverrideKindReplace:
+		noteEdits.MaxUses = override.MaxEdits
+	case entities.QuotaOverrideKindDisable:
+		noteEdits.MaxUses = UnlimitedEdits
+		// The caps on distinct notes are lifted as well.
+		tier.Notes.MaxNotes = nil
+		tier.Notes.MaxNotesPerTarget = nil
+	}
+
+	// Copy the features, so the override doesn't leak into the tier configuration.
+	tier.Features = lo.Assign(tier.Features, map[string]config.FeatureTierInformation{config.NoteEditsFeature: noteEdits})
+
+	return tier, nil
+}
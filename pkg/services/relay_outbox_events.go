@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"time"
+)
+
+// RelayOutboxEventsService publishes the events waiting in the outbox, by sequence, and marks them as published. It
+// returns the number of published events.
+//
+// Delivery is at least once: events are published while the outbox transaction is open, so a failure to commit
+// publishes them again on the next run. The sequence is assigned on insert, not on commit, so an event written by a
+// slow transaction may still follow a later one. Consumers deduplicate on the message ID and must not rely on a
+// strict order.
+type RelayOutboxEventsService interface {
+	Exec(ctx context.Context, now time.Time) (int, error)
+}
+
+type relayOutboxEventsServiceImpl struct {
+	lockEventsRepository          dao.LockOutboxEventsRepository
+	listPendingEventsRepository   dao.ListPendingOutboxEventsRepository
+	markEventsPublishedRepository dao.MarkOutboxEventsPublishedRepository
+	runInTransactionRepository    dao.RunInTransactionRepository
+
+	publisher events.Publisher
+	batchSize int
+}
+
+func (s *relayOutboxEventsServiceImpl) Exec(ctx context.Context, now time.Time) (int, error) {
+	total := 0
+
+	for {
+		var pending []*entities.OutboxEvent
+		var publishErr error
+
+		published := make([]uuid.UUID, 0)
+
+		// A single relay reads the outbox at a time. Other instances wait for the lock, then find the events already
+		// marked, so they neither publish them twice nor publish later events ahead of them.
+		err := s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+			if err := s.lockEventsRepository.LockOutboxEvents(ctx); err != nil {
+				return fmt.Errorf("lock outbox events: %w", err)
+			}
+
+			var err error
+
+			pending, err = s.listPendingEventsRepository.ListPendingOutboxEvents(ctx, s.batchSize)
+			if err != nil {
+				return fmt.Errorf("list pending outbox events: %w", err)
+			}
+
+			for _, event := range pending {
+				publishErr = s.publisher.Publish(ctx, &events.Message{
+					ID:        *event.ID,
+					Name:      event.Name,
+					Payload:   event.Payload,
+					CreatedAt: *event.CreatedAt,
+				})
+				// Stop at the first failure, so the events after it are not published ahead of it.
+				if publishErr != nil {
+					break
+				}
+
+				published = append(published, *event.ID)
+			}
+
+			// Events published before a failure are still marked, so they are not published again on the next run.
+			if err := s.markEventsPublishedRepository.MarkOutboxEventsPublished(ctx, published, now); err != nil {
+				return fmt.Errorf("mark outbox events published: %w", err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += len(published)
+
+		if publishErr != nil {
+			return total, fmt.Errorf("publish outbox event: %w", publishErr)
+		}
+
+		if len(pending) < s.batchSize {
+			return total, nil
+		}
+	}
+}
+
+func NewRelayOutboxEventsService(
+	lockEventsRepository dao.LockOutboxEventsRepository,
+	listPendingEventsRepository dao.ListPendingOutboxEventsRepository,
+	markEventsPublishedRepository dao.MarkOutboxEventsPublishedRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+	publisher events.Publisher,
+	batchSize int,
+) RelayOutboxEventsService {
+	return &relayOutboxEventsServiceImpl{
+		lockEventsRepository:          lockEventsRepository,
+		listPendingEventsRepository:   listPendingEventsRepository,
+		markEventsPublishedRepository: markEventsPublishedRepository,
+		runInTransactionRepository:    runInTransactionRepository,
+		publisher:                     publisher,
+		batchSize:                     batchSize,
+	}
+}
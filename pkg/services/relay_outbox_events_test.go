@@ -0,0 +1,188 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	eventsmocks "github.com/in-rich/uservice-subscription/pkg/events/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRelayOutboxEvents(t *testing.T) {
+	now := time.Date(2021, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	outboxEvent := func(id string) *entities.OutboxEvent {
+		return &entities.OutboxEvent{
+			ID:        lo.ToPtr(uuid.MustParse(id)),
+			Name:      events.SubscriptionChangedEventName,
+			Payload:   json.RawMessage(`{"userID":"user-id-1"}`),
+			CreatedAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+		}
+	}
+
+	event1 := outboxEvent("00000000-0000-0000-0000-000000000001")
+	event2 := outboxEvent("00000000-0000-0000-0000-000000000002")
+	event3 := outboxEvent("00000000-0000-0000-0000-000000000003")
+
+	testData := []struct {
+		name string
+
+		// One response per batch.
+		listPendingResponses [][]*entities.OutboxEvent
+		listPendingErr       error
+
+		lockErr error
+
+		// Events handed to the publisher, in order. The publisher fails on the last one if publishErr is set.
+		expectPublished []*entities.OutboxEvent
+		publishErr      error
+
+		// Events marked as published, per batch.
+		expectMarked [][]uuid.UUID
+		markErr      error
+
+		expect    int
+		expectErr error
+	}{
+		// Success cases.
+		{
+			name:                 "RelayOutboxEvents",
+			listPendingResponses: [][]*entities.OutboxEvent{{event1}},
+			expectPublished:      []*entities.OutboxEvent{event1},
+			expectMarked:         [][]uuid.UUID{{*event1.ID}},
+			expect:               1,
+		},
+		{
+			name: "RelayOutboxEvents/MultipleBatches",
+			listPendingResponses: [][]*entities.OutboxEvent{
+				{event1, event2},
+				{event3},
+			},
+			expectPublished: []*entities.OutboxEvent{event1, event2, event3},
+			expectMarked:    [][]uuid.UUID{{*event1.ID, *event2.ID}, {*event3.ID}},
+			expect:          3,
+		},
+		{
+			name:                 "RelayOutboxEvents/NoPendingEvents",
+			listPendingResponses: [][]*entities.OutboxEvent{{}},
+			expectMarked:         [][]uuid.UUID{{}},
+		},
+
+		// Dependency error cases.
+		{
+			name:                 "PublishError",
+			listPendingResponses: [][]*entities.OutboxEvent{{event1, event2}},
+			expectPublished:      []*entities.OutboxEvent{event1, event2},
+			publishErr:           FooErr,
+			// The events published before the failure are still marked.
+			expectMarked: [][]uuid.UUID{{*event1.ID}},
+			expect:       1,
+			expectErr:    FooErr,
+		},
+		{
+			name:                 "MarkPublishedError",
+			listPendingResponses: [][]*entities.OutboxEvent{{event1}},
+			expectPublished:      []*entities.OutboxEvent{event1},
+			expectMarked:         [][]uuid.UUID{{*event1.ID}},
+			markErr:              FooErr,
+			expectErr:            FooErr,
+		},
+		{
+			name:                 "LockError",
+			listPendingResponses: [][]*entities.OutboxEvent{nil},
+			lockErr:              FooErr,
+			expectErr:            FooErr,
+		},
+		{
+			name:                 "ListPendingError",
+			listPendingResponses: [][]*entities.OutboxEvent{nil},
+			listPendingErr:       FooErr,
+			expectErr:            FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			lockRepository := daomocks.NewMockLockOutboxEventsRepository(t)
+			listPendingRepository := daomocks.NewMockListPendingOutboxEventsRepository(t)
+			markPublishedRepository := daomocks.NewMockMarkOutboxEventsPublishedRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+			publisher := eventsmocks.NewMockPublisher(t)
+
+			runInTransactionRepository.
+				On("RunInTransaction", context.TODO(), mock.Anything).
+				Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).
+				Times(len(tt.listPendingResponses))
+
+			lockRepository.
+				On("LockOutboxEvents", context.TODO()).
+				Return(tt.lockErr).
+				Times(len(tt.listPendingResponses))
+
+			for _, response := range tt.listPendingResponses {
+				if tt.lockErr != nil {
+					break
+				}
+
+				listPendingRepository.
+					On("ListPendingOutboxEvents", context.TODO(), 2).
+					Return(response, tt.listPendingErr).
+					Once()
+			}
+
+			for i, event := range tt.expectPublished {
+				var err error
+				if i == len(tt.expectPublished)-1 {
+					err = tt.publishErr
+				}
+
+				publisher.
+					On("Publish", context.TODO(), &events.Message{
+						ID:        *event.ID,
+						Name:      event.Name,
+						Payload:   event.Payload,
+						CreatedAt: *event.CreatedAt,
+					}).
+					Return(err).
+					Once()
+			}
+
+			for _, ids := range tt.expectMarked {
+				markPublishedRepository.
+					On("MarkOutboxEventsPublished", context.TODO(), ids, now).
+					Return(tt.markErr).
+					Once()
+			}
+
+			service := services.NewRelayOutboxEventsService(
+				lockRepository,
+				listPendingRepository,
+				markPublishedRepository,
+				runInTransactionRepository,
+				publisher,
+				2,
+			)
+
+			count, err := service.Exec(context.TODO(), now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, count)
+
+			lockRepository.AssertExpectations(t)
+			listPendingRepository.AssertExpectations(t)
+			markPublishedRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+			publisher.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"time"
+)
+
+// ReleaseNoteEditService gives back an edit counted by CanUpdateNote, when the note it was counted for failed to
+// update, and emits a NoteEditReleased event.
+type ReleaseNoteEditService interface {
+	Exec(ctx context.Context, request *models.ReleaseNoteEditRequest, now time.Time) error
+}
+
+type releaseNoteEditServiceImpl struct {
+	voidNoteEditRepository     dao.VoidNoteEditRepository
+	runInTransactionRepository dao.RunInTransactionRepository
+
+	emitter events.Emitter
+}
+
+func (s *releaseNoteEditServiceImpl) Exec(ctx context.Context, request *models.ReleaseNoteEditRequest, now time.Time) error {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return errors.Join(ErrInvalidRequest, err)
+	}
+
+	return s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+		noteEdit, err := s.voidNoteEditRepository.VoidNoteEdit(ctx, uuid.MustParse(request.NoteEditID), request.AuthorID, now)
+		if err != nil {
+			if errors.Is(err, dao.ErrNoNoteEditFound) {
+				return ErrNoteEditNotFound
+			}
+
+			return fmt.Errorf("void note edit: %w", err)
+		}
+
+		event := &events.NoteEditReleased{
+			NoteEditID:     *noteEdit.ID,
+			AuthorID:       noteEdit.AuthorID,
+			OrganizationID: noteEdit.OrganizationID,
+			Reason:         events.NoteEditReleasedReasonVoided,
+			ReleasedAt:     noteEdit.VoidedAt.UTC(),
+		}
+		if err := s.emitter.Emit(ctx, event); err != nil {
+			return fmt.Errorf("emit note edit released: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func NewReleaseNoteEditService(
+	voidNoteEditRepository dao.VoidNoteEditRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+	emitter events.Emitter,
+) ReleaseNoteEditService {
+	return &releaseNoteEditServiceImpl{
+		voidNoteEditRepository:     voidNoteEditRepository,
+		runInTransactionRepository: runInTransactionRepository,
+		emitter:                    emitter,
+	}
+}
@@ -0,0 +1,149 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	eventsmocks "github.com/in-rich/uservice-subscription/pkg/events/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestReleaseNoteEdit(t *testing.T) {
+	noteEditID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	organizationID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	now := time.Date(2021, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	voidedNoteEdit := &entities.NoteEdit{
+		ID:             &noteEditID,
+		AuthorID:       "author-id-1",
+		OrganizationID: &organizationID,
+		VoidedAt:       &now,
+	}
+
+	testData := []struct {
+		name string
+
+		request *models.ReleaseNoteEditRequest
+
+		shouldCallVoid bool
+		voidResponse   *entities.NoteEdit
+		voidErr        error
+
+		expectEvent *events.NoteEditReleased
+		emitErr     error
+
+		expectErr error
+	}{
+		{
+			name: "ReleaseNoteEdit",
+			request: &models.ReleaseNoteEditRequest{
+				NoteEditID: noteEditID.String(),
+				AuthorID:   "author-id-1",
+			},
+			shouldCallVoid: true,
+			voidResponse:   voidedNoteEdit,
+			expectEvent: &events.NoteEditReleased{
+				NoteEditID:     noteEditID,
+				AuthorID:       "author-id-1",
+				OrganizationID: &organizationID,
+				Reason:         events.NoteEditReleasedReasonVoided,
+				ReleasedAt:     time.Date(2021, 1, 20, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "ReleaseNoteEdit/NotFound",
+			request: &models.ReleaseNoteEditRequest{
+				NoteEditID: noteEditID.String(),
+				AuthorID:   "author-id-1",
+			},
+			shouldCallVoid: true,
+			voidErr:        dao.ErrNoNoteEditFound,
+			expectErr:      services.ErrNoteEditNotFound,
+		},
+		{
+			name: "ReleaseNoteEdit/InvalidNoteEditID",
+			request: &models.ReleaseNoteEditRequest{
+				NoteEditID: "note-edit-id-1",
+				AuthorID:   "author-id-1",
+			},
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "ReleaseNoteEdit/NoAuthor",
+			request: &models.ReleaseNoteEditRequest{
+				NoteEditID: noteEditID.String(),
+			},
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "VoidNoteEditError",
+			request: &models.ReleaseNoteEditRequest{
+				NoteEditID: noteEditID.String(),
+				AuthorID:   "author-id-1",
+			},
+			shouldCallVoid: true,
+			voidErr:        FooErr,
+			expectErr:      FooErr,
+		},
+		{
+			name: "EmitError",
+			request: &models.ReleaseNoteEditRequest{
+				NoteEditID: noteEditID.String(),
+				AuthorID:   "author-id-1",
+			},
+			shouldCallVoid: true,
+			voidResponse:   voidedNoteEdit,
+			expectEvent: &events.NoteEditReleased{
+				NoteEditID:     noteEditID,
+				AuthorID:       "author-id-1",
+				OrganizationID: &organizationID,
+				Reason:         events.NoteEditReleasedReasonVoided,
+				ReleasedAt:     time.Date(2021, 1, 20, 0, 0, 0, 0, time.UTC),
+			},
+			emitErr:   FooErr,
+			expectErr: FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			voidNoteEditRepository := daomocks.NewMockVoidNoteEditRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+			emitter := eventsmocks.NewMockEmitter(t)
+
+			if tt.shouldCallVoid {
+				runInTransactionRepository.
+					On("RunInTransaction", context.TODO(), mock.Anything).
+					Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				voidNoteEditRepository.
+					On("VoidNoteEdit", context.TODO(), noteEditID, tt.request.AuthorID, now).
+					Return(tt.voidResponse, tt.voidErr)
+			}
+
+			if tt.expectEvent != nil {
+				emitter.On("Emit", context.TODO(), tt.expectEvent).Return(tt.emitErr)
+			}
+
+			service := services.NewReleaseNoteEditService(voidNoteEditRepository, runInTransactionRepository, emitter)
+
+			err := service.Exec(context.TODO(), tt.request, now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			voidNoteEditRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+			emitter.AssertExpectations(t)
+		})
+	}
+}
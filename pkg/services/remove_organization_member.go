@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+)
+
+// RemoveOrganizationMemberService removes a user from an organization, freeing their seat. Edits they drew from the
+// pool keep counting against it until they leave the window.
+type RemoveOrganizationMemberService interface {
+	Exec(ctx context.Context, request *models.RemoveOrganizationMemberRequest) error
+}
+
+type removeOrganizationMemberServiceImpl struct {
+	deleteOrganizationMemberRepository dao.DeleteOrganizationMemberRepository
+	countOrganizationMembersRepository dao.CountOrganizationMembersRepository
+	lockOrganizationMembersRepository  dao.LockOrganizationMembersRepository
+	createSeatChangeRepository         dao.CreateSeatChangeRepository
+	runInTransactionRepository         dao.RunInTransactionRepository
+}
+
+func (s *removeOrganizationMemberServiceImpl) Exec(
+	ctx context.Context, request *models.RemoveOrganizationMemberRequest,
+) error {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return errors.Join(ErrInvalidRequest, err)
+	}
+
+	organizationID := uuid.MustParse(request.OrganizationID)
+
+	return s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := s.lockOrganizationMembersRepository.LockOrganizationMembers(ctx, organizationID); err != nil {
+			return fmt.Errorf("lock organization members: %w", err)
+		}
+
+		err := s.deleteOrganizationMemberRepository.DeleteOrganizationMember(ctx, organizationID, request.UserID)
+		if err != nil {
+			if errors.Is(err, dao.ErrOrganizationMemberNotFound) {
+				return ErrOrganizationMemberNotFound
+			}
+
+			return fmt.Errorf("delete organization member: %w", err)
+		}
+
+		seatsUsed, err := s.countOrganizationMembersRepository.CountOrganizationMembers(ctx, organizationID)
+		if err != nil {
+			return fmt.Errorf("count organization members: %w", err)
+		}
+
+		_, err = s.createSeatChangeRepository.CreateSeatChange(
+			ctx, organizationID, request.UserID, &dao.CreateSeatChangeData{
+				Kind:      entities.SeatChangeKindRemoved,
+				SeatsUsed: seatsUsed,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("create seat change: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func NewRemoveOrganizationMemberService(
+	deleteOrganizationMemberRepository dao.DeleteOrganizationMemberRepository,
+	countOrganizationMembersRepository dao.CountOrganizationMembersRepository,
+	lockOrganizationMembersRepository dao.LockOrganizationMembersRepository,
+	createSeatChangeRepository dao.CreateSeatChangeRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+) RemoveOrganizationMemberService {
+	return &removeOrganizationMemberServiceImpl{
+		deleteOrganizationMemberRepository: deleteOrganizationMemberRepository,
+		countOrganizationMembersRepository: countOrganizationMembersRepository,
+		lockOrganizationMembersRepository:  lockOrganizationMembersRepository,
+		createSeatChangeRepository:         createSeatChangeRepository,
+		runInTransactionRepository:         runInTransactionRepository,
+	}
+}
@@ -0,0 +1,189 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRemoveOrganizationMember(t *testing.T) {
+	organizationID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+
+	testData := []struct {
+		name string
+
+		request *models.RemoveOrganizationMemberRequest
+
+		shouldCallLock bool
+		lockErr        error
+
+		shouldCallDelete bool
+		deleteErr        error
+
+		shouldCallCount bool
+		countResponse   int
+		countErr        error
+
+		shouldCallCreateSeatChange bool
+		createSeatChangeData       *dao.CreateSeatChangeData
+		createSeatChangeErr        error
+
+		expectErr error
+	}{
+		// Success cases.
+		{
+			name: "RemoveOrganizationMember",
+			request: &models.RemoveOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			shouldCallLock:             true,
+			shouldCallDelete:           true,
+			shouldCallCount:            true,
+			countResponse:              1,
+			shouldCallCreateSeatChange: true,
+			createSeatChangeData: &dao.CreateSeatChangeData{
+				Kind:      entities.SeatChangeKindRemoved,
+				SeatsUsed: 1,
+			},
+		},
+
+		// Local error cases.
+		{
+			name: "RemoveOrganizationMember/NotFound",
+			request: &models.RemoveOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			shouldCallLock:   true,
+			shouldCallDelete: true,
+			deleteErr:        dao.ErrOrganizationMemberNotFound,
+			expectErr:        services.ErrOrganizationMemberNotFound,
+		},
+		{
+			name: "RemoveOrganizationMember/InvalidRequest",
+			request: &models.RemoveOrganizationMemberRequest{
+				UserID: "user-id-1",
+			},
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "LockOrganizationMembersError",
+			request: &models.RemoveOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			shouldCallLock: true,
+			lockErr:        FooErr,
+			expectErr:      FooErr,
+		},
+		{
+			name: "DeleteOrganizationMemberError",
+			request: &models.RemoveOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			shouldCallLock:   true,
+			shouldCallDelete: true,
+			deleteErr:        FooErr,
+			expectErr:        FooErr,
+		},
+		{
+			name: "CountOrganizationMembersError",
+			request: &models.RemoveOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			shouldCallLock:   true,
+			shouldCallDelete: true,
+			shouldCallCount:  true,
+			countErr:         FooErr,
+			expectErr:        FooErr,
+		},
+		{
+			name: "CreateSeatChangeError",
+			request: &models.RemoveOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			shouldCallLock:             true,
+			shouldCallDelete:           true,
+			shouldCallCount:            true,
+			countResponse:              1,
+			shouldCallCreateSeatChange: true,
+			createSeatChangeData: &dao.CreateSeatChangeData{
+				Kind:      entities.SeatChangeKindRemoved,
+				SeatsUsed: 1,
+			},
+			createSeatChangeErr: FooErr,
+			expectErr:           FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			deleteOrganizationMemberRepository := daomocks.NewMockDeleteOrganizationMemberRepository(t)
+			countOrganizationMembersRepository := daomocks.NewMockCountOrganizationMembersRepository(t)
+			lockOrganizationMembersRepository := daomocks.NewMockLockOrganizationMembersRepository(t)
+			createSeatChangeRepository := daomocks.NewMockCreateSeatChangeRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+
+			if tt.shouldCallLock {
+				runInTransactionRepository.
+					On("RunInTransaction", context.TODO(), mock.Anything).
+					Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				lockOrganizationMembersRepository.
+					On("LockOrganizationMembers", context.TODO(), organizationID).
+					Return(tt.lockErr)
+			}
+
+			if tt.shouldCallDelete {
+				deleteOrganizationMemberRepository.
+					On("DeleteOrganizationMember", context.TODO(), organizationID, tt.request.UserID).
+					Return(tt.deleteErr)
+			}
+
+			if tt.shouldCallCount {
+				countOrganizationMembersRepository.
+					On("CountOrganizationMembers", context.TODO(), organizationID).
+					Return(tt.countResponse, tt.countErr)
+			}
+
+			if tt.shouldCallCreateSeatChange {
+				createSeatChangeRepository.
+					On("CreateSeatChange", context.TODO(), organizationID, tt.request.UserID, tt.createSeatChangeData).
+					Return(&entities.SeatChange{}, tt.createSeatChangeErr)
+			}
+
+			service := services.NewRemoveOrganizationMemberService(
+				deleteOrganizationMemberRepository,
+				countOrganizationMembersRepository,
+				lockOrganizationMembersRepository,
+				createSeatChangeRepository,
+				runInTransactionRepository,
+			)
+
+			err := service.Exec(context.TODO(), tt.request)
+
+			require.ErrorIs(t, err, tt.expectErr)
+
+			deleteOrganizationMemberRepository.AssertExpectations(t)
+			countOrganizationMembersRepository.AssertExpectations(t)
+			lockOrganizationMembersRepository.AssertExpectations(t)
+			createSeatChangeRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+		})
+	}
+}
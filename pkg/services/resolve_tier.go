@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"time"
+)
+
+// ResolveTierService returns the tier that applies to a user at a given time.
+type ResolveTierService interface {
+	Exec(ctx context.Context, authorID string, now time.Time) (*models.ResolvedTier, error)
+}
+
+type resolveTierServiceImpl struct {
+	getSubscriptionRepository       dao.GetSubscriptionByUserRepository
+	getOrganizationMemberRepository dao.GetOrganizationMemberByUserRepository
+
+	tiers       map[string]config.TierInformation
+	defaultTier string
+}
+
+func (s *resolveTierServiceImpl) Exec(ctx context.Context, authorID string, now time.Time) (*models.ResolvedTier, error) {
+	member, err := s.getOrganizationMemberRepository.GetOrganizationMemberByUser(ctx, authorID)
+	if err != nil && !errors.Is(err, dao.ErrOrganizationMemberNotFound) {
+		return nil, fmt.Errorf("get organization member: %w", err)
+	}
+
+	// The subscription of the organization takes precedence over the one of the user, as long as it grants its tier.
+	if member != nil {
+		resolved, granted, err := s.resolveSubscription(ctx, entities.OrganizationSubscriberID(*member.OrganizationID), now)
+		if err != nil {
+			return nil, err
+		}
+
+		if granted {
+			resolved.OrganizationID = member.OrganizationID
+			resolved.MemberMaxEdits = member.MaxEdits
+			return resolved, nil
+		}
+	}
+
+	resolved, _, err := s.resolveSubscription(ctx, authorID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users without a running subscription are on the default tier.
+	if resolved == nil {
+		return s.resolve(s.defaultTier)
+	}
+
+	return resolved, nil
+}
+
+// resolveSubscription returns the tier that the subscription of a subscriber applies, or nil if the subscriber has no
+// running subscription. granted is false when an overdue subscription fell back to the default tier.
+func (s *resolveTierServiceImpl) resolveSubscription(
+	ctx context.Context, subscriberID string, now time.Time,
+) (*models.ResolvedTier, bool, error) {
+	subscription, err := s.getSubscriptionRepository.GetSubscriptionByUser(ctx, subscriberID)
+	if err != nil {
+		if errors.Is(err, dao.ErrSubscriptionNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, fmt.Errorf("get subscription: %w", err)
+	}
+
+	if !subscription.IsRunning(now) {
+		return nil, false, nil
+	}
+
+	resolved, err := s.resolve(subscription.Tier)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if subscription.IsPaymentOverdue() && !subscription.InGracePeriod(now, resolved.GracePeriod) {
+		if resolved, err = s.resolve(s.defaultTier); err != nil {
+			return nil, false, err
+		}
+
+		resolved.BillingWarning = true
+		return resolved, false, nil
+	}
+
+	resolved.BillingWarning = subscription.IsPaymentOverdue()
+	resolved.BillingPeriodStart = subscription.CurrentPeriodStart
+	resolved.BillingPeriodEnd = subscription.CurrentPeriodEnd
+
+	return resolved, true, nil
+}
+
+func (s *resolveTierServiceImpl) resolve(name string) (*models.ResolvedTier, error) {
+	tier, ok := s.tiers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownTier, name)
+	}
+
+	return &models.ResolvedTier{Name: name, TierInformation: tier}, nil
+}
+
+func NewResolveTierService(
+	getSubscriptionRepository dao.GetSubscriptionByUserRepository,
+	getOrganizationMemberRepository dao.GetOrganizationMemberByUserRepository,
+	tiers map[string]config.TierInformation,
+	defaultTier string,
+) ResolveTierService {
+	return &resolveTierServiceImpl{
+		getSubscriptionRepository:       getSubscriptionRepository,
+		getOrganizationMemberRepository: getOrganizationMemberRepository,
+		tiers:                           tiers,
+		defaultTier:                     defaultTier,
+	}
+}
@@ -0,0 +1,412 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestResolveTier(t *testing.T) {
+	freeTier := config.TierInformation{
+		Features: map[string]config.FeatureTierInformation{
+			config.NoteEditsFeature: {
+				CountUsesOver: lo.ToPtr(24 * time.Hour),
+				MaxUses:       5,
+			},
+		},
+	}
+	proTier := config.TierInformation{
+		Features: map[string]config.FeatureTierInformation{
+			config.NoteEditsFeature: {
+				CountUsesOver: lo.ToPtr(24 * time.Hour),
+				MaxUses:       50,
+			},
+		},
+		GracePeriod: 7 * 24 * time.Hour,
+	}
+	tiers := map[string]config.TierInformation{
+		"free": freeTier,
+		"pro":  proTier,
+	}
+
+	organizationID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+	organizationMember := &entities.OrganizationMember{
+		OrganizationID: &organizationID,
+		UserID:         "author-id-1",
+		MaxEdits:       lo.ToPtr(10),
+	}
+
+	testData := []struct {
+		name string
+
+		authorID string
+		now      time.Time
+
+		memberResponse *entities.OrganizationMember
+		memberErr      error
+
+		organizationSubscriptionResponse *entities.Subscription
+		organizationSubscriptionErr      error
+
+		subscriptionResponse *entities.Subscription
+		subscriptionErr      error
+
+		expect    *models.ResolvedTier
+		expectErr error
+	}{
+		{
+			name:     "ResolveTier/ActiveSubscription",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:    "author-id-1",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "pro", TierInformation: proTier},
+		},
+		{
+			name:     "ResolveTier/ActiveSubscription/NotEnded",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:    "author-id-1",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				EndsAt:    lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "pro", TierInformation: proTier},
+		},
+		{
+			name:     "ResolveTier/ActiveSubscription/BillingPeriod",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:             "author-id-1",
+				Tier:               "pro",
+				Status:             entities.SubscriptionStatusActive,
+				StartedAt:          lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodStart: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CurrentPeriodEnd:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{
+				Name:               "pro",
+				TierInformation:    proTier,
+				BillingPeriodStart: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				BillingPeriodEnd:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "ResolveTier/ActiveSubscription/Ended",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 2, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:    "author-id-1",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				EndsAt:    lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "free", TierInformation: freeTier},
+		},
+		{
+			name:     "ResolveTier/ActiveSubscription/NotStarted",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:    "author-id-1",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "free", TierInformation: freeTier},
+		},
+		{
+			name:     "ResolveTier/CanceledSubscription",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:    "author-id-1",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusCanceled,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "free", TierInformation: freeTier},
+		},
+		{
+			name:     "ResolveTier/TrialingSubscription",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 1, 14, 23, 59, 59, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:      "author-id-1",
+				Tier:        "pro",
+				Status:      entities.SubscriptionStatusTrialing,
+				StartedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "pro", TierInformation: proTier},
+		},
+		{
+			// The trial expired, but was not marked as expired yet.
+			name:     "ResolveTier/TrialingSubscription/Expired",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:      "author-id-1",
+				Tier:        "pro",
+				Status:      entities.SubscriptionStatusTrialing,
+				StartedAt:   lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "free", TierInformation: freeTier},
+		},
+		{
+			name:     "ResolveTier/TrialingSubscription/NoTrialEnd",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:    "author-id-1",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusTrialing,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "free", TierInformation: freeTier},
+		},
+		{
+			name:     "ResolveTier/PastDueSubscription/InGracePeriod",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 2, 7, 23, 59, 59, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:          "author-id-1",
+				Tier:            "pro",
+				Status:          entities.SubscriptionStatusPastDue,
+				StartedAt:       lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				PaymentFailedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "pro", TierInformation: proTier, BillingWarning: true},
+		},
+		{
+			name:     "ResolveTier/PastDueSubscription/GracePeriodEnded",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 2, 8, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:          "author-id-1",
+				Tier:            "pro",
+				Status:          entities.SubscriptionStatusPastDue,
+				StartedAt:       lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				PaymentFailedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "free", TierInformation: freeTier, BillingWarning: true},
+		},
+		{
+			name:     "ResolveTier/PastDueSubscription/NoPaymentFailure",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 2, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:    "author-id-1",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusPastDue,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "free", TierInformation: freeTier, BillingWarning: true},
+		},
+		{
+			name:     "ResolveTier/UnpaidSubscription/InGracePeriod",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 2, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:          "author-id-1",
+				Tier:            "pro",
+				Status:          entities.SubscriptionStatusUnpaid,
+				StartedAt:       lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				PaymentFailedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "pro", TierInformation: proTier, BillingWarning: true},
+		},
+		{
+			// Free tier has no grace period.
+			name:     "ResolveTier/PastDueSubscription/NoGracePeriod",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 2, 1, 0, 0, 1, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:          "author-id-1",
+				Tier:            "free",
+				Status:          entities.SubscriptionStatusPastDue,
+				StartedAt:       lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				PaymentFailedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "free", TierInformation: freeTier, BillingWarning: true},
+		},
+		{
+			name:     "ResolveTier/PastDueSubscription/Ended",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 2, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:          "author-id-1",
+				Tier:            "pro",
+				Status:          entities.SubscriptionStatusPastDue,
+				StartedAt:       lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				EndsAt:          lo.ToPtr(time.Date(2021, 2, 2, 0, 0, 0, 0, time.UTC)),
+				PaymentFailedAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "free", TierInformation: freeTier},
+		},
+		{
+			name:            "ResolveTier/NoSubscription",
+			authorID:        "author-id-1",
+			now:             time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionErr: dao.ErrSubscriptionNotFound,
+			expect:          &models.ResolvedTier{Name: "free", TierInformation: freeTier},
+		},
+		{
+			name:           "ResolveTier/Organization",
+			authorID:       "author-id-1",
+			now:            time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			memberResponse: organizationMember,
+			organizationSubscriptionResponse: &entities.Subscription{
+				UserID:    entities.OrganizationSubscriberID(organizationID),
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{
+				Name:            "pro",
+				TierInformation: proTier,
+				OrganizationID:  &organizationID,
+				MemberMaxEdits:  lo.ToPtr(10),
+			},
+		},
+		{
+			// The organization lost its tier, the member falls back to their own subscription.
+			name:           "ResolveTier/Organization/CanceledSubscription",
+			authorID:       "author-id-1",
+			now:            time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			memberResponse: organizationMember,
+			organizationSubscriptionResponse: &entities.Subscription{
+				UserID:    entities.OrganizationSubscriberID(organizationID),
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusCanceled,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			subscriptionResponse: &entities.Subscription{
+				UserID:    "author-id-1",
+				Tier:      "pro",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: &models.ResolvedTier{Name: "pro", TierInformation: proTier},
+		},
+		{
+			name:           "ResolveTier/Organization/GracePeriodEnded",
+			authorID:       "author-id-1",
+			now:            time.Date(2021, 1, 9, 0, 0, 0, 0, time.UTC),
+			memberResponse: organizationMember,
+			organizationSubscriptionResponse: &entities.Subscription{
+				UserID:          entities.OrganizationSubscriberID(organizationID),
+				Tier:            "pro",
+				Status:          entities.SubscriptionStatusPastDue,
+				StartedAt:       lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				PaymentFailedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			subscriptionErr: dao.ErrSubscriptionNotFound,
+			expect:          &models.ResolvedTier{Name: "free", TierInformation: freeTier},
+		},
+		{
+			name:                        "ResolveTier/Organization/NoSubscription",
+			authorID:                    "author-id-1",
+			now:                         time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			memberResponse:              organizationMember,
+			organizationSubscriptionErr: dao.ErrSubscriptionNotFound,
+			subscriptionErr:             dao.ErrSubscriptionNotFound,
+			expect:                      &models.ResolvedTier{Name: "free", TierInformation: freeTier},
+		},
+
+		// Local error cases.
+		{
+			name:     "ResolveTier/UnknownTier",
+			authorID: "author-id-1",
+			now:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionResponse: &entities.Subscription{
+				UserID:    "author-id-1",
+				Tier:      "legacy",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expectErr: services.ErrUnknownTier,
+		},
+
+		// Dependency error cases.
+		{
+			name:            "GetSubscriptionError",
+			authorID:        "author-id-1",
+			now:             time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			subscriptionErr: FooErr,
+			expectErr:       FooErr,
+		},
+		{
+			name:      "GetOrganizationMemberError",
+			authorID:  "author-id-1",
+			now:       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			memberErr: FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name:                        "GetOrganizationSubscriptionError",
+			authorID:                    "author-id-1",
+			now:                         time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			memberResponse:              organizationMember,
+			organizationSubscriptionErr: FooErr,
+			expectErr:                   FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			getSubscriptionRepository := daomocks.NewMockGetSubscriptionByUserRepository(t)
+			getOrganizationMemberRepository := daomocks.NewMockGetOrganizationMemberByUserRepository(t)
+
+			// Most cases are about users outside any organization.
+			memberErr := tt.memberErr
+			if tt.memberResponse == nil && memberErr == nil {
+				memberErr = dao.ErrOrganizationMemberNotFound
+			}
+
+			getOrganizationMemberRepository.
+				On("GetOrganizationMemberByUser", context.TODO(), tt.authorID).
+				Return(tt.memberResponse, memberErr)
+
+			if tt.organizationSubscriptionResponse != nil || tt.organizationSubscriptionErr != nil {
+				getSubscriptionRepository.
+					On("GetSubscriptionByUser", context.TODO(), entities.OrganizationSubscriberID(organizationID)).
+					Return(tt.organizationSubscriptionResponse, tt.organizationSubscriptionErr)
+			}
+
+			if tt.subscriptionResponse != nil || tt.subscriptionErr != nil {
+				getSubscriptionRepository.
+					On("GetSubscriptionByUser", context.TODO(), tt.authorID).
+					Return(tt.subscriptionResponse, tt.subscriptionErr)
+			}
+
+			service := services.NewResolveTierService(getSubscriptionRepository, getOrganizationMemberRepository, tiers, "free")
+
+			tier, err := service.Exec(context.TODO(), tt.authorID, tt.now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, tier)
+
+			getSubscriptionRepository.AssertExpectations(t)
+			getOrganizationMemberRepository.AssertExpectations(t)
+		})
+	}
+}
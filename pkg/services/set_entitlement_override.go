@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"time"
+)
+
+type SetEntitlementOverrideService interface {
+	Exec(ctx context.Context, request *models.SetEntitlementOverrideRequest, now time.Time) (*entities.EntitlementOverride, error)
+}
+
+type setEntitlementOverrideServiceImpl struct {
+	upsertEntitlementOverrideRepository dao.UpsertEntitlementOverrideRepository
+
+	// Every tier configures the same entitlements, so the entitlements of any tier describe them all.
+	entitlements map[string]config.Entitlement
+}
+
+func (s *setEntitlementOverrideServiceImpl) Exec(
+	ctx context.Context, request *models.SetEntitlementOverrideRequest, now time.Time,
+) (*entities.EntitlementOverride, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(now) {
+		return nil, errors.Join(ErrInvalidRequest, errors.New("expiration date must be in the future"))
+	}
+
+	entitlement, ok := s.entitlements[request.Entitlement]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEntitlement, request.Entitlement)
+	}
+
+	data := &dao.UpsertEntitlementOverrideData{
+		Enabled:   request.Enabled,
+		ExpiresAt: request.ExpiresAt,
+	}
+
+	if entitlement.IsNumeric() {
+		if request.Limit == nil {
+			return nil, errors.Join(ErrInvalidRequest, errors.New("limit is required for numeric entitlements"))
+		}
+
+		override := config.NumericEntitlement(*request.Limit)
+		data.Enabled = override.Enabled
+		data.Limit = override.Limit
+	} else if request.Limit != nil {
+		return nil, errors.Join(ErrInvalidRequest, errors.New("limit is not allowed for on/off entitlements"))
+	}
+
+	override, err := s.upsertEntitlementOverrideRepository.UpsertEntitlementOverride(
+		ctx, request.UserID, request.Entitlement, data,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("upsert entitlement override: %w", err)
+	}
+
+	return override, nil
+}
+
+func NewSetEntitlementOverrideService(
+	upsertEntitlementOverrideRepository dao.UpsertEntitlementOverrideRepository,
+	entitlements map[string]config.Entitlement,
+) SetEntitlementOverrideService {
+	return &setEntitlementOverrideServiceImpl{
+		upsertEntitlementOverrideRepository: upsertEntitlementOverrideRepository,
+		entitlements:                        entitlements,
+	}
+}
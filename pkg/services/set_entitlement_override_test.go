@@ -0,0 +1,218 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestSetEntitlementOverride(t *testing.T) {
+	entitlements := map[string]config.Entitlement{
+		"can-export-csv": {Enabled: false},
+		"max-team-seats": config.NumericEntitlement(1),
+	}
+
+	testData := []struct {
+		name string
+
+		request *models.SetEntitlementOverrideRequest
+		now     time.Time
+
+		shouldCallUpsert bool
+		upsertData       *dao.UpsertEntitlementOverrideData
+		upsertResponse   *entities.EntitlementOverride
+		upsertErr        error
+
+		expect    *entities.EntitlementOverride
+		expectErr error
+	}{
+		{
+			name: "SetEntitlementOverride",
+			request: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Enabled:     true,
+				ExpiresAt:   lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			now:              time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallUpsert: true,
+			upsertData: &dao.UpsertEntitlementOverrideData{
+				Enabled:   true,
+				ExpiresAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			upsertResponse: &entities.EntitlementOverride{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Enabled:     true,
+			},
+			expect: &entities.EntitlementOverride{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Enabled:     true,
+			},
+		},
+		{
+			name: "SetEntitlementOverride/Numeric",
+			request: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+				Limit:       lo.ToPtr(25),
+			},
+			now:              time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallUpsert: true,
+			upsertData: &dao.UpsertEntitlementOverrideData{
+				Enabled: true,
+				Limit:   lo.ToPtr(25),
+			},
+			upsertResponse: &entities.EntitlementOverride{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+				Enabled:     true,
+				Limit:       lo.ToPtr(25),
+			},
+			expect: &entities.EntitlementOverride{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+				Enabled:     true,
+				Limit:       lo.ToPtr(25),
+			},
+		},
+		{
+			name: "SetEntitlementOverride/Numeric/Zero",
+			request: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+				// Enabled is derived from the limit.
+				Enabled: true,
+				Limit:   lo.ToPtr(0),
+			},
+			now:              time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallUpsert: true,
+			upsertData: &dao.UpsertEntitlementOverrideData{
+				Enabled: false,
+				Limit:   lo.ToPtr(0),
+			},
+			upsertResponse: &entities.EntitlementOverride{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+				Limit:       lo.ToPtr(0),
+			},
+			expect: &entities.EntitlementOverride{
+				ID:          lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+				Limit:       lo.ToPtr(0),
+			},
+		},
+
+		// Local error cases.
+		{
+			name: "SetEntitlementOverride/UnknownEntitlement",
+			request: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "can-fly",
+				Enabled:     true,
+			},
+			now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectErr: services.ErrUnknownEntitlement,
+		},
+		{
+			name: "SetEntitlementOverride/Numeric/MissingLimit",
+			request: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+				Enabled:     true,
+			},
+			now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "SetEntitlementOverride/Boolean/UnexpectedLimit",
+			request: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Limit:       lo.ToPtr(10),
+			},
+			now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "SetEntitlementOverride/NegativeLimit",
+			request: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "max-team-seats",
+				Limit:       lo.ToPtr(-1),
+			},
+			now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "SetEntitlementOverride/ExpiresInThePast",
+			request: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Enabled:     true,
+				ExpiresAt:   lo.ToPtr(time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name:      "SetEntitlementOverride/InvalidRequest",
+			request:   &models.SetEntitlementOverrideRequest{},
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "UpsertEntitlementOverrideError",
+			request: &models.SetEntitlementOverrideRequest{
+				UserID:      "user-id-1",
+				Entitlement: "can-export-csv",
+				Enabled:     true,
+			},
+			now:              time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallUpsert: true,
+			upsertData: &dao.UpsertEntitlementOverrideData{
+				Enabled: true,
+			},
+			upsertErr: FooErr,
+			expectErr: FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			upsertEntitlementOverrideRepository := daomocks.NewMockUpsertEntitlementOverrideRepository(t)
+
+			if tt.shouldCallUpsert {
+				upsertEntitlementOverrideRepository.
+					On("UpsertEntitlementOverride", context.TODO(), tt.request.UserID, tt.request.Entitlement, tt.upsertData).
+					Return(tt.upsertResponse, tt.upsertErr)
+			}
+
+			service := services.NewSetEntitlementOverrideService(upsertEntitlementOverrideRepository, entitlements)
+
+			override, err := service.Exec(context.TODO(), tt.request, tt.now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, override)
+
+			upsertEntitlementOverrideRepository.AssertExpectations(t)
+		})
+	}
+}
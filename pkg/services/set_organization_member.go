@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+)
+
+// SetOrganizationMemberService adds a user to an organization, or updates their sub-limit if they already belong to
+// it. Adding a user takes one of the seats of the organization tier, and is recorded as a seat change.
+type SetOrganizationMemberService interface {
+	Exec(
+		ctx context.Context,
+		request *models.SetOrganizationMemberRequest,
+		organizationTier *models.ResolvedTier,
+	) (*entities.OrganizationMember, error)
+}
+
+type setOrganizationMemberServiceImpl struct {
+	getOrganizationRepository             dao.GetOrganizationRepository
+	getOrganizationMemberByUserRepository dao.GetOrganizationMemberByUserRepository
+	upsertOrganizationMemberRepository    dao.UpsertOrganizationMemberRepository
+	countOrganizationMembersRepository    dao.CountOrganizationMembersRepository
+	lockOrganizationMembersRepository     dao.LockOrganizationMembersRepository
+	createSeatChangeRepository            dao.CreateSeatChangeRepository
+	runInTransactionRepository            dao.RunInTransactionRepository
+}
+
+func (s *setOrganizationMemberServiceImpl) Exec(
+	ctx context.Context,
+	request *models.SetOrganizationMemberRequest,
+	organizationTier *models.ResolvedTier,
+) (*entities.OrganizationMember, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	organizationID := uuid.MustParse(request.OrganizationID)
+
+	if _, err := s.getOrganizationRepository.GetOrganization(ctx, organizationID); err != nil {
+		if errors.Is(err, dao.ErrOrganizationNotFound) {
+			return nil, ErrOrganizationNotFound
+		}
+
+		return nil, fmt.Errorf("get organization: %w", err)
+	}
+
+	var member *entities.OrganizationMember
+
+	// Check and take the seat atomically, so parallel requests cannot add more members than the tier allows.
+	err := s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := s.lockOrganizationMembersRepository.LockOrganizationMembers(ctx, organizationID); err != nil {
+			return fmt.Errorf("lock organization members: %w", err)
+		}
+
+		existing, err := s.getOrganizationMemberByUserRepository.GetOrganizationMemberByUser(ctx, request.UserID)
+		if err != nil && !errors.Is(err, dao.ErrOrganizationMemberNotFound) {
+			return fmt.Errorf("get organization member: %w", err)
+		}
+
+		// Updating the sub-limit of a member does not take a new seat.
+		if existing != nil && *existing.OrganizationID == organizationID {
+			member, err = s.upsertMember(ctx, organizationID, request)
+			return err
+		}
+
+		if existing != nil {
+			return ErrMemberOfAnotherOrganization
+		}
+
+		seatsUsed, err := s.countOrganizationMembersRepository.CountOrganizationMembers(ctx, organizationID)
+		if err != nil {
+			return fmt.Errorf("count organization members: %w", err)
+		}
+
+		if seatsUsed >= organizationTier.Seats {
+			return ErrNoSeatsAvailable
+		}
+
+		member, err = s.upsertMember(ctx, organizationID, request)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.createSeatChangeRepository.CreateSeatChange(
+			ctx, organizationID, request.UserID, &dao.CreateSeatChangeData{
+				Kind:      entities.SeatChangeKindAdded,
+				SeatsUsed: seatsUsed + 1,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("create seat change: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+func (s *setOrganizationMemberServiceImpl) upsertMember(
+	ctx context.Context, organizationID uuid.UUID, request *models.SetOrganizationMemberRequest,
+) (*entities.OrganizationMember, error) {
+	member, err := s.upsertOrganizationMemberRepository.UpsertOrganizationMember(
+		ctx, organizationID, request.UserID, &dao.UpsertOrganizationMemberData{MaxEdits: request.MaxEdits},
+	)
+	if err != nil {
+		if errors.Is(err, dao.ErrMemberOfAnotherOrganization) {
+			return nil, ErrMemberOfAnotherOrganization
+		}
+
+		return nil, fmt.Errorf("upsert organization member: %w", err)
+	}
+
+	return member, nil
+}
+
+func NewSetOrganizationMemberService(
+	getOrganizationRepository dao.GetOrganizationRepository,
+	getOrganizationMemberByUserRepository dao.GetOrganizationMemberByUserRepository,
+	upsertOrganizationMemberRepository dao.UpsertOrganizationMemberRepository,
+	countOrganizationMembersRepository dao.CountOrganizationMembersRepository,
+	lockOrganizationMembersRepository dao.LockOrganizationMembersRepository,
+	createSeatChangeRepository dao.CreateSeatChangeRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+) SetOrganizationMemberService {
+	return &setOrganizationMemberServiceImpl{
+		getOrganizationRepository:             getOrganizationRepository,
+		getOrganizationMemberByUserRepository: getOrganizationMemberByUserRepository,
+		upsertOrganizationMemberRepository:    upsertOrganizationMemberRepository,
+		countOrganizationMembersRepository:    countOrganizationMembersRepository,
+		lockOrganizationMembersRepository:     lockOrganizationMembersRepository,
+		createSeatChangeRepository:            createSeatChangeRepository,
+		runInTransactionRepository:            runInTransactionRepository,
+	}
+}
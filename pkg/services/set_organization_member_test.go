@@ -0,0 +1,451 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSetOrganizationMember(t *testing.T) {
+	organizationID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+	otherOrganizationID := uuid.MustParse("00000000-0000-0000-0000-000000000102")
+
+	teamTier := &models.ResolvedTier{
+		Name:            "team",
+		TierInformation: config.TierInformation{Seats: 10},
+	}
+
+	testData := []struct {
+		name string
+
+		request          *models.SetOrganizationMemberRequest
+		organizationTier *models.ResolvedTier
+
+		shouldCallGetOrganization bool
+		getOrganizationErr        error
+
+		shouldCallLock bool
+		lockErr        error
+
+		shouldCallGetMember bool
+		getMemberResponse   *entities.OrganizationMember
+		getMemberErr        error
+
+		shouldCallCount bool
+		countResponse   int
+		countErr        error
+
+		shouldCallUpsert bool
+		upsertData       *dao.UpsertOrganizationMemberData
+		upsertResponse   *entities.OrganizationMember
+		upsertErr        error
+
+		shouldCallCreateSeatChange bool
+		createSeatChangeData       *dao.CreateSeatChangeData
+		createSeatChangeErr        error
+
+		expect    *entities.OrganizationMember
+		expectErr error
+	}{
+		// Success cases.
+		{
+			name: "SetOrganizationMember",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+				MaxEdits:       lo.ToPtr(10),
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberErr:              dao.ErrOrganizationMemberNotFound,
+			shouldCallCount:           true,
+			countResponse:             3,
+			shouldCallUpsert:          true,
+			upsertData:                &dao.UpsertOrganizationMemberData{MaxEdits: lo.ToPtr(10)},
+			upsertResponse: &entities.OrganizationMember{
+				OrganizationID: &organizationID,
+				UserID:         "user-id-1",
+				MaxEdits:       lo.ToPtr(10),
+			},
+			shouldCallCreateSeatChange: true,
+			createSeatChangeData: &dao.CreateSeatChangeData{
+				Kind:      entities.SeatChangeKindAdded,
+				SeatsUsed: 4,
+			},
+			expect: &entities.OrganizationMember{
+				OrganizationID: &organizationID,
+				UserID:         "user-id-1",
+				MaxEdits:       lo.ToPtr(10),
+			},
+		},
+		{
+			name: "SetOrganizationMember/NoSubLimit",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberErr:              dao.ErrOrganizationMemberNotFound,
+			shouldCallCount:           true,
+			countResponse:             3,
+			shouldCallUpsert:          true,
+			upsertData:                &dao.UpsertOrganizationMemberData{},
+			upsertResponse: &entities.OrganizationMember{
+				OrganizationID: &organizationID,
+				UserID:         "user-id-1",
+			},
+			shouldCallCreateSeatChange: true,
+			createSeatChangeData: &dao.CreateSeatChangeData{
+				Kind:      entities.SeatChangeKindAdded,
+				SeatsUsed: 4,
+			},
+			expect: &entities.OrganizationMember{
+				OrganizationID: &organizationID,
+				UserID:         "user-id-1",
+			},
+		},
+		{
+			name: "SetOrganizationMember/LastSeat",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberErr:              dao.ErrOrganizationMemberNotFound,
+			shouldCallCount:           true,
+			countResponse:             9,
+			shouldCallUpsert:          true,
+			upsertData:                &dao.UpsertOrganizationMemberData{},
+			upsertResponse: &entities.OrganizationMember{
+				OrganizationID: &organizationID,
+				UserID:         "user-id-1",
+			},
+			shouldCallCreateSeatChange: true,
+			createSeatChangeData: &dao.CreateSeatChangeData{
+				Kind:      entities.SeatChangeKindAdded,
+				SeatsUsed: 10,
+			},
+			expect: &entities.OrganizationMember{
+				OrganizationID: &organizationID,
+				UserID:         "user-id-1",
+			},
+		},
+		{
+			// Existing members already hold a seat, so their sub-limit can change even when the organization is full.
+			name: "SetOrganizationMember/UpdateExistingMember",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+				MaxEdits:       lo.ToPtr(5),
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberResponse: &entities.OrganizationMember{
+				OrganizationID: &organizationID,
+				UserID:         "user-id-1",
+			},
+			shouldCallUpsert: true,
+			upsertData:       &dao.UpsertOrganizationMemberData{MaxEdits: lo.ToPtr(5)},
+			upsertResponse: &entities.OrganizationMember{
+				OrganizationID: &organizationID,
+				UserID:         "user-id-1",
+				MaxEdits:       lo.ToPtr(5),
+			},
+			expect: &entities.OrganizationMember{
+				OrganizationID: &organizationID,
+				UserID:         "user-id-1",
+				MaxEdits:       lo.ToPtr(5),
+			},
+		},
+
+		// Local error cases.
+		{
+			name: "SetOrganizationMember/OrganizationNotFound",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			getOrganizationErr:        dao.ErrOrganizationNotFound,
+			expectErr:                 services.ErrOrganizationNotFound,
+		},
+		{
+			name: "SetOrganizationMember/MemberOfAnotherOrganization",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberResponse: &entities.OrganizationMember{
+				OrganizationID: &otherOrganizationID,
+				UserID:         "user-id-1",
+			},
+			expectErr: services.ErrMemberOfAnotherOrganization,
+		},
+		{
+			// The user joined another organization between the lookup and the upsert.
+			name: "SetOrganizationMember/MemberOfAnotherOrganizationOnUpsert",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberErr:              dao.ErrOrganizationMemberNotFound,
+			shouldCallCount:           true,
+			countResponse:             3,
+			shouldCallUpsert:          true,
+			upsertData:                &dao.UpsertOrganizationMemberData{},
+			upsertErr:                 dao.ErrMemberOfAnotherOrganization,
+			expectErr:                 services.ErrMemberOfAnotherOrganization,
+		},
+		{
+			name: "SetOrganizationMember/NoSeatsAvailable",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberErr:              dao.ErrOrganizationMemberNotFound,
+			shouldCallCount:           true,
+			countResponse:             10,
+			expectErr:                 services.ErrNoSeatsAvailable,
+		},
+		{
+			name: "SetOrganizationMember/TierWithoutSeats",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          &models.ResolvedTier{Name: "free"},
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberErr:              dao.ErrOrganizationMemberNotFound,
+			shouldCallCount:           true,
+			expectErr:                 services.ErrNoSeatsAvailable,
+		},
+		{
+			name: "SetOrganizationMember/InvalidOrganizationID",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: "acme",
+				UserID:         "user-id-1",
+			},
+			organizationTier: teamTier,
+			expectErr:        services.ErrInvalidRequest,
+		},
+		{
+			name: "SetOrganizationMember/NegativeMaxEdits",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+				MaxEdits:       lo.ToPtr(-1),
+			},
+			organizationTier: teamTier,
+			expectErr:        services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "GetOrganizationError",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			getOrganizationErr:        FooErr,
+			expectErr:                 FooErr,
+		},
+		{
+			name: "LockOrganizationMembersError",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			lockErr:                   FooErr,
+			expectErr:                 FooErr,
+		},
+		{
+			name: "GetOrganizationMemberByUserError",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberErr:              FooErr,
+			expectErr:                 FooErr,
+		},
+		{
+			name: "CountOrganizationMembersError",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberErr:              dao.ErrOrganizationMemberNotFound,
+			shouldCallCount:           true,
+			countErr:                  FooErr,
+			expectErr:                 FooErr,
+		},
+		{
+			name: "UpsertOrganizationMemberError",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberErr:              dao.ErrOrganizationMemberNotFound,
+			shouldCallCount:           true,
+			countResponse:             3,
+			shouldCallUpsert:          true,
+			upsertData:                &dao.UpsertOrganizationMemberData{},
+			upsertErr:                 FooErr,
+			expectErr:                 FooErr,
+		},
+		{
+			name: "CreateSeatChangeError",
+			request: &models.SetOrganizationMemberRequest{
+				OrganizationID: organizationID.String(),
+				UserID:         "user-id-1",
+			},
+			organizationTier:          teamTier,
+			shouldCallGetOrganization: true,
+			shouldCallLock:            true,
+			shouldCallGetMember:       true,
+			getMemberErr:              dao.ErrOrganizationMemberNotFound,
+			shouldCallCount:           true,
+			countResponse:             3,
+			shouldCallUpsert:          true,
+			upsertData:                &dao.UpsertOrganizationMemberData{},
+			upsertResponse: &entities.OrganizationMember{
+				OrganizationID: &organizationID,
+				UserID:         "user-id-1",
+			},
+			shouldCallCreateSeatChange: true,
+			createSeatChangeData: &dao.CreateSeatChangeData{
+				Kind:      entities.SeatChangeKindAdded,
+				SeatsUsed: 4,
+			},
+			createSeatChangeErr: FooErr,
+			expectErr:           FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			getOrganizationRepository := daomocks.NewMockGetOrganizationRepository(t)
+			getOrganizationMemberByUserRepository := daomocks.NewMockGetOrganizationMemberByUserRepository(t)
+			upsertOrganizationMemberRepository := daomocks.NewMockUpsertOrganizationMemberRepository(t)
+			countOrganizationMembersRepository := daomocks.NewMockCountOrganizationMembersRepository(t)
+			lockOrganizationMembersRepository := daomocks.NewMockLockOrganizationMembersRepository(t)
+			createSeatChangeRepository := daomocks.NewMockCreateSeatChangeRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+
+			if tt.shouldCallGetOrganization {
+				getOrganizationRepository.
+					On("GetOrganization", context.TODO(), organizationID).
+					Return(&entities.Organization{ID: &organizationID}, tt.getOrganizationErr)
+			}
+
+			if tt.shouldCallLock {
+				runInTransactionRepository.
+					On("RunInTransaction", context.TODO(), mock.Anything).
+					Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				lockOrganizationMembersRepository.
+					On("LockOrganizationMembers", context.TODO(), organizationID).
+					Return(tt.lockErr)
+			}
+
+			if tt.shouldCallGetMember {
+				getOrganizationMemberByUserRepository.
+					On("GetOrganizationMemberByUser", context.TODO(), tt.request.UserID).
+					Return(tt.getMemberResponse, tt.getMemberErr)
+			}
+
+			if tt.shouldCallCount {
+				countOrganizationMembersRepository.
+					On("CountOrganizationMembers", context.TODO(), organizationID).
+					Return(tt.countResponse, tt.countErr)
+			}
+
+			if tt.shouldCallUpsert {
+				upsertOrganizationMemberRepository.
+					On("UpsertOrganizationMember", context.TODO(), organizationID, tt.request.UserID, tt.upsertData).
+					Return(tt.upsertResponse, tt.upsertErr)
+			}
+
+			if tt.shouldCallCreateSeatChange {
+				createSeatChangeRepository.
+					On("CreateSeatChange", context.TODO(), organizationID, tt.request.UserID, tt.createSeatChangeData).
+					Return(&entities.SeatChange{}, tt.createSeatChangeErr)
+			}
+
+			service := services.NewSetOrganizationMemberService(
+				getOrganizationRepository,
+				getOrganizationMemberByUserRepository,
+				upsertOrganizationMemberRepository,
+				countOrganizationMembersRepository,
+				lockOrganizationMembersRepository,
+				createSeatChangeRepository,
+				runInTransactionRepository,
+			)
+
+			member, err := service.Exec(context.TODO(), tt.request, tt.organizationTier)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, member)
+
+			getOrganizationRepository.AssertExpectations(t)
+			getOrganizationMemberByUserRepository.AssertExpectations(t)
+			upsertOrganizationMemberRepository.AssertExpectations(t)
+			countOrganizationMembersRepository.AssertExpectations(t)
+			lockOrganizationMembersRepository.AssertExpectations(t)
+			createSeatChangeRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"time"
+)
+
+type SetQuotaOverrideService interface {
+	Exec(ctx context.Context, request *models.SetQuotaOverrideRequest, now time.Time) (*entities.QuotaOverride, error)
+}
+
+type setQuotaOverrideServiceImpl struct {
+	upsertQuotaOverrideRepository dao.UpsertQuotaOverrideRepository
+}
+
+func (s *setQuotaOverrideServiceImpl) Exec(
+	ctx context.Context, request *models.SetQuotaOverrideRequest, now time.Time,
+) (*entities.QuotaOverride, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(now) {
+		return nil, errors.Join(ErrInvalidRequest, errors.New("expiration date must be in the future"))
+	}
+
+	data := &dao.UpsertQuotaOverrideData{
+		Kind:      entities.QuotaOverrideKind(request.Kind),
+		MaxEdits:  request.MaxEdits,
+		ExpiresAt: request.ExpiresAt,
+	}
+
+	// Don't store a meaningless value for overrides that lift the limit.
+	if data.Kind == entities.QuotaOverrideKindDisable {
+		data.MaxEdits = 0
+	}
+
+	override, err := s.upsertQuotaOverrideRepository.UpsertQuotaOverride(ctx, request.AuthorID, data)
+	if err != nil {
+		return nil, fmt.Errorf("upsert quota override: %w", err)
+	}
+
+	return override, nil
+}
+
+func NewSetQuotaOverrideService(upsertQuotaOverrideRepository dao.UpsertQuotaOverrideRepository) SetQuotaOverrideService {
+	return &setQuotaOverrideServiceImpl{
+		upsertQuotaOverrideRepository: upsertQuotaOverrideRepository,
+	}
+}
@@ -0,0 +1,161 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestSetQuotaOverride(t *testing.T) {
+	testData := []struct {
+		name string
+
+		request *models.SetQuotaOverrideRequest
+		now     time.Time
+
+		shouldCallUpsert bool
+		upsertData       *dao.UpsertQuotaOverrideData
+		upsertResponse   *entities.QuotaOverride
+		upsertErr        error
+
+		expect    *entities.QuotaOverride
+		expectErr error
+	}{
+		{
+			name: "SetQuotaOverride",
+			request: &models.SetQuotaOverrideRequest{
+				AuthorID:  "author-id-1",
+				Kind:      "add",
+				MaxEdits:  10,
+				ExpiresAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			now:              time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallUpsert: true,
+			upsertData: &dao.UpsertQuotaOverrideData{
+				Kind:      entities.QuotaOverrideKindAdd,
+				MaxEdits:  10,
+				ExpiresAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			upsertResponse: &entities.QuotaOverride{
+				ID:       lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID: "author-id-1",
+				Kind:     entities.QuotaOverrideKindAdd,
+				MaxEdits: 10,
+			},
+			expect: &entities.QuotaOverride{
+				ID:       lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID: "author-id-1",
+				Kind:     entities.QuotaOverrideKindAdd,
+				MaxEdits: 10,
+			},
+		},
+		{
+			name: "SetQuotaOverride/Disable",
+			request: &models.SetQuotaOverrideRequest{
+				AuthorID: "author-id-1",
+				Kind:     "disable",
+				MaxEdits: 10,
+			},
+			now:              time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallUpsert: true,
+			upsertData: &dao.UpsertQuotaOverrideData{
+				Kind: entities.QuotaOverrideKindDisable,
+			},
+			upsertResponse: &entities.QuotaOverride{
+				ID:       lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID: "author-id-1",
+				Kind:     entities.QuotaOverrideKindDisable,
+			},
+			expect: &entities.QuotaOverride{
+				ID:       lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				AuthorID: "author-id-1",
+				Kind:     entities.QuotaOverrideKindDisable,
+			},
+		},
+
+		// Local error cases.
+		{
+			name: "SetQuotaOverride/ExpiredAlready",
+			request: &models.SetQuotaOverrideRequest{
+				AuthorID:  "author-id-1",
+				Kind:      "replace",
+				MaxEdits:  10,
+				ExpiresAt: lo.ToPtr(time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "SetQuotaOverride/InvalidKind",
+			request: &models.SetQuotaOverrideRequest{
+				AuthorID: "author-id-1",
+				Kind:     "multiply",
+				MaxEdits: 10,
+			},
+			now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "SetQuotaOverride/NegativeMaxEdits",
+			request: &models.SetQuotaOverrideRequest{
+				AuthorID: "author-id-1",
+				Kind:     "replace",
+				MaxEdits: -1,
+			},
+			now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name:      "SetQuotaOverride/InvalidRequest",
+			request:   &models.SetQuotaOverrideRequest{},
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "UpsertQuotaOverrideError",
+			request: &models.SetQuotaOverrideRequest{
+				AuthorID: "author-id-1",
+				Kind:     "replace",
+				MaxEdits: 10,
+			},
+			now:              time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			shouldCallUpsert: true,
+			upsertData: &dao.UpsertQuotaOverrideData{
+				Kind:     entities.QuotaOverrideKindReplace,
+				MaxEdits: 10,
+			},
+			upsertErr: FooErr,
+			expectErr: FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			upsertQuotaOverrideRepository := daomocks.NewMockUpsertQuotaOverrideRepository(t)
+
+			if tt.shouldCallUpsert {
+				upsertQuotaOverrideRepository.
+					On("UpsertQuotaOverride", context.TODO(), tt.request.AuthorID, tt.upsertData).
+					Return(tt.upsertResponse, tt.upsertErr)
+			}
+
+			service := services.NewSetQuotaOverrideService(upsertQuotaOverrideRepository)
+
+			override, err := service.Exec(context.TODO(), tt.request, tt.now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, override)
+
+			upsertQuotaOverrideRepository.AssertExpectations(t)
+		})
+	}
+}
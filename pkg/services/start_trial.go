@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/samber/lo"
+	"time"
+)
+
+// StartTrialService grants the trial tier to a user for the configured trial duration. Each user can only have one
+// trial.
+type StartTrialService interface {
+	Exec(ctx context.Context, request *models.StartTrialRequest, now time.Time) (*entities.Subscription, error)
+}
+
+type startTrialServiceImpl struct {
+	getSubscriptionRepository    dao.GetSubscriptionByUserRepository
+	hasUsedTrialRepository       dao.HasUsedTrialRepository
+	createSubscriptionRepository dao.CreateSubscriptionRepository
+	lockSubscriptionsRepository  dao.LockSubscriptionsByUserRepository
+	runInTransactionRepository   dao.RunInTransactionRepository
+
+	emitter events.Emitter
+	trial   config.TrialInformation
+}
+
+func (s *startTrialServiceImpl) Exec(
+	ctx context.Context, request *models.StartTrialRequest, now time.Time,
+) (*entities.Subscription, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	if s.trial.Tier == "" {
+		return nil, ErrTrialsDisabled
+	}
+
+	var subscription *entities.Subscription
+
+	// Parallel requests for the same user could otherwise both pass the checks, and both start a trial. The change is
+	// announced in the same transaction, so the event is only sent if the trial is saved.
+	err := s.runInTransactionRepository.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := s.lockSubscriptionsRepository.LockSubscriptionsByUser(ctx, request.UserID); err != nil {
+			return fmt.Errorf("lock subscriptions: %w", err)
+		}
+
+		currentSubscription, err := s.getSubscriptionRepository.GetSubscriptionByUser(ctx, request.UserID)
+		if err != nil && !errors.Is(err, dao.ErrSubscriptionNotFound) {
+			return fmt.Errorf("get subscription: %w", err)
+		}
+
+		if currentSubscription != nil && currentSubscription.IsRunning(now) {
+			return ErrSubscriptionAlreadyExists
+		}
+
+		usedTrial, err := s.hasUsedTrialRepository.HasUsedTrial(ctx, request.UserID)
+		if err != nil {
+			return fmt.Errorf("has used trial: %w", err)
+		}
+
+		if usedTrial {
+			return ErrTrialAlreadyUsed
+		}
+
+		data := &dao.CreateSubscriptionData{
+			Tier:        s.trial.Tier,
+			Status:      entities.SubscriptionStatusTrialing,
+			StartedAt:   now.UTC(),
+			TrialEndsAt: lo.ToPtr(now.UTC().Add(s.trial.Duration)),
+		}
+
+		subscription, err = s.createSubscriptionRepository.CreateSubscription(ctx, request.UserID, data)
+		if err != nil {
+			return fmt.Errorf("create subscription: %w", err)
+		}
+
+		if err := s.emitter.Emit(ctx, subscriptionChanged(subscription, "", now)); err != nil {
+			return fmt.Errorf("emit subscription changed: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func NewStartTrialService(
+	getSubscriptionRepository dao.GetSubscriptionByUserRepository,
+	hasUsedTrialRepository dao.HasUsedTrialRepository,
+	createSubscriptionRepository dao.CreateSubscriptionRepository,
+	lockSubscriptionsRepository dao.LockSubscriptionsByUserRepository,
+	runInTransactionRepository dao.RunInTransactionRepository,
+	emitter events.Emitter,
+	trial config.TrialInformation,
+) StartTrialService {
+	return &startTrialServiceImpl{
+		getSubscriptionRepository:    getSubscriptionRepository,
+		hasUsedTrialRepository:       hasUsedTrialRepository,
+		createSubscriptionRepository: createSubscriptionRepository,
+		lockSubscriptionsRepository:  lockSubscriptionsRepository,
+		runInTransactionRepository:   runInTransactionRepository,
+		emitter:                      emitter,
+		trial:                        trial,
+	}
+}
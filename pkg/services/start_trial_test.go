@@ -0,0 +1,328 @@
+package services_test
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	daomocks "github.com/in-rich/uservice-subscription/pkg/dao/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	eventsmocks "github.com/in-rich/uservice-subscription/pkg/events/mocks"
+	"github.com/in-rich/uservice-subscription/pkg/models"
+	"github.com/in-rich/uservice-subscription/pkg/services"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestStartTrial(t *testing.T) {
+	trial := config.TrialInformation{
+		Tier:     "pro",
+		Duration: 14 * 24 * time.Hour,
+	}
+
+	testData := []struct {
+		name string
+
+		request *models.StartTrialRequest
+		now     time.Time
+		trial   config.TrialInformation
+
+		// The lock is always taken before getting the subscription.
+		shouldCallLockSubscriptions bool
+		lockSubscriptionsErr        error
+
+		shouldCallGetSubscription bool
+		getSubscriptionResponse   *entities.Subscription
+		getSubscriptionErr        error
+
+		shouldCallHasUsedTrial bool
+		hasUsedTrialResponse   bool
+		hasUsedTrialErr        error
+
+		shouldCallCreateSubscription bool
+		createSubscriptionData       *dao.CreateSubscriptionData
+		createSubscriptionResponse   *entities.Subscription
+		createSubscriptionErr        error
+
+		emitErr error
+
+		expect    *entities.Subscription
+		expectErr error
+	}{
+		{
+			name: "StartTrial",
+			request: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			now:                          time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			trial:                        trial,
+			shouldCallGetSubscription:    true,
+			getSubscriptionErr:           dao.ErrSubscriptionNotFound,
+			shouldCallHasUsedTrial:       true,
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:        "pro",
+				Status:      entities.SubscriptionStatusTrialing,
+				StartedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+			},
+			createSubscriptionResponse: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusTrialing,
+			},
+			expect: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusTrialing,
+			},
+		},
+		{
+			name: "StartTrial/PreviousSubscriptionEnded",
+			request: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			now:                       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			trial:                     trial,
+			shouldCallGetSubscription: true,
+			getSubscriptionResponse: &entities.Subscription{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    "user-id-1",
+				Tier:      "team",
+				Status:    entities.SubscriptionStatusCanceled,
+				StartedAt: lo.ToPtr(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+				EndsAt:    lo.ToPtr(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldCallHasUsedTrial:       true,
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:        "pro",
+				Status:      entities.SubscriptionStatusTrialing,
+				StartedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+			},
+			createSubscriptionResponse: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusTrialing,
+			},
+			expect: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusTrialing,
+			},
+		},
+
+		// Local error cases.
+		{
+			name: "StartTrial/AlreadyUsed",
+			request: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			now:                       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			trial:                     trial,
+			shouldCallGetSubscription: true,
+			getSubscriptionErr:        dao.ErrSubscriptionNotFound,
+			shouldCallHasUsedTrial:    true,
+			hasUsedTrialResponse:      true,
+			expectErr:                 services.ErrTrialAlreadyUsed,
+		},
+		{
+			name: "StartTrial/SubscriptionAlreadyExists",
+			request: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			now:                       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			trial:                     trial,
+			shouldCallGetSubscription: true,
+			getSubscriptionResponse: &entities.Subscription{
+				ID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID:    "user-id-1",
+				Tier:      "team",
+				Status:    entities.SubscriptionStatusActive,
+				StartedAt: lo.ToPtr(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expectErr: services.ErrSubscriptionAlreadyExists,
+		},
+		{
+			name: "StartTrial/Disabled",
+			request: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectErr: services.ErrTrialsDisabled,
+		},
+		{
+			name:      "StartTrial/InvalidRequest",
+			request:   &models.StartTrialRequest{},
+			trial:     trial,
+			expectErr: services.ErrInvalidRequest,
+		},
+
+		// Dependency error cases.
+		{
+			name: "EmitError",
+			request: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			now:                          time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			trial:                        trial,
+			shouldCallGetSubscription:    true,
+			getSubscriptionErr:           dao.ErrSubscriptionNotFound,
+			shouldCallHasUsedTrial:       true,
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:        "pro",
+				Status:      entities.SubscriptionStatusTrialing,
+				StartedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+			},
+			createSubscriptionResponse: &entities.Subscription{
+				ID:     lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				UserID: "user-id-1",
+				Tier:   "pro",
+				Status: entities.SubscriptionStatusTrialing,
+			},
+			emitErr:   FooErr,
+			expectErr: FooErr,
+		},
+		{
+			name: "CreateSubscriptionError",
+			request: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			now:                          time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			trial:                        trial,
+			shouldCallGetSubscription:    true,
+			getSubscriptionErr:           dao.ErrSubscriptionNotFound,
+			shouldCallHasUsedTrial:       true,
+			shouldCallCreateSubscription: true,
+			createSubscriptionData: &dao.CreateSubscriptionData{
+				Tier:        "pro",
+				Status:      entities.SubscriptionStatusTrialing,
+				StartedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				TrialEndsAt: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+			},
+			createSubscriptionErr: FooErr,
+			expectErr:             FooErr,
+		},
+		{
+			name: "HasUsedTrialError",
+			request: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			now:                       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			trial:                     trial,
+			shouldCallGetSubscription: true,
+			getSubscriptionErr:        dao.ErrSubscriptionNotFound,
+			shouldCallHasUsedTrial:    true,
+			hasUsedTrialErr:           FooErr,
+			expectErr:                 FooErr,
+		},
+		{
+			name: "LockSubscriptionsError",
+			request: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			now:                         time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			trial:                       trial,
+			shouldCallLockSubscriptions: true,
+			lockSubscriptionsErr:        FooErr,
+			expectErr:                   FooErr,
+		},
+		{
+			name: "GetSubscriptionError",
+			request: &models.StartTrialRequest{
+				UserID: "user-id-1",
+			},
+			now:                       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			trial:                     trial,
+			shouldCallGetSubscription: true,
+			getSubscriptionErr:        FooErr,
+			expectErr:                 FooErr,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			getSubscriptionRepository := daomocks.NewMockGetSubscriptionByUserRepository(t)
+			hasUsedTrialRepository := daomocks.NewMockHasUsedTrialRepository(t)
+			createSubscriptionRepository := daomocks.NewMockCreateSubscriptionRepository(t)
+			lockSubscriptionsRepository := daomocks.NewMockLockSubscriptionsByUserRepository(t)
+			runInTransactionRepository := daomocks.NewMockRunInTransactionRepository(t)
+			emitter := eventsmocks.NewMockEmitter(t)
+
+			if tt.shouldCallLockSubscriptions || tt.shouldCallGetSubscription {
+				runInTransactionRepository.
+					On("RunInTransaction", context.TODO(), mock.Anything).
+					Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				lockSubscriptionsRepository.
+					On("LockSubscriptionsByUser", context.TODO(), tt.request.UserID).
+					Return(tt.lockSubscriptionsErr)
+			}
+
+			if tt.shouldCallGetSubscription {
+				getSubscriptionRepository.
+					On("GetSubscriptionByUser", context.TODO(), tt.request.UserID).
+					Return(tt.getSubscriptionResponse, tt.getSubscriptionErr)
+			}
+
+			if tt.shouldCallHasUsedTrial {
+				hasUsedTrialRepository.
+					On("HasUsedTrial", context.TODO(), tt.request.UserID).
+					Return(tt.hasUsedTrialResponse, tt.hasUsedTrialErr)
+			}
+
+			if tt.shouldCallCreateSubscription {
+				if tt.createSubscriptionErr == nil {
+					emitter.
+						On("Emit", context.TODO(), &events.SubscriptionChanged{
+							SubscriptionID: *tt.createSubscriptionResponse.ID,
+							UserID:         tt.createSubscriptionResponse.UserID,
+							Tier:           tt.createSubscriptionResponse.Tier,
+							Status:         string(tt.createSubscriptionResponse.Status),
+							ChangedAt:      tt.now,
+						}).
+						Return(tt.emitErr)
+				}
+
+				createSubscriptionRepository.
+					On("CreateSubscription", context.TODO(), tt.request.UserID, tt.createSubscriptionData).
+					Return(tt.createSubscriptionResponse, tt.createSubscriptionErr)
+			}
+
+			service := services.NewStartTrialService(
+				getSubscriptionRepository,
+				hasUsedTrialRepository,
+				createSubscriptionRepository,
+				lockSubscriptionsRepository,
+				runInTransactionRepository,
+				emitter,
+				tt.trial,
+			)
+
+			subscription, err := service.Exec(context.TODO(), tt.request, tt.now)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expect, subscription)
+
+			getSubscriptionRepository.AssertExpectations(t)
+			hasUsedTrialRepository.AssertExpectations(t)
+			createSubscriptionRepository.AssertExpectations(t)
+			lockSubscriptionsRepository.AssertExpectations(t)
+			runInTransactionRepository.AssertExpectations(t)
+			emitter.AssertExpectations(t)
+		})
+	}
+}
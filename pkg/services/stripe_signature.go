@@ -0,0 +1,75 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// verifyStripeSignature checks the Stripe-Signature header of a webhook payload, as described in
+// https://docs.stripe.com/webhooks#verify-manually.
+func verifyStripeSignature(payload []byte, header string, secret string, tolerance time.Duration, now time.Time) error {
+	// An empty secret would let anyone forge a valid signature.
+	if secret == "" {
+		return errors.Join(ErrInvalidStripeSignature, errors.New("no webhook secret configured"))
+	}
+
+	var timestamp string
+	var signatures [][]byte
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			// Ignore malformed signatures, another one may still match.
+			if signature, err := hex.DecodeString(value); err == nil {
+				signatures = append(signatures, signature)
+			}
+		}
+	}
+
+	if timestamp == "" || len(signatures) == 0 {
+		return errors.Join(ErrInvalidStripeSignature, errors.New("malformed header"))
+	}
+
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Join(ErrInvalidStripeSignature, fmt.Errorf("malformed timestamp: %w", err))
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	valid := false
+	for _, signature := range signatures {
+		if hmac.Equal(signature, expected) {
+			valid = true
+			break
+		}
+	}
+
+	if !valid {
+		return errors.Join(ErrInvalidStripeSignature, errors.New("no matching signature"))
+	}
+
+	// Only check the timestamp once the signature is verified, since it is part of the signed content.
+	if age := now.Sub(time.Unix(signedAt, 0)); tolerance > 0 && (age > tolerance || age < -tolerance) {
+		return errors.Join(ErrInvalidStripeSignature, errors.New("timestamp outside the tolerance zone"))
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"github.com/in-rich/uservice-subscription/pkg/dao"
+	"github.com/in-rich/uservice-subscription/pkg/entities"
+	"github.com/in-rich/uservice-subscription/pkg/events"
+	"time"
+)
+
+// subscriptionUpdateData returns the update data that leaves a subscription unchanged, so services only have to
+// override the fields they actually update.
+func subscriptionUpdateData(subscription *entities.Subscription) *dao.UpdateSubscriptionData {
+	return &dao.UpdateSubscriptionData{
+		Tier:   subscription.Tier,
+		Status: subscription.Status,
+		EndsAt: subscription.EndsAt,
+
+		CurrentPeriodStart: subscription.CurrentPeriodStart,
+		CurrentPeriodEnd:   subscription.CurrentPeriodEnd,
+		CancelAtPeriodEnd:  subscription.CancelAtPeriodEnd,
+		CanceledAt:         subscription.CanceledAt,
+
+		TrialEndsAt: subscription.TrialEndsAt,
+
+		PaymentFailedAt:      subscription.PaymentFailedAt,
+		StripeEventCreatedAt: subscription.StripeEventCreatedAt,
+		StripeEventID:        subscription.StripeEventID,
+	}
+}
+
+// subscriptionChanged describes a subscription once it was created or updated. previousTier is empty for new
+// subscriptions.
+func subscriptionChanged(subscription *entities.Subscription, previousTier string, now time.Time) *events.SubscriptionChanged {
+	return &events.SubscriptionChanged{
+		SubscriptionID: *subscription.ID,
+		UserID:         subscription.UserID,
+		Tier:           subscription.Tier,
+		PreviousTier:   previousTier,
+		Status:         string(subscription.Status),
+		EndsAt:         subscription.EndsAt,
+		ChangedAt:      now.UTC(),
+	}
+}
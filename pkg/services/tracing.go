@@ -0,0 +1,20 @@
+package services
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer opens a span around service calls, under which the spans of the repositories they call are recorded.
+var tracer = otel.Tracer("github.com/in-rich/uservice-subscription/pkg/services")
+
+// endSpan marks the span as failed if the service returned an error, then ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,90 @@
+package tracing_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/tracing"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	parent := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	testData := []struct {
+		name string
+
+		md metadata.MD
+
+		handlerErr error
+
+		expectParent trace.SpanContext
+		expectCode   grpccodes.Code
+		expectStatus codes.Code
+	}{
+		{
+			name:         "PropagatedTrace",
+			md:           metadata.Pairs("traceparent", "00-01000000000000000000000000000000-0100000000000000-01"),
+			expectParent: parent.WithRemote(true),
+			expectCode:   grpccodes.OK,
+			expectStatus: codes.Unset,
+		},
+		{
+			name:         "NewTrace",
+			expectCode:   grpccodes.OK,
+			expectStatus: codes.Unset,
+		},
+		{
+			name:         "HandlerError",
+			handlerErr:   status.Error(grpccodes.ResourceExhausted, "note edits exhausted"),
+			expectCode:   grpccodes.ResourceExhausted,
+			expectStatus: codes.Error,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+			interceptor := tracing.NewUnaryServerInterceptor(provider, propagation.TraceContext{})
+
+			ctx := metadata.NewIncomingContext(context.TODO(), tt.md)
+			info := &grpc.UnaryServerInfo{FullMethod: "/subscription.CanUpdateNote/CanUpdateNote"}
+
+			var handlerSpan trace.SpanContext
+			_, err := interceptor(ctx, nil, info, func(ctx context.Context, _ any) (any, error) {
+				handlerSpan = trace.SpanContextFromContext(ctx)
+				return nil, tt.handlerErr
+			})
+
+			require.ErrorIs(t, err, tt.handlerErr)
+
+			spans := exporter.GetSpans()
+			require.Len(t, spans, 1)
+			require.Equal(t, "subscription.CanUpdateNote/CanUpdateNote", spans[0].Name)
+			require.Equal(t, trace.SpanKindServer, spans[0].SpanKind)
+			require.Equal(t, tt.expectParent, spans[0].Parent)
+			require.Equal(t, spans[0].SpanContext, handlerSpan)
+			require.Equal(t, tt.expectStatus, spans[0].Status.Code)
+			require.Contains(t, spans[0].Attributes, attribute.Int("rpc.grpc.status_code", int(tt.expectCode)))
+
+			if tt.expectParent.IsValid() {
+				require.Equal(t, tt.expectParent.TraceID(), spans[0].SpanContext.TraceID())
+			}
+		})
+	}
+}
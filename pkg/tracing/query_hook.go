@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// QueryHook records a client span for every query sent through a bun database.
+type QueryHook struct {
+	tracer trace.Tracer
+}
+
+func (h *QueryHook) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	ctx, _ = h.tracer.Start(ctx, event.Operation(), trace.WithSpanKind(trace.SpanKindClient))
+	return ctx
+}
+
+func (h *QueryHook) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.SetAttributes(
+		semconv.DBSystemPostgreSQL,
+		semconv.DBOperationName(event.Operation()),
+		semconv.DBQueryText(event.Query),
+	)
+	if event.IQuery != nil && event.IQuery.GetTableName() != "" {
+		span.SetAttributes(semconv.DBCollectionName(event.IQuery.GetTableName()))
+	}
+
+	// Repositories report missing rows as regular results.
+	if event.Err != nil && !errors.Is(event.Err, sql.ErrNoRows) {
+		span.RecordError(event.Err)
+		span.SetStatus(codes.Error, event.Err.Error())
+	}
+}
+
+// NewQueryHook returns a bun query hook that records the queries as spans of the given provider.
+func NewQueryHook(provider trace.TracerProvider) *QueryHook {
+	return &QueryHook{
+		tracer: provider.Tracer(instrumentationName),
+	}
+}
@@ -0,0 +1,65 @@
+package tracing_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/in-rich/uservice-subscription/pkg/tracing"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"testing"
+)
+
+func TestQueryHook(t *testing.T) {
+	testData := []struct {
+		name string
+
+		queryErr error
+
+		expectStatus codes.Code
+	}{
+		{
+			name:         "QueryHook",
+			expectStatus: codes.Unset,
+		},
+		{
+			name:         "NoRows",
+			queryErr:     sql.ErrNoRows,
+			expectStatus: codes.Unset,
+		},
+		{
+			name:         "QueryError",
+			queryErr:     errors.New("query error"),
+			expectStatus: codes.Error,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+			ctx, parent := provider.Tracer("test").Start(context.TODO(), "dao.CountNoteEditsByAuthor")
+
+			hook := tracing.NewQueryHook(provider)
+			event := &bun.QueryEvent{Query: "SELECT count(*) FROM note_edits", Err: tt.queryErr}
+
+			hook.AfterQuery(hook.BeforeQuery(ctx, event), event)
+			parent.End()
+
+			spans := exporter.GetSpans()
+			require.Len(t, spans, 2)
+			require.Equal(t, "SELECT", spans[0].Name)
+			require.Equal(t, trace.SpanKindClient, spans[0].SpanKind)
+			require.Equal(t, parent.SpanContext(), spans[0].Parent)
+			require.Equal(t, tt.expectStatus, spans[0].Status.Code)
+			require.Contains(t, spans[0].Attributes, attribute.String("db.system", "postgresql"))
+			require.Contains(t, spans[0].Attributes, attribute.String("db.query.text", event.Query))
+		})
+	}
+}
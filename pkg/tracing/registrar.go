@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"google.golang.org/grpc"
+)
+
+type interceptedRegistrar struct {
+	registrar   grpc.ServiceRegistrar
+	interceptor grpc.UnaryServerInterceptor
+}
+
+func (r *interceptedRegistrar) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	intercepted := *desc
+	intercepted.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+
+	for i, method := range desc.Methods {
+		handler := method.Handler
+		intercepted.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv any, ctx context.Context, dec func(any) error, next grpc.UnaryServerInterceptor) (any, error) {
+				return handler(srv, ctx, dec, r.chain(next))
+			},
+		}
+	}
+
+	r.registrar.RegisterService(&intercepted, impl)
+}
+
+// chain runs the interceptor of the registrar before the interceptor configured on the server, if any.
+func (r *interceptedRegistrar) chain(next grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	if next == nil {
+		return r.interceptor
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return r.interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return next(ctx, req, info, handler)
+		})
+	}
+}
+
+// WithUnaryInterceptor wraps the unary methods of the services registered through the returned registrar with the
+// given interceptor. It is needed because deploy.StartGRPCServer creates the server without any option.
+//
+//	subscription_pb.RegisterCanUpdateNoteServer(tracing.WithUnaryInterceptor(server, interceptor), handler)
+func WithUnaryInterceptor(registrar grpc.ServiceRegistrar, interceptor grpc.UnaryServerInterceptor) grpc.ServiceRegistrar {
+	return &interceptedRegistrar{
+		registrar:   registrar,
+		interceptor: interceptor,
+	}
+}
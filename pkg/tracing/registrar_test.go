@@ -0,0 +1,90 @@
+package tracing_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/pkg/tracing"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"testing"
+)
+
+type fakeRegistrar struct {
+	desc *grpc.ServiceDesc
+}
+
+func (r *fakeRegistrar) RegisterService(desc *grpc.ServiceDesc, _ any) {
+	r.desc = desc
+}
+
+func recordingInterceptor(calls *[]string, name string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		*calls = append(*calls, name)
+		return handler(ctx, req)
+	}
+}
+
+func TestWithUnaryInterceptor(t *testing.T) {
+	testData := []struct {
+		name string
+
+		serverInterceptor bool
+
+		expectCalls []string
+	}{
+		{
+			name:        "WithUnaryInterceptor",
+			expectCalls: []string{"registrar", "handler"},
+		},
+		{
+			name:              "WithUnaryInterceptor/ServerInterceptor",
+			serverInterceptor: true,
+			expectCalls:       []string{"registrar", "server", "handler"},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+
+			// Mimic the handlers generated by protoc, that only call the interceptor when there is one.
+			desc := &grpc.ServiceDesc{
+				ServiceName: "subscription.CanUpdateNote",
+				Methods: []grpc.MethodDesc{
+					{
+						MethodName: "CanUpdateNote",
+						Handler: func(_ any, ctx context.Context, _ func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+							handler := func(context.Context, any) (any, error) {
+								calls = append(calls, "handler")
+								return "response", nil
+							}
+							if interceptor == nil {
+								return handler(ctx, nil)
+							}
+
+							info := &grpc.UnaryServerInfo{FullMethod: "/subscription.CanUpdateNote/CanUpdateNote"}
+							return interceptor(ctx, nil, info, handler)
+						},
+					},
+				},
+			}
+
+			registrar := new(fakeRegistrar)
+			tracing.WithUnaryInterceptor(registrar, recordingInterceptor(&calls, "registrar")).RegisterService(desc, nil)
+
+			require.NotNil(t, registrar.desc)
+			require.Equal(t, desc.ServiceName, registrar.desc.ServiceName)
+			require.Len(t, registrar.desc.Methods, 1)
+
+			var serverInterceptor grpc.UnaryServerInterceptor
+			if tt.serverInterceptor {
+				serverInterceptor = recordingInterceptor(&calls, "server")
+			}
+
+			res, err := registrar.desc.Methods[0].Handler(nil, context.TODO(), nil, serverInterceptor)
+
+			require.NoError(t, err)
+			require.Equal(t, "response", res)
+			require.Equal(t, tt.expectCalls, calls)
+		})
+	}
+}
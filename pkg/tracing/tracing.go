@@ -0,0 +1,47 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"github.com/in-rich/uservice-subscription/config"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// ServiceName identifies the spans of this service in the collector.
+const ServiceName = "uservice-subscription"
+
+// NewExporter returns the span exporter selected in the configuration, or nil if traces are not exported.
+func NewExporter(ctx context.Context, cfg config.TracingInformation) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case config.TracingExporterNone:
+		return nil, nil
+	case config.TracingExporterOTLP:
+		var options []otlptracegrpc.Option
+		if cfg.Endpoint != "" {
+			options = append(options, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+
+		exporter, err := otlptracegrpc.New(ctx, options...)
+		if err != nil {
+			return nil, fmt.Errorf("create otlp exporter: %w", err)
+		}
+
+		return exporter, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", config.ErrUnknownTracingExporter, cfg.Exporter)
+	}
+}
+
+// NewTracerProvider returns a tracer provider that sends the spans of the service to the given exporter, in batches.
+func NewTracerProvider(exporter sdktrace.SpanExporter) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(ServiceName))),
+	)
+}
@@ -0,0 +1,53 @@
+package tracing_test
+
+import (
+	"context"
+	"github.com/in-rich/uservice-subscription/config"
+	"github.com/in-rich/uservice-subscription/pkg/tracing"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewExporter(t *testing.T) {
+	testData := []struct {
+		name string
+
+		cfg config.TracingInformation
+
+		expectExporter bool
+		expectErr      error
+	}{
+		{
+			name: "Disabled",
+		},
+		{
+			name: "OTLP",
+			cfg: config.TracingInformation{
+				Exporter: config.TracingExporterOTLP,
+				Endpoint: "localhost:4317",
+				Insecure: true,
+			},
+			expectExporter: true,
+		},
+		{
+			name: "UnknownExporter",
+			cfg: config.TracingInformation{
+				Exporter: "zipkin",
+			},
+			expectErr: config.ErrUnknownTracingExporter,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter, err := tracing.NewExporter(context.TODO(), tt.cfg)
+
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.expectExporter, exporter != nil)
+
+			if exporter != nil {
+				require.NoError(t, exporter.Shutdown(context.TODO()))
+			}
+		})
+	}
+}
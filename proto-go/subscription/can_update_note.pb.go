@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.4
+// source: proto/subscription/can_update_note.proto
+
+package subscription_pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CanUpdateNoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The target of a note indicates what type of LinkedIn profile this note belongs to.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The vanity name of the LinkedIn profile, as it appears in its LinkedIn profile URL.
+	PublicIdentifier string `protobuf:"bytes,2,opt,name=public_identifier,json=publicIdentifier,proto3" json:"public_identifier,omitempty"`
+	// The id of the note's author.
+	AuthorId string `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Only return the remaining number of edits, without counting this request as an edit.
+	ReadOnly bool `protobuf:"varint,4,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// An optional key that identifies retries of the same request. A request replayed with the same key within 24 hours
+	// returns the result of the original request, without counting a new edit.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *CanUpdateNoteRequest) Reset() {
+	*x = CanUpdateNoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_can_update_note_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanUpdateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanUpdateNoteRequest) ProtoMessage() {}
+
+func (x *CanUpdateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_can_update_note_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanUpdateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CanUpdateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_can_update_note_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CanUpdateNoteRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *CanUpdateNoteRequest) GetPublicIdentifier() string {
+	if x != nil {
+		return x.PublicIdentifier
+	}
+	return ""
+}
+
+func (x *CanUpdateNoteRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CanUpdateNoteRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *CanUpdateNoteRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type CanUpdateNoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of remaining edits the user can still perform.
+	RemainingEdits int32 `protobuf:"varint,1,opt,name=remaining_edits,json=remainingEdits,proto3" json:"remaining_edits,omitempty"`
+	// The subscription of the user failed to renew. Clients should ask the user to update their payment method, before
+	// the paid limits stop applying.
+	BillingWarning bool `protobuf:"varint,2,opt,name=billing_warning,json=billingWarning,proto3" json:"billing_warning,omitempty"`
+	// The id of the edit counted by this request. Pass it to ReleaseNoteEdit if the note fails to update. Unset when no
+	// new edit was counted, in read only mode or when the note was edited recently.
+	NoteEditId *string `protobuf:"bytes,3,opt,name=note_edit_id,json=noteEditId,proto3,oneof" json:"note_edit_id,omitempty"`
+	// The time at which the counted edit expires, unless committed before. Unset when the edit is committed right away.
+	ReservationExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reservation_expires_at,json=reservationExpiresAt,proto3,oneof" json:"reservation_expires_at,omitempty"`
+}
+
+func (x *CanUpdateNoteResponse) Reset() {
+	*x = CanUpdateNoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_can_update_note_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanUpdateNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanUpdateNoteResponse) ProtoMessage() {}
+
+func (x *CanUpdateNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_can_update_note_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanUpdateNoteResponse.ProtoReflect.Descriptor instead.
+func (*CanUpdateNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_can_update_note_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CanUpdateNoteResponse) GetRemainingEdits() int32 {
+	if x != nil {
+		return x.RemainingEdits
+	}
+	return 0
+}
+
+func (x *CanUpdateNoteResponse) GetBillingWarning() bool {
+	if x != nil {
+		return x.BillingWarning
+	}
+	return false
+}
+
+func (x *CanUpdateNoteResponse) GetNoteEditId() string {
+	if x != nil && x.NoteEditId != nil {
+		return *x.NoteEditId
+	}
+	return ""
+}
+
+func (x *CanUpdateNoteResponse) GetReservationExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReservationExpiresAt
+	}
+	return nil
+}
+
+var File_proto_subscription_can_update_note_proto protoreflect.FileDescriptor
+
+var file_proto_subscription_can_update_note_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x61, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x01, 0x0a, 0x14, 0x43, 0x61,
+	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x93, 0x02, 0x0a, 0x15, 0x43,
+	0x61, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x45, 0x64, 0x69, 0x74, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x57,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x65,
+	0x64, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
+	0x6e, 0x6f, 0x74, 0x65, 0x45, 0x64, 0x69, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a,
+	0x16, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x14, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x32, 0x6b, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+	0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f,
+	0x74, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x27, 0x5a,
+	0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_subscription_can_update_note_proto_rawDescOnce sync.Once
+	file_proto_subscription_can_update_note_proto_rawDescData = file_proto_subscription_can_update_note_proto_rawDesc
+)
+
+func file_proto_subscription_can_update_note_proto_rawDescGZIP() []byte {
+	file_proto_subscription_can_update_note_proto_rawDescOnce.Do(func() {
+		file_proto_subscription_can_update_note_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_subscription_can_update_note_proto_rawDescData)
+	})
+	return file_proto_subscription_can_update_note_proto_rawDescData
+}
+
+var file_proto_subscription_can_update_note_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_subscription_can_update_note_proto_goTypes = []any{
+	(*CanUpdateNoteRequest)(nil),  // 0: subscription.CanUpdateNoteRequest
+	(*CanUpdateNoteResponse)(nil), // 1: subscription.CanUpdateNoteResponse
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_proto_subscription_can_update_note_proto_depIdxs = []int32{
+	2, // 0: subscription.CanUpdateNoteResponse.reservation_expires_at:type_name -> google.protobuf.Timestamp
+	0, // 1: subscription.CanUpdateNote.CanUpdateNote:input_type -> subscription.CanUpdateNoteRequest
+	1, // 2: subscription.CanUpdateNote.CanUpdateNote:output_type -> subscription.CanUpdateNoteResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_subscription_can_update_note_proto_init() }
+func file_proto_subscription_can_update_note_proto_init() {
+	if File_proto_subscription_can_update_note_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_subscription_can_update_note_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CanUpdateNoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_subscription_can_update_note_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CanUpdateNoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_subscription_can_update_note_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_subscription_can_update_note_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_subscription_can_update_note_proto_goTypes,
+		DependencyIndexes: file_proto_subscription_can_update_note_proto_depIdxs,
+		MessageInfos:      file_proto_subscription_can_update_note_proto_msgTypes,
+	}.Build()
+	File_proto_subscription_can_update_note_proto = out.File
+	file_proto_subscription_can_update_note_proto_rawDesc = nil
+	file_proto_subscription_can_update_note_proto_goTypes = nil
+	file_proto_subscription_can_update_note_proto_depIdxs = nil
+}
@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.4
+// source: proto/subscription/can_update_notes.proto
+
+package subscription_pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NoteKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The target of a note indicates what type of LinkedIn profile this note belongs to.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The vanity name of the LinkedIn profile, as it appears in its LinkedIn profile URL.
+	PublicIdentifier string `protobuf:"bytes,2,opt,name=public_identifier,json=publicIdentifier,proto3" json:"public_identifier,omitempty"`
+}
+
+func (x *NoteKey) Reset() {
+	*x = NoteKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_can_update_notes_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NoteKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteKey) ProtoMessage() {}
+
+func (x *NoteKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_can_update_notes_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteKey.ProtoReflect.Descriptor instead.
+func (*NoteKey) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_can_update_notes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NoteKey) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *NoteKey) GetPublicIdentifier() string {
+	if x != nil {
+		return x.PublicIdentifier
+	}
+	return ""
+}
+
+type CanUpdateNotesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the notes' author.
+	AuthorId string `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// The notes to check, up to 100.
+	Notes []*NoteKey `protobuf:"bytes,2,rep,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *CanUpdateNotesRequest) Reset() {
+	*x = CanUpdateNotesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_can_update_notes_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanUpdateNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanUpdateNotesRequest) ProtoMessage() {}
+
+func (x *CanUpdateNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_can_update_notes_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanUpdateNotesRequest.ProtoReflect.Descriptor instead.
+func (*CanUpdateNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_can_update_notes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CanUpdateNotesRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CanUpdateNotesRequest) GetNotes() []*NoteKey {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type NoteEditSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Note *NoteKey `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	// The note was edited recently, so a new edit would not count.
+	InSession bool `protobuf:"varint,2,opt,name=in_session,json=inSession,proto3" json:"in_session,omitempty"`
+}
+
+func (x *NoteEditSession) Reset() {
+	*x = NoteEditSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_can_update_notes_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NoteEditSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteEditSession) ProtoMessage() {}
+
+func (x *NoteEditSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_can_update_notes_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteEditSession.ProtoReflect.Descriptor instead.
+func (*NoteEditSession) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_can_update_notes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NoteEditSession) GetNote() *NoteKey {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *NoteEditSession) GetInSession() bool {
+	if x != nil {
+		return x.InSession
+	}
+	return false
+}
+
+type CanUpdateNotesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of remaining edits the user can still perform. Notes outside their edit session can only be edited if
+	// this is positive.
+	RemainingEdits int32 `protobuf:"varint,1,opt,name=remaining_edits,json=remainingEdits,proto3" json:"remaining_edits,omitempty"`
+	// The subscription of the user failed to renew. Clients should ask the user to update their payment method, before
+	// the paid limits stop applying.
+	BillingWarning bool `protobuf:"varint,2,opt,name=billing_warning,json=billingWarning,proto3" json:"billing_warning,omitempty"`
+	// The notes of the request, in the same order.
+	Notes []*NoteEditSession `protobuf:"bytes,3,rep,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *CanUpdateNotesResponse) Reset() {
+	*x = CanUpdateNotesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_can_update_notes_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanUpdateNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanUpdateNotesResponse) ProtoMessage() {}
+
+func (x *CanUpdateNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_can_update_notes_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanUpdateNotesResponse.ProtoReflect.Descriptor instead.
+func (*CanUpdateNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_can_update_notes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CanUpdateNotesResponse) GetRemainingEdits() int32 {
+	if x != nil {
+		return x.RemainingEdits
+	}
+	return 0
+}
+
+func (x *CanUpdateNotesResponse) GetBillingWarning() bool {
+	if x != nil {
+		return x.BillingWarning
+	}
+	return false
+}
+
+func (x *CanUpdateNotesResponse) GetNotes() []*NoteEditSession {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+var File_proto_subscription_can_update_notes_proto protoreflect.FileDescriptor
+
+var file_proto_subscription_can_update_notes_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x61, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x07, 0x4e, 0x6f, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x61, 0x0a, 0x15, 0x43, 0x61, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x2b, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x0f,
+	0x4e, 0x6f, 0x74, 0x65, 0x45, 0x64, 0x69, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e,
+	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9f, 0x01, 0x0a, 0x16, 0x43, 0x61,
+	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x45, 0x64, 0x69, 0x74, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x57,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x64, 0x69, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x32, 0x6f, 0x0a, 0x0e, 0x43,
+	0x61, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x5d, 0x0a,
+	0x0e, 0x43, 0x61, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x23, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
+	0x61, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_subscription_can_update_notes_proto_rawDescOnce sync.Once
+	file_proto_subscription_can_update_notes_proto_rawDescData = file_proto_subscription_can_update_notes_proto_rawDesc
+)
+
+func file_proto_subscription_can_update_notes_proto_rawDescGZIP() []byte {
+	file_proto_subscription_can_update_notes_proto_rawDescOnce.Do(func() {
+		file_proto_subscription_can_update_notes_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_subscription_can_update_notes_proto_rawDescData)
+	})
+	return file_proto_subscription_can_update_notes_proto_rawDescData
+}
+
+var file_proto_subscription_can_update_notes_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_subscription_can_update_notes_proto_goTypes = []any{
+	(*NoteKey)(nil),                // 0: subscription.NoteKey
+	(*CanUpdateNotesRequest)(nil),  // 1: subscription.CanUpdateNotesRequest
+	(*NoteEditSession)(nil),        // 2: subscription.NoteEditSession
+	(*CanUpdateNotesResponse)(nil), // 3: subscription.CanUpdateNotesResponse
+}
+var file_proto_subscription_can_update_notes_proto_depIdxs = []int32{
+	0, // 0: subscription.CanUpdateNotesRequest.notes:type_name -> subscription.NoteKey
+	0, // 1: subscription.NoteEditSession.note:type_name -> subscription.NoteKey
+	2, // 2: subscription.CanUpdateNotesResponse.notes:type_name -> subscription.NoteEditSession
+	1, // 3: subscription.CanUpdateNotes.CanUpdateNotes:input_type -> subscription.CanUpdateNotesRequest
+	3, // 4: subscription.CanUpdateNotes.CanUpdateNotes:output_type -> subscription.CanUpdateNotesResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_subscription_can_update_notes_proto_init() }
+func file_proto_subscription_can_update_notes_proto_init() {
+	if File_proto_subscription_can_update_notes_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_subscription_can_update_notes_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*NoteKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_subscription_can_update_notes_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CanUpdateNotesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_subscription_can_update_notes_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*NoteEditSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_subscription_can_update_notes_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CanUpdateNotesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_subscription_can_update_notes_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_subscription_can_update_notes_proto_goTypes,
+		DependencyIndexes: file_proto_subscription_can_update_notes_proto_depIdxs,
+		MessageInfos:      file_proto_subscription_can_update_notes_proto_msgTypes,
+	}.Build()
+	File_proto_subscription_can_update_notes_proto = out.File
+	file_proto_subscription_can_update_notes_proto_rawDesc = nil
+	file_proto_subscription_can_update_notes_proto_goTypes = nil
+	file_proto_subscription_can_update_notes_proto_depIdxs = nil
+}
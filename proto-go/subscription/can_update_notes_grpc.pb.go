@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/can_update_notes.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CanUpdateNotes_CanUpdateNotes_FullMethodName = "/subscription.CanUpdateNotes/CanUpdateNotes"
+)
+
+// CanUpdateNotesClient is the client API for CanUpdateNotes service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CanUpdateNotesClient interface {
+	// Check whether many notes of the same author can be edited, without counting any edit. Each note reports whether
+	// it is inside its edit session, in which case an edit would not count. Use CanUpdateNote to count the edit when
+	// the note is actually updated.
+	CanUpdateNotes(ctx context.Context, in *CanUpdateNotesRequest, opts ...grpc.CallOption) (*CanUpdateNotesResponse, error)
+}
+
+type canUpdateNotesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCanUpdateNotesClient(cc grpc.ClientConnInterface) CanUpdateNotesClient {
+	return &canUpdateNotesClient{cc}
+}
+
+func (c *canUpdateNotesClient) CanUpdateNotes(ctx context.Context, in *CanUpdateNotesRequest, opts ...grpc.CallOption) (*CanUpdateNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanUpdateNotesResponse)
+	err := c.cc.Invoke(ctx, CanUpdateNotes_CanUpdateNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CanUpdateNotesServer is the server API for CanUpdateNotes service.
+// All implementations must embed UnimplementedCanUpdateNotesServer
+// for forward compatibility.
+type CanUpdateNotesServer interface {
+	// Check whether many notes of the same author can be edited, without counting any edit. Each note reports whether
+	// it is inside its edit session, in which case an edit would not count. Use CanUpdateNote to count the edit when
+	// the note is actually updated.
+	CanUpdateNotes(context.Context, *CanUpdateNotesRequest) (*CanUpdateNotesResponse, error)
+	mustEmbedUnimplementedCanUpdateNotesServer()
+}
+
+// UnimplementedCanUpdateNotesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCanUpdateNotesServer struct{}
+
+func (UnimplementedCanUpdateNotesServer) CanUpdateNotes(context.Context, *CanUpdateNotesRequest) (*CanUpdateNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanUpdateNotes not implemented")
+}
+func (UnimplementedCanUpdateNotesServer) mustEmbedUnimplementedCanUpdateNotesServer() {}
+func (UnimplementedCanUpdateNotesServer) testEmbeddedByValue()                        {}
+
+// UnsafeCanUpdateNotesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CanUpdateNotesServer will
+// result in compilation errors.
+type UnsafeCanUpdateNotesServer interface {
+	mustEmbedUnimplementedCanUpdateNotesServer()
+}
+
+func RegisterCanUpdateNotesServer(s grpc.ServiceRegistrar, srv CanUpdateNotesServer) {
+	// If the following call pancis, it indicates UnimplementedCanUpdateNotesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CanUpdateNotes_ServiceDesc, srv)
+}
+
+func _CanUpdateNotes_CanUpdateNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanUpdateNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanUpdateNotesServer).CanUpdateNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CanUpdateNotes_CanUpdateNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanUpdateNotesServer).CanUpdateNotes(ctx, req.(*CanUpdateNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CanUpdateNotes_ServiceDesc is the grpc.ServiceDesc for CanUpdateNotes service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CanUpdateNotes_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.CanUpdateNotes",
+	HandlerType: (*CanUpdateNotesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CanUpdateNotes",
+			Handler:    _CanUpdateNotes_CanUpdateNotes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/can_update_notes.proto",
+}
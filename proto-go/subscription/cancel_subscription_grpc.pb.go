@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/cancel_subscription.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CancelSubscription_CancelSubscription_FullMethodName = "/subscription.CancelSubscription/CancelSubscription"
+)
+
+// CancelSubscriptionClient is the client API for CancelSubscription service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CancelSubscriptionClient interface {
+	// Cancel the running subscription of a user.
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+}
+
+type cancelSubscriptionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCancelSubscriptionClient(cc grpc.ClientConnInterface) CancelSubscriptionClient {
+	return &cancelSubscriptionClient{cc}
+}
+
+func (c *cancelSubscriptionClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, CancelSubscription_CancelSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CancelSubscriptionServer is the server API for CancelSubscription service.
+// All implementations must embed UnimplementedCancelSubscriptionServer
+// for forward compatibility.
+type CancelSubscriptionServer interface {
+	// Cancel the running subscription of a user.
+	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*Subscription, error)
+	mustEmbedUnimplementedCancelSubscriptionServer()
+}
+
+// UnimplementedCancelSubscriptionServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCancelSubscriptionServer struct{}
+
+func (UnimplementedCancelSubscriptionServer) CancelSubscription(context.Context, *CancelSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (UnimplementedCancelSubscriptionServer) mustEmbedUnimplementedCancelSubscriptionServer() {}
+func (UnimplementedCancelSubscriptionServer) testEmbeddedByValue()                            {}
+
+// UnsafeCancelSubscriptionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CancelSubscriptionServer will
+// result in compilation errors.
+type UnsafeCancelSubscriptionServer interface {
+	mustEmbedUnimplementedCancelSubscriptionServer()
+}
+
+func RegisterCancelSubscriptionServer(s grpc.ServiceRegistrar, srv CancelSubscriptionServer) {
+	// If the following call pancis, it indicates UnimplementedCancelSubscriptionServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CancelSubscription_ServiceDesc, srv)
+}
+
+func _CancelSubscription_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CancelSubscriptionServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CancelSubscription_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CancelSubscriptionServer).CancelSubscription(ctx, req.(*CancelSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CancelSubscription_ServiceDesc is the grpc.ServiceDesc for CancelSubscription service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CancelSubscription_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.CancelSubscription",
+	HandlerType: (*CancelSubscriptionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _CancelSubscription_CancelSubscription_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/cancel_subscription.proto",
+}
@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.4
+// source: proto/subscription/change_subscription_tier.proto
+
+package subscription_pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChangeSubscriptionTierRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the subscribed user.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The name of the new tier.
+	Tier string `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+}
+
+func (x *ChangeSubscriptionTierRequest) Reset() {
+	*x = ChangeSubscriptionTierRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_change_subscription_tier_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeSubscriptionTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSubscriptionTierRequest) ProtoMessage() {}
+
+func (x *ChangeSubscriptionTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_change_subscription_tier_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSubscriptionTierRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSubscriptionTierRequest) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_change_subscription_tier_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChangeSubscriptionTierRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangeSubscriptionTierRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+var File_proto_subscription_change_subscription_tier_proto protoreflect.FileDescriptor
+
+var file_proto_subscription_change_subscription_tier_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x4c, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72,
+	0x32, 0x7d, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x65, 0x72, 0x12, 0x63, 0x0a, 0x16, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x42,
+	0x27, 0x5a, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_subscription_change_subscription_tier_proto_rawDescOnce sync.Once
+	file_proto_subscription_change_subscription_tier_proto_rawDescData = file_proto_subscription_change_subscription_tier_proto_rawDesc
+)
+
+func file_proto_subscription_change_subscription_tier_proto_rawDescGZIP() []byte {
+	file_proto_subscription_change_subscription_tier_proto_rawDescOnce.Do(func() {
+		file_proto_subscription_change_subscription_tier_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_subscription_change_subscription_tier_proto_rawDescData)
+	})
+	return file_proto_subscription_change_subscription_tier_proto_rawDescData
+}
+
+var file_proto_subscription_change_subscription_tier_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_subscription_change_subscription_tier_proto_goTypes = []any{
+	(*ChangeSubscriptionTierRequest)(nil), // 0: subscription.ChangeSubscriptionTierRequest
+	(*Subscription)(nil),                  // 1: subscription.Subscription
+}
+var file_proto_subscription_change_subscription_tier_proto_depIdxs = []int32{
+	0, // 0: subscription.ChangeSubscriptionTier.ChangeSubscriptionTier:input_type -> subscription.ChangeSubscriptionTierRequest
+	1, // 1: subscription.ChangeSubscriptionTier.ChangeSubscriptionTier:output_type -> subscription.Subscription
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_subscription_change_subscription_tier_proto_init() }
+func file_proto_subscription_change_subscription_tier_proto_init() {
+	if File_proto_subscription_change_subscription_tier_proto != nil {
+		return
+	}
+	file_proto_subscription_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_subscription_change_subscription_tier_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeSubscriptionTierRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_subscription_change_subscription_tier_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_subscription_change_subscription_tier_proto_goTypes,
+		DependencyIndexes: file_proto_subscription_change_subscription_tier_proto_depIdxs,
+		MessageInfos:      file_proto_subscription_change_subscription_tier_proto_msgTypes,
+	}.Build()
+	File_proto_subscription_change_subscription_tier_proto = out.File
+	file_proto_subscription_change_subscription_tier_proto_rawDesc = nil
+	file_proto_subscription_change_subscription_tier_proto_goTypes = nil
+	file_proto_subscription_change_subscription_tier_proto_depIdxs = nil
+}
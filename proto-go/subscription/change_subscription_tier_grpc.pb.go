@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/change_subscription_tier.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ChangeSubscriptionTier_ChangeSubscriptionTier_FullMethodName = "/subscription.ChangeSubscriptionTier/ChangeSubscriptionTier"
+)
+
+// ChangeSubscriptionTierClient is the client API for ChangeSubscriptionTier service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChangeSubscriptionTierClient interface {
+	// Move the running subscription of a user to a new tier. The change applies immediately.
+	ChangeSubscriptionTier(ctx context.Context, in *ChangeSubscriptionTierRequest, opts ...grpc.CallOption) (*Subscription, error)
+}
+
+type changeSubscriptionTierClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChangeSubscriptionTierClient(cc grpc.ClientConnInterface) ChangeSubscriptionTierClient {
+	return &changeSubscriptionTierClient{cc}
+}
+
+func (c *changeSubscriptionTierClient) ChangeSubscriptionTier(ctx context.Context, in *ChangeSubscriptionTierRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, ChangeSubscriptionTier_ChangeSubscriptionTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChangeSubscriptionTierServer is the server API for ChangeSubscriptionTier service.
+// All implementations must embed UnimplementedChangeSubscriptionTierServer
+// for forward compatibility.
+type ChangeSubscriptionTierServer interface {
+	// Move the running subscription of a user to a new tier. The change applies immediately.
+	ChangeSubscriptionTier(context.Context, *ChangeSubscriptionTierRequest) (*Subscription, error)
+	mustEmbedUnimplementedChangeSubscriptionTierServer()
+}
+
+// UnimplementedChangeSubscriptionTierServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChangeSubscriptionTierServer struct{}
+
+func (UnimplementedChangeSubscriptionTierServer) ChangeSubscriptionTier(context.Context, *ChangeSubscriptionTierRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSubscriptionTier not implemented")
+}
+func (UnimplementedChangeSubscriptionTierServer) mustEmbedUnimplementedChangeSubscriptionTierServer() {
+}
+func (UnimplementedChangeSubscriptionTierServer) testEmbeddedByValue() {}
+
+// UnsafeChangeSubscriptionTierServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChangeSubscriptionTierServer will
+// result in compilation errors.
+type UnsafeChangeSubscriptionTierServer interface {
+	mustEmbedUnimplementedChangeSubscriptionTierServer()
+}
+
+func RegisterChangeSubscriptionTierServer(s grpc.ServiceRegistrar, srv ChangeSubscriptionTierServer) {
+	// If the following call pancis, it indicates UnimplementedChangeSubscriptionTierServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChangeSubscriptionTier_ServiceDesc, srv)
+}
+
+func _ChangeSubscriptionTier_ChangeSubscriptionTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSubscriptionTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangeSubscriptionTierServer).ChangeSubscriptionTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChangeSubscriptionTier_ChangeSubscriptionTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangeSubscriptionTierServer).ChangeSubscriptionTier(ctx, req.(*ChangeSubscriptionTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChangeSubscriptionTier_ServiceDesc is the grpc.ServiceDesc for ChangeSubscriptionTier service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChangeSubscriptionTier_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.ChangeSubscriptionTier",
+	HandlerType: (*ChangeSubscriptionTierServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChangeSubscriptionTier",
+			Handler:    _ChangeSubscriptionTier_ChangeSubscriptionTier_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/change_subscription_tier.proto",
+}
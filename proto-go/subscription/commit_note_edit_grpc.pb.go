@@ -0,0 +1,126 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/commit_note_edit.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CommitNoteEdit_CommitNoteEdit_FullMethodName = "/subscription.CommitNoteEdit/CommitNoteEdit"
+)
+
+// CommitNoteEditClient is the client API for CommitNoteEdit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommitNoteEditClient interface {
+	// Confirm an edit reserved by CanUpdateNote, once the note it was counted for is durably updated. Committing an
+	// edit twice has no effect. Edits that expired or were released cannot be committed.
+	CommitNoteEdit(ctx context.Context, in *CommitNoteEditRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type commitNoteEditClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommitNoteEditClient(cc grpc.ClientConnInterface) CommitNoteEditClient {
+	return &commitNoteEditClient{cc}
+}
+
+func (c *commitNoteEditClient) CommitNoteEdit(ctx context.Context, in *CommitNoteEditRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommitNoteEdit_CommitNoteEdit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommitNoteEditServer is the server API for CommitNoteEdit service.
+// All implementations must embed UnimplementedCommitNoteEditServer
+// for forward compatibility.
+type CommitNoteEditServer interface {
+	// Confirm an edit reserved by CanUpdateNote, once the note it was counted for is durably updated. Committing an
+	// edit twice has no effect. Edits that expired or were released cannot be committed.
+	CommitNoteEdit(context.Context, *CommitNoteEditRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCommitNoteEditServer()
+}
+
+// UnimplementedCommitNoteEditServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCommitNoteEditServer struct{}
+
+func (UnimplementedCommitNoteEditServer) CommitNoteEdit(context.Context, *CommitNoteEditRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitNoteEdit not implemented")
+}
+func (UnimplementedCommitNoteEditServer) mustEmbedUnimplementedCommitNoteEditServer() {}
+func (UnimplementedCommitNoteEditServer) testEmbeddedByValue()                        {}
+
+// UnsafeCommitNoteEditServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommitNoteEditServer will
+// result in compilation errors.
+type UnsafeCommitNoteEditServer interface {
+	mustEmbedUnimplementedCommitNoteEditServer()
+}
+
+func RegisterCommitNoteEditServer(s grpc.ServiceRegistrar, srv CommitNoteEditServer) {
+	// If the following call pancis, it indicates UnimplementedCommitNoteEditServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CommitNoteEdit_ServiceDesc, srv)
+}
+
+func _CommitNoteEdit_CommitNoteEdit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitNoteEditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommitNoteEditServer).CommitNoteEdit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommitNoteEdit_CommitNoteEdit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommitNoteEditServer).CommitNoteEdit(ctx, req.(*CommitNoteEditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommitNoteEdit_ServiceDesc is the grpc.ServiceDesc for CommitNoteEdit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CommitNoteEdit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.CommitNoteEdit",
+	HandlerType: (*CommitNoteEditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CommitNoteEdit",
+			Handler:    _CommitNoteEdit_CommitNoteEdit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/commit_note_edit.proto",
+}
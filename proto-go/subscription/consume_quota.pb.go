@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.4
+// source: proto/subscription/consume_quota.proto
+
+package subscription_pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConsumeQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the metered feature, as configured in the tiers.
+	Feature string `protobuf:"bytes,1,opt,name=feature,proto3" json:"feature,omitempty"`
+	// The id of the user the use is counted against.
+	SubjectId string `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// Identifies the resource the feature is used on, for example the public identifier of an exported profile.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Only return the remaining number of uses, without counting this request as a use.
+	ReadOnly bool `protobuf:"varint,4,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+}
+
+func (x *ConsumeQuotaRequest) Reset() {
+	*x = ConsumeQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_consume_quota_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumeQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeQuotaRequest) ProtoMessage() {}
+
+func (x *ConsumeQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_consume_quota_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeQuotaRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_consume_quota_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConsumeQuotaRequest) GetFeature() string {
+	if x != nil {
+		return x.Feature
+	}
+	return ""
+}
+
+func (x *ConsumeQuotaRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *ConsumeQuotaRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConsumeQuotaRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type ConsumeQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of remaining uses of the feature the user can still perform.
+	RemainingUses int32 `protobuf:"varint,1,opt,name=remaining_uses,json=remainingUses,proto3" json:"remaining_uses,omitempty"`
+	// The subscription of the user failed to renew. Clients should ask the user to update their payment method, before
+	// the paid limits stop applying.
+	BillingWarning bool `protobuf:"varint,2,opt,name=billing_warning,json=billingWarning,proto3" json:"billing_warning,omitempty"`
+}
+
+func (x *ConsumeQuotaResponse) Reset() {
+	*x = ConsumeQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_consume_quota_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumeQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeQuotaResponse) ProtoMessage() {}
+
+func (x *ConsumeQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_consume_quota_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeQuotaResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_consume_quota_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConsumeQuotaResponse) GetRemainingUses() int32 {
+	if x != nil {
+		return x.RemainingUses
+	}
+	return 0
+}
+
+func (x *ConsumeQuotaResponse) GetBillingWarning() bool {
+	if x != nil {
+		return x.BillingWarning
+	}
+	return false
+}
+
+var File_proto_subscription_consume_quota_proto protoreflect.FileDescriptor
+
+var file_proto_subscription_consume_quota_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x66, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x55, 0x73, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x32, 0x67, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x57, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x21, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d,
+	0x67, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3b,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_subscription_consume_quota_proto_rawDescOnce sync.Once
+	file_proto_subscription_consume_quota_proto_rawDescData = file_proto_subscription_consume_quota_proto_rawDesc
+)
+
+func file_proto_subscription_consume_quota_proto_rawDescGZIP() []byte {
+	file_proto_subscription_consume_quota_proto_rawDescOnce.Do(func() {
+		file_proto_subscription_consume_quota_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_subscription_consume_quota_proto_rawDescData)
+	})
+	return file_proto_subscription_consume_quota_proto_rawDescData
+}
+
+var file_proto_subscription_consume_quota_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_subscription_consume_quota_proto_goTypes = []any{
+	(*ConsumeQuotaRequest)(nil),  // 0: subscription.ConsumeQuotaRequest
+	(*ConsumeQuotaResponse)(nil), // 1: subscription.ConsumeQuotaResponse
+}
+var file_proto_subscription_consume_quota_proto_depIdxs = []int32{
+	0, // 0: subscription.ConsumeQuota.ConsumeQuota:input_type -> subscription.ConsumeQuotaRequest
+	1, // 1: subscription.ConsumeQuota.ConsumeQuota:output_type -> subscription.ConsumeQuotaResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_subscription_consume_quota_proto_init() }
+func file_proto_subscription_consume_quota_proto_init() {
+	if File_proto_subscription_consume_quota_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_subscription_consume_quota_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsumeQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_subscription_consume_quota_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsumeQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_subscription_consume_quota_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_subscription_consume_quota_proto_goTypes,
+		DependencyIndexes: file_proto_subscription_consume_quota_proto_depIdxs,
+		MessageInfos:      file_proto_subscription_consume_quota_proto_msgTypes,
+	}.Build()
+	File_proto_subscription_consume_quota_proto = out.File
+	file_proto_subscription_consume_quota_proto_rawDesc = nil
+	file_proto_subscription_consume_quota_proto_goTypes = nil
+	file_proto_subscription_consume_quota_proto_depIdxs = nil
+}
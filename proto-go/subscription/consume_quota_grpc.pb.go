@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/consume_quota.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ConsumeQuota_ConsumeQuota_FullMethodName = "/subscription.ConsumeQuota/ConsumeQuota"
+)
+
+// ConsumeQuotaClient is the client API for ConsumeQuota service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConsumeQuotaClient interface {
+	// Check if a metered feature can be used. If at least one use is available, count a new use and return the
+	// remaining number of uses. Uses of the same key within the buffer time of the feature only count once.
+	ConsumeQuota(ctx context.Context, in *ConsumeQuotaRequest, opts ...grpc.CallOption) (*ConsumeQuotaResponse, error)
+}
+
+type consumeQuotaClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConsumeQuotaClient(cc grpc.ClientConnInterface) ConsumeQuotaClient {
+	return &consumeQuotaClient{cc}
+}
+
+func (c *consumeQuotaClient) ConsumeQuota(ctx context.Context, in *ConsumeQuotaRequest, opts ...grpc.CallOption) (*ConsumeQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsumeQuotaResponse)
+	err := c.cc.Invoke(ctx, ConsumeQuota_ConsumeQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConsumeQuotaServer is the server API for ConsumeQuota service.
+// All implementations must embed UnimplementedConsumeQuotaServer
+// for forward compatibility.
+type ConsumeQuotaServer interface {
+	// Check if a metered feature can be used. If at least one use is available, count a new use and return the
+	// remaining number of uses. Uses of the same key within the buffer time of the feature only count once.
+	ConsumeQuota(context.Context, *ConsumeQuotaRequest) (*ConsumeQuotaResponse, error)
+	mustEmbedUnimplementedConsumeQuotaServer()
+}
+
+// UnimplementedConsumeQuotaServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConsumeQuotaServer struct{}
+
+func (UnimplementedConsumeQuotaServer) ConsumeQuota(context.Context, *ConsumeQuotaRequest) (*ConsumeQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumeQuota not implemented")
+}
+func (UnimplementedConsumeQuotaServer) mustEmbedUnimplementedConsumeQuotaServer() {}
+func (UnimplementedConsumeQuotaServer) testEmbeddedByValue()                      {}
+
+// UnsafeConsumeQuotaServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConsumeQuotaServer will
+// result in compilation errors.
+type UnsafeConsumeQuotaServer interface {
+	mustEmbedUnimplementedConsumeQuotaServer()
+}
+
+func RegisterConsumeQuotaServer(s grpc.ServiceRegistrar, srv ConsumeQuotaServer) {
+	// If the following call pancis, it indicates UnimplementedConsumeQuotaServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConsumeQuota_ServiceDesc, srv)
+}
+
+func _ConsumeQuota_ConsumeQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsumeQuotaServer).ConsumeQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsumeQuota_ConsumeQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsumeQuotaServer).ConsumeQuota(ctx, req.(*ConsumeQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConsumeQuota_ServiceDesc is the grpc.ServiceDesc for ConsumeQuota service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConsumeQuota_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.ConsumeQuota",
+	HandlerType: (*ConsumeQuotaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConsumeQuota",
+			Handler:    _ConsumeQuota_ConsumeQuota_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/consume_quota.proto",
+}
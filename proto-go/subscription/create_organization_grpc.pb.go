@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/create_organization.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CreateOrganization_CreateOrganization_FullMethodName = "/subscription.CreateOrganization/CreateOrganization"
+)
+
+// CreateOrganizationClient is the client API for CreateOrganization service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CreateOrganizationClient interface {
+	// Create an organization. Members of an organization with a running subscription share the note edits quota of its
+	// tier.
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+}
+
+type createOrganizationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCreateOrganizationClient(cc grpc.ClientConnInterface) CreateOrganizationClient {
+	return &createOrganizationClient{cc}
+}
+
+func (c *createOrganizationClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, CreateOrganization_CreateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateOrganizationServer is the server API for CreateOrganization service.
+// All implementations must embed UnimplementedCreateOrganizationServer
+// for forward compatibility.
+type CreateOrganizationServer interface {
+	// Create an organization. Members of an organization with a running subscription share the note edits quota of its
+	// tier.
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*Organization, error)
+	mustEmbedUnimplementedCreateOrganizationServer()
+}
+
+// UnimplementedCreateOrganizationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCreateOrganizationServer struct{}
+
+func (UnimplementedCreateOrganizationServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedCreateOrganizationServer) mustEmbedUnimplementedCreateOrganizationServer() {}
+func (UnimplementedCreateOrganizationServer) testEmbeddedByValue()                            {}
+
+// UnsafeCreateOrganizationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CreateOrganizationServer will
+// result in compilation errors.
+type UnsafeCreateOrganizationServer interface {
+	mustEmbedUnimplementedCreateOrganizationServer()
+}
+
+func RegisterCreateOrganizationServer(s grpc.ServiceRegistrar, srv CreateOrganizationServer) {
+	// If the following call pancis, it indicates UnimplementedCreateOrganizationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CreateOrganization_ServiceDesc, srv)
+}
+
+func _CreateOrganization_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreateOrganizationServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreateOrganization_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreateOrganizationServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CreateOrganization_ServiceDesc is the grpc.ServiceDesc for CreateOrganization service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CreateOrganization_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.CreateOrganization",
+	HandlerType: (*CreateOrganizationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _CreateOrganization_CreateOrganization_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/create_organization.proto",
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/create_subscription.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CreateSubscription_CreateSubscription_FullMethodName = "/subscription.CreateSubscription/CreateSubscription"
+)
+
+// CreateSubscriptionClient is the client API for CreateSubscription service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CreateSubscriptionClient interface {
+	// Subscribe a user to a tier. A user can only have one running subscription at a time.
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+}
+
+type createSubscriptionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCreateSubscriptionClient(cc grpc.ClientConnInterface) CreateSubscriptionClient {
+	return &createSubscriptionClient{cc}
+}
+
+func (c *createSubscriptionClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, CreateSubscription_CreateSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateSubscriptionServer is the server API for CreateSubscription service.
+// All implementations must embed UnimplementedCreateSubscriptionServer
+// for forward compatibility.
+type CreateSubscriptionServer interface {
+	// Subscribe a user to a tier. A user can only have one running subscription at a time.
+	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*Subscription, error)
+	mustEmbedUnimplementedCreateSubscriptionServer()
+}
+
+// UnimplementedCreateSubscriptionServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCreateSubscriptionServer struct{}
+
+func (UnimplementedCreateSubscriptionServer) CreateSubscription(context.Context, *CreateSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (UnimplementedCreateSubscriptionServer) mustEmbedUnimplementedCreateSubscriptionServer() {}
+func (UnimplementedCreateSubscriptionServer) testEmbeddedByValue()                            {}
+
+// UnsafeCreateSubscriptionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CreateSubscriptionServer will
+// result in compilation errors.
+type UnsafeCreateSubscriptionServer interface {
+	mustEmbedUnimplementedCreateSubscriptionServer()
+}
+
+func RegisterCreateSubscriptionServer(s grpc.ServiceRegistrar, srv CreateSubscriptionServer) {
+	// If the following call pancis, it indicates UnimplementedCreateSubscriptionServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CreateSubscription_ServiceDesc, srv)
+}
+
+func _CreateSubscription_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreateSubscriptionServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreateSubscription_CreateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreateSubscriptionServer).CreateSubscription(ctx, req.(*CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CreateSubscription_ServiceDesc is the grpc.ServiceDesc for CreateSubscription service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CreateSubscription_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.CreateSubscription",
+	HandlerType: (*CreateSubscriptionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _CreateSubscription_CreateSubscription_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/create_subscription.proto",
+}
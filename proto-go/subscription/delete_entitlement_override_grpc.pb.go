@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/delete_entitlement_override.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DeleteEntitlementOverride_DeleteEntitlementOverride_FullMethodName = "/subscription.DeleteEntitlementOverride/DeleteEntitlementOverride"
+)
+
+// DeleteEntitlementOverrideClient is the client API for DeleteEntitlementOverride service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeleteEntitlementOverrideClient interface {
+	// Remove the override of an entitlement for a user, so only their tier applies.
+	DeleteEntitlementOverride(ctx context.Context, in *DeleteEntitlementOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type deleteEntitlementOverrideClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeleteEntitlementOverrideClient(cc grpc.ClientConnInterface) DeleteEntitlementOverrideClient {
+	return &deleteEntitlementOverrideClient{cc}
+}
+
+func (c *deleteEntitlementOverrideClient) DeleteEntitlementOverride(ctx context.Context, in *DeleteEntitlementOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DeleteEntitlementOverride_DeleteEntitlementOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteEntitlementOverrideServer is the server API for DeleteEntitlementOverride service.
+// All implementations must embed UnimplementedDeleteEntitlementOverrideServer
+// for forward compatibility.
+type DeleteEntitlementOverrideServer interface {
+	// Remove the override of an entitlement for a user, so only their tier applies.
+	DeleteEntitlementOverride(context.Context, *DeleteEntitlementOverrideRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedDeleteEntitlementOverrideServer()
+}
+
+// UnimplementedDeleteEntitlementOverrideServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDeleteEntitlementOverrideServer struct{}
+
+func (UnimplementedDeleteEntitlementOverrideServer) DeleteEntitlementOverride(context.Context, *DeleteEntitlementOverrideRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntitlementOverride not implemented")
+}
+func (UnimplementedDeleteEntitlementOverrideServer) mustEmbedUnimplementedDeleteEntitlementOverrideServer() {
+}
+func (UnimplementedDeleteEntitlementOverrideServer) testEmbeddedByValue() {}
+
+// UnsafeDeleteEntitlementOverrideServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeleteEntitlementOverrideServer will
+// result in compilation errors.
+type UnsafeDeleteEntitlementOverrideServer interface {
+	mustEmbedUnimplementedDeleteEntitlementOverrideServer()
+}
+
+func RegisterDeleteEntitlementOverrideServer(s grpc.ServiceRegistrar, srv DeleteEntitlementOverrideServer) {
+	// If the following call pancis, it indicates UnimplementedDeleteEntitlementOverrideServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DeleteEntitlementOverride_ServiceDesc, srv)
+}
+
+func _DeleteEntitlementOverride_DeleteEntitlementOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEntitlementOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeleteEntitlementOverrideServer).DeleteEntitlementOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeleteEntitlementOverride_DeleteEntitlementOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeleteEntitlementOverrideServer).DeleteEntitlementOverride(ctx, req.(*DeleteEntitlementOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeleteEntitlementOverride_ServiceDesc is the grpc.ServiceDesc for DeleteEntitlementOverride service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeleteEntitlementOverride_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.DeleteEntitlementOverride",
+	HandlerType: (*DeleteEntitlementOverrideServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteEntitlementOverride",
+			Handler:    _DeleteEntitlementOverride_DeleteEntitlementOverride_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/delete_entitlement_override.proto",
+}
@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/delete_quota_override.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DeleteQuotaOverride_DeleteQuotaOverride_FullMethodName = "/subscription.DeleteQuotaOverride/DeleteQuotaOverride"
+)
+
+// DeleteQuotaOverrideClient is the client API for DeleteQuotaOverride service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeleteQuotaOverrideClient interface {
+	// Remove the quota override of an author, so only their tier applies.
+	DeleteQuotaOverride(ctx context.Context, in *DeleteQuotaOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type deleteQuotaOverrideClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeleteQuotaOverrideClient(cc grpc.ClientConnInterface) DeleteQuotaOverrideClient {
+	return &deleteQuotaOverrideClient{cc}
+}
+
+func (c *deleteQuotaOverrideClient) DeleteQuotaOverride(ctx context.Context, in *DeleteQuotaOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DeleteQuotaOverride_DeleteQuotaOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteQuotaOverrideServer is the server API for DeleteQuotaOverride service.
+// All implementations must embed UnimplementedDeleteQuotaOverrideServer
+// for forward compatibility.
+type DeleteQuotaOverrideServer interface {
+	// Remove the quota override of an author, so only their tier applies.
+	DeleteQuotaOverride(context.Context, *DeleteQuotaOverrideRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedDeleteQuotaOverrideServer()
+}
+
+// UnimplementedDeleteQuotaOverrideServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDeleteQuotaOverrideServer struct{}
+
+func (UnimplementedDeleteQuotaOverrideServer) DeleteQuotaOverride(context.Context, *DeleteQuotaOverrideRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuotaOverride not implemented")
+}
+func (UnimplementedDeleteQuotaOverrideServer) mustEmbedUnimplementedDeleteQuotaOverrideServer() {}
+func (UnimplementedDeleteQuotaOverrideServer) testEmbeddedByValue()                             {}
+
+// UnsafeDeleteQuotaOverrideServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeleteQuotaOverrideServer will
+// result in compilation errors.
+type UnsafeDeleteQuotaOverrideServer interface {
+	mustEmbedUnimplementedDeleteQuotaOverrideServer()
+}
+
+func RegisterDeleteQuotaOverrideServer(s grpc.ServiceRegistrar, srv DeleteQuotaOverrideServer) {
+	// If the following call pancis, it indicates UnimplementedDeleteQuotaOverrideServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DeleteQuotaOverride_ServiceDesc, srv)
+}
+
+func _DeleteQuotaOverride_DeleteQuotaOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuotaOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeleteQuotaOverrideServer).DeleteQuotaOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeleteQuotaOverride_DeleteQuotaOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeleteQuotaOverrideServer).DeleteQuotaOverride(ctx, req.(*DeleteQuotaOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeleteQuotaOverride_ServiceDesc is the grpc.ServiceDesc for DeleteQuotaOverride service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeleteQuotaOverride_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.DeleteQuotaOverride",
+	HandlerType: (*DeleteQuotaOverrideServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteQuotaOverride",
+			Handler:    _DeleteQuotaOverride_DeleteQuotaOverride_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/delete_quota_override.proto",
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/get_entitlements.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GetEntitlements_GetEntitlements_FullMethodName = "/subscription.GetEntitlements/GetEntitlements"
+)
+
+// GetEntitlementsClient is the client API for GetEntitlements service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GetEntitlementsClient interface {
+	// Return every entitlement of a user, resolved from their current tier and their active overrides.
+	GetEntitlements(ctx context.Context, in *GetEntitlementsRequest, opts ...grpc.CallOption) (*GetEntitlementsResponse, error)
+}
+
+type getEntitlementsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGetEntitlementsClient(cc grpc.ClientConnInterface) GetEntitlementsClient {
+	return &getEntitlementsClient{cc}
+}
+
+func (c *getEntitlementsClient) GetEntitlements(ctx context.Context, in *GetEntitlementsRequest, opts ...grpc.CallOption) (*GetEntitlementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEntitlementsResponse)
+	err := c.cc.Invoke(ctx, GetEntitlements_GetEntitlements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetEntitlementsServer is the server API for GetEntitlements service.
+// All implementations must embed UnimplementedGetEntitlementsServer
+// for forward compatibility.
+type GetEntitlementsServer interface {
+	// Return every entitlement of a user, resolved from their current tier and their active overrides.
+	GetEntitlements(context.Context, *GetEntitlementsRequest) (*GetEntitlementsResponse, error)
+	mustEmbedUnimplementedGetEntitlementsServer()
+}
+
+// UnimplementedGetEntitlementsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGetEntitlementsServer struct{}
+
+func (UnimplementedGetEntitlementsServer) GetEntitlements(context.Context, *GetEntitlementsRequest) (*GetEntitlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntitlements not implemented")
+}
+func (UnimplementedGetEntitlementsServer) mustEmbedUnimplementedGetEntitlementsServer() {}
+func (UnimplementedGetEntitlementsServer) testEmbeddedByValue()                         {}
+
+// UnsafeGetEntitlementsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GetEntitlementsServer will
+// result in compilation errors.
+type UnsafeGetEntitlementsServer interface {
+	mustEmbedUnimplementedGetEntitlementsServer()
+}
+
+func RegisterGetEntitlementsServer(s grpc.ServiceRegistrar, srv GetEntitlementsServer) {
+	// If the following call pancis, it indicates UnimplementedGetEntitlementsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GetEntitlements_ServiceDesc, srv)
+}
+
+func _GetEntitlements_GetEntitlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntitlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GetEntitlementsServer).GetEntitlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GetEntitlements_GetEntitlements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GetEntitlementsServer).GetEntitlements(ctx, req.(*GetEntitlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GetEntitlements_ServiceDesc is the grpc.ServiceDesc for GetEntitlements service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GetEntitlements_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.GetEntitlements",
+	HandlerType: (*GetEntitlementsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEntitlements",
+			Handler:    _GetEntitlements_GetEntitlements_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/get_entitlements.proto",
+}
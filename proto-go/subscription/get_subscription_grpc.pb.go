@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/get_subscription.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GetSubscription_GetSubscription_FullMethodName = "/subscription.GetSubscription/GetSubscription"
+)
+
+// GetSubscriptionClient is the client API for GetSubscription service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GetSubscriptionClient interface {
+	// Return the latest subscription of a user, whether it is still running or not.
+	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+}
+
+type getSubscriptionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGetSubscriptionClient(cc grpc.ClientConnInterface) GetSubscriptionClient {
+	return &getSubscriptionClient{cc}
+}
+
+func (c *getSubscriptionClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, GetSubscription_GetSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetSubscriptionServer is the server API for GetSubscription service.
+// All implementations must embed UnimplementedGetSubscriptionServer
+// for forward compatibility.
+type GetSubscriptionServer interface {
+	// Return the latest subscription of a user, whether it is still running or not.
+	GetSubscription(context.Context, *GetSubscriptionRequest) (*Subscription, error)
+	mustEmbedUnimplementedGetSubscriptionServer()
+}
+
+// UnimplementedGetSubscriptionServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGetSubscriptionServer struct{}
+
+func (UnimplementedGetSubscriptionServer) GetSubscription(context.Context, *GetSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+}
+func (UnimplementedGetSubscriptionServer) mustEmbedUnimplementedGetSubscriptionServer() {}
+func (UnimplementedGetSubscriptionServer) testEmbeddedByValue()                         {}
+
+// UnsafeGetSubscriptionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GetSubscriptionServer will
+// result in compilation errors.
+type UnsafeGetSubscriptionServer interface {
+	mustEmbedUnimplementedGetSubscriptionServer()
+}
+
+func RegisterGetSubscriptionServer(s grpc.ServiceRegistrar, srv GetSubscriptionServer) {
+	// If the following call pancis, it indicates UnimplementedGetSubscriptionServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GetSubscription_ServiceDesc, srv)
+}
+
+func _GetSubscription_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GetSubscriptionServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GetSubscription_GetSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GetSubscriptionServer).GetSubscription(ctx, req.(*GetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GetSubscription_ServiceDesc is the grpc.ServiceDesc for GetSubscription service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GetSubscription_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.GetSubscription",
+	HandlerType: (*GetSubscriptionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSubscription",
+			Handler:    _GetSubscription_GetSubscription_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/get_subscription.proto",
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/get_usage.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GetUsage_GetUsage_FullMethodName = "/subscription.GetUsage/GetUsage"
+)
+
+// GetUsageClient is the client API for GetUsage service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GetUsageClient interface {
+	// Return how much of their note edits quota a user has consumed, without counting a new edit.
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+}
+
+type getUsageClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGetUsageClient(cc grpc.ClientConnInterface) GetUsageClient {
+	return &getUsageClient{cc}
+}
+
+func (c *getUsageClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, GetUsage_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetUsageServer is the server API for GetUsage service.
+// All implementations must embed UnimplementedGetUsageServer
+// for forward compatibility.
+type GetUsageServer interface {
+	// Return how much of their note edits quota a user has consumed, without counting a new edit.
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	mustEmbedUnimplementedGetUsageServer()
+}
+
+// UnimplementedGetUsageServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGetUsageServer struct{}
+
+func (UnimplementedGetUsageServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedGetUsageServer) mustEmbedUnimplementedGetUsageServer() {}
+func (UnimplementedGetUsageServer) testEmbeddedByValue()                  {}
+
+// UnsafeGetUsageServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GetUsageServer will
+// result in compilation errors.
+type UnsafeGetUsageServer interface {
+	mustEmbedUnimplementedGetUsageServer()
+}
+
+func RegisterGetUsageServer(s grpc.ServiceRegistrar, srv GetUsageServer) {
+	// If the following call pancis, it indicates UnimplementedGetUsageServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GetUsage_ServiceDesc, srv)
+}
+
+func _GetUsage_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GetUsageServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GetUsage_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GetUsageServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GetUsage_ServiceDesc is the grpc.ServiceDesc for GetUsage service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GetUsage_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.GetUsage",
+	HandlerType: (*GetUsageServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUsage",
+			Handler:    _GetUsage_GetUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/get_usage.proto",
+}
@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/list_organization_members.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ListOrganizationMembers_ListOrganizationMembers_FullMethodName = "/subscription.ListOrganizationMembers/ListOrganizationMembers"
+)
+
+// ListOrganizationMembersClient is the client API for ListOrganizationMembers service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ListOrganizationMembersClient interface {
+	// Return the members of an organization.
+	ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error)
+}
+
+type listOrganizationMembersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewListOrganizationMembersClient(cc grpc.ClientConnInterface) ListOrganizationMembersClient {
+	return &listOrganizationMembersClient{cc}
+}
+
+func (c *listOrganizationMembersClient) ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizationMembersResponse)
+	err := c.cc.Invoke(ctx, ListOrganizationMembers_ListOrganizationMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListOrganizationMembersServer is the server API for ListOrganizationMembers service.
+// All implementations must embed UnimplementedListOrganizationMembersServer
+// for forward compatibility.
+type ListOrganizationMembersServer interface {
+	// Return the members of an organization.
+	ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
+	mustEmbedUnimplementedListOrganizationMembersServer()
+}
+
+// UnimplementedListOrganizationMembersServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedListOrganizationMembersServer struct{}
+
+func (UnimplementedListOrganizationMembersServer) ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMembers not implemented")
+}
+func (UnimplementedListOrganizationMembersServer) mustEmbedUnimplementedListOrganizationMembersServer() {
+}
+func (UnimplementedListOrganizationMembersServer) testEmbeddedByValue() {}
+
+// UnsafeListOrganizationMembersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ListOrganizationMembersServer will
+// result in compilation errors.
+type UnsafeListOrganizationMembersServer interface {
+	mustEmbedUnimplementedListOrganizationMembersServer()
+}
+
+func RegisterListOrganizationMembersServer(s grpc.ServiceRegistrar, srv ListOrganizationMembersServer) {
+	// If the following call pancis, it indicates UnimplementedListOrganizationMembersServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ListOrganizationMembers_ServiceDesc, srv)
+}
+
+func _ListOrganizationMembers_ListOrganizationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListOrganizationMembersServer).ListOrganizationMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListOrganizationMembers_ListOrganizationMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListOrganizationMembersServer).ListOrganizationMembers(ctx, req.(*ListOrganizationMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ListOrganizationMembers_ServiceDesc is the grpc.ServiceDesc for ListOrganizationMembers service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ListOrganizationMembers_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.ListOrganizationMembers",
+	HandlerType: (*ListOrganizationMembersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListOrganizationMembers",
+			Handler:    _ListOrganizationMembers_ListOrganizationMembers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/list_organization_members.proto",
+}
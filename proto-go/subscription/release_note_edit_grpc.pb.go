@@ -0,0 +1,126 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/release_note_edit.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReleaseNoteEdit_ReleaseNoteEdit_FullMethodName = "/subscription.ReleaseNoteEdit/ReleaseNoteEdit"
+)
+
+// ReleaseNoteEditClient is the client API for ReleaseNoteEdit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReleaseNoteEditClient interface {
+	// Give back an edit counted by CanUpdateNote, when the note it was counted for failed to update. The edit stops
+	// counting against the quota of its author, or against the pool of their organization.
+	ReleaseNoteEdit(ctx context.Context, in *ReleaseNoteEditRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type releaseNoteEditClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReleaseNoteEditClient(cc grpc.ClientConnInterface) ReleaseNoteEditClient {
+	return &releaseNoteEditClient{cc}
+}
+
+func (c *releaseNoteEditClient) ReleaseNoteEdit(ctx context.Context, in *ReleaseNoteEditRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReleaseNoteEdit_ReleaseNoteEdit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReleaseNoteEditServer is the server API for ReleaseNoteEdit service.
+// All implementations must embed UnimplementedReleaseNoteEditServer
+// for forward compatibility.
+type ReleaseNoteEditServer interface {
+	// Give back an edit counted by CanUpdateNote, when the note it was counted for failed to update. The edit stops
+	// counting against the quota of its author, or against the pool of their organization.
+	ReleaseNoteEdit(context.Context, *ReleaseNoteEditRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedReleaseNoteEditServer()
+}
+
+// UnimplementedReleaseNoteEditServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReleaseNoteEditServer struct{}
+
+func (UnimplementedReleaseNoteEditServer) ReleaseNoteEdit(context.Context, *ReleaseNoteEditRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNoteEdit not implemented")
+}
+func (UnimplementedReleaseNoteEditServer) mustEmbedUnimplementedReleaseNoteEditServer() {}
+func (UnimplementedReleaseNoteEditServer) testEmbeddedByValue()                         {}
+
+// UnsafeReleaseNoteEditServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReleaseNoteEditServer will
+// result in compilation errors.
+type UnsafeReleaseNoteEditServer interface {
+	mustEmbedUnimplementedReleaseNoteEditServer()
+}
+
+func RegisterReleaseNoteEditServer(s grpc.ServiceRegistrar, srv ReleaseNoteEditServer) {
+	// If the following call pancis, it indicates UnimplementedReleaseNoteEditServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReleaseNoteEdit_ServiceDesc, srv)
+}
+
+func _ReleaseNoteEdit_ReleaseNoteEdit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseNoteEditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReleaseNoteEditServer).ReleaseNoteEdit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReleaseNoteEdit_ReleaseNoteEdit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReleaseNoteEditServer).ReleaseNoteEdit(ctx, req.(*ReleaseNoteEditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReleaseNoteEdit_ServiceDesc is the grpc.ServiceDesc for ReleaseNoteEdit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReleaseNoteEdit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.ReleaseNoteEdit",
+	HandlerType: (*ReleaseNoteEditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReleaseNoteEdit",
+			Handler:    _ReleaseNoteEdit_ReleaseNoteEdit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/release_note_edit.proto",
+}
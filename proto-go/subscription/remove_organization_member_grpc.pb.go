@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/remove_organization_member.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RemoveOrganizationMember_RemoveOrganizationMember_FullMethodName = "/subscription.RemoveOrganizationMember/RemoveOrganizationMember"
+)
+
+// RemoveOrganizationMemberClient is the client API for RemoveOrganizationMember service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RemoveOrganizationMemberClient interface {
+	// Remove a user from an organization, freeing their seat. The user immediately falls back to their own subscription,
+	// or to the default tier.
+	RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type removeOrganizationMemberClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRemoveOrganizationMemberClient(cc grpc.ClientConnInterface) RemoveOrganizationMemberClient {
+	return &removeOrganizationMemberClient{cc}
+}
+
+func (c *removeOrganizationMemberClient) RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RemoveOrganizationMember_RemoveOrganizationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoveOrganizationMemberServer is the server API for RemoveOrganizationMember service.
+// All implementations must embed UnimplementedRemoveOrganizationMemberServer
+// for forward compatibility.
+type RemoveOrganizationMemberServer interface {
+	// Remove a user from an organization, freeing their seat. The user immediately falls back to their own subscription,
+	// or to the default tier.
+	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedRemoveOrganizationMemberServer()
+}
+
+// UnimplementedRemoveOrganizationMemberServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRemoveOrganizationMemberServer struct{}
+
+func (UnimplementedRemoveOrganizationMemberServer) RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationMember not implemented")
+}
+func (UnimplementedRemoveOrganizationMemberServer) mustEmbedUnimplementedRemoveOrganizationMemberServer() {
+}
+func (UnimplementedRemoveOrganizationMemberServer) testEmbeddedByValue() {}
+
+// UnsafeRemoveOrganizationMemberServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RemoveOrganizationMemberServer will
+// result in compilation errors.
+type UnsafeRemoveOrganizationMemberServer interface {
+	mustEmbedUnimplementedRemoveOrganizationMemberServer()
+}
+
+func RegisterRemoveOrganizationMemberServer(s grpc.ServiceRegistrar, srv RemoveOrganizationMemberServer) {
+	// If the following call pancis, it indicates UnimplementedRemoveOrganizationMemberServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RemoveOrganizationMember_ServiceDesc, srv)
+}
+
+func _RemoveOrganizationMember_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoveOrganizationMemberServer).RemoveOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RemoveOrganizationMember_RemoveOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoveOrganizationMemberServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RemoveOrganizationMember_ServiceDesc is the grpc.ServiceDesc for RemoveOrganizationMember service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RemoveOrganizationMember_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.RemoveOrganizationMember",
+	HandlerType: (*RemoveOrganizationMemberServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RemoveOrganizationMember",
+			Handler:    _RemoveOrganizationMember_RemoveOrganizationMember_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/remove_organization_member.proto",
+}
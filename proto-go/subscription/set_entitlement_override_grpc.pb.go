@@ -0,0 +1,126 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/set_entitlement_override.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SetEntitlementOverride_SetEntitlementOverride_FullMethodName = "/subscription.SetEntitlementOverride/SetEntitlementOverride"
+)
+
+// SetEntitlementOverrideClient is the client API for SetEntitlementOverride service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SetEntitlementOverrideClient interface {
+	// Create or replace the override of an entitlement for a user. The override replaces the value of the entitlement
+	// granted by the user's tier.
+	SetEntitlementOverride(ctx context.Context, in *SetEntitlementOverrideRequest, opts ...grpc.CallOption) (*EntitlementOverride, error)
+}
+
+type setEntitlementOverrideClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSetEntitlementOverrideClient(cc grpc.ClientConnInterface) SetEntitlementOverrideClient {
+	return &setEntitlementOverrideClient{cc}
+}
+
+func (c *setEntitlementOverrideClient) SetEntitlementOverride(ctx context.Context, in *SetEntitlementOverrideRequest, opts ...grpc.CallOption) (*EntitlementOverride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntitlementOverride)
+	err := c.cc.Invoke(ctx, SetEntitlementOverride_SetEntitlementOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetEntitlementOverrideServer is the server API for SetEntitlementOverride service.
+// All implementations must embed UnimplementedSetEntitlementOverrideServer
+// for forward compatibility.
+type SetEntitlementOverrideServer interface {
+	// Create or replace the override of an entitlement for a user. The override replaces the value of the entitlement
+	// granted by the user's tier.
+	SetEntitlementOverride(context.Context, *SetEntitlementOverrideRequest) (*EntitlementOverride, error)
+	mustEmbedUnimplementedSetEntitlementOverrideServer()
+}
+
+// UnimplementedSetEntitlementOverrideServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSetEntitlementOverrideServer struct{}
+
+func (UnimplementedSetEntitlementOverrideServer) SetEntitlementOverride(context.Context, *SetEntitlementOverrideRequest) (*EntitlementOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEntitlementOverride not implemented")
+}
+func (UnimplementedSetEntitlementOverrideServer) mustEmbedUnimplementedSetEntitlementOverrideServer() {
+}
+func (UnimplementedSetEntitlementOverrideServer) testEmbeddedByValue() {}
+
+// UnsafeSetEntitlementOverrideServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SetEntitlementOverrideServer will
+// result in compilation errors.
+type UnsafeSetEntitlementOverrideServer interface {
+	mustEmbedUnimplementedSetEntitlementOverrideServer()
+}
+
+func RegisterSetEntitlementOverrideServer(s grpc.ServiceRegistrar, srv SetEntitlementOverrideServer) {
+	// If the following call pancis, it indicates UnimplementedSetEntitlementOverrideServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SetEntitlementOverride_ServiceDesc, srv)
+}
+
+func _SetEntitlementOverride_SetEntitlementOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEntitlementOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetEntitlementOverrideServer).SetEntitlementOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SetEntitlementOverride_SetEntitlementOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetEntitlementOverrideServer).SetEntitlementOverride(ctx, req.(*SetEntitlementOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SetEntitlementOverride_ServiceDesc is the grpc.ServiceDesc for SetEntitlementOverride service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SetEntitlementOverride_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.SetEntitlementOverride",
+	HandlerType: (*SetEntitlementOverrideServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetEntitlementOverride",
+			Handler:    _SetEntitlementOverride_SetEntitlementOverride_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/set_entitlement_override.proto",
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/set_organization_member.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SetOrganizationMember_SetOrganizationMember_FullMethodName = "/subscription.SetOrganizationMember/SetOrganizationMember"
+)
+
+// SetOrganizationMemberClient is the client API for SetOrganizationMember service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SetOrganizationMemberClient interface {
+	// Add a user to an organization, or update their membership if they already belong to it. A user belongs to at most
+	// one organization. Adding a user takes a seat of the organization tier, and fails with RESOURCE_EXHAUSTED once every
+	// seat is taken.
+	SetOrganizationMember(ctx context.Context, in *SetOrganizationMemberRequest, opts ...grpc.CallOption) (*OrganizationMember, error)
+}
+
+type setOrganizationMemberClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSetOrganizationMemberClient(cc grpc.ClientConnInterface) SetOrganizationMemberClient {
+	return &setOrganizationMemberClient{cc}
+}
+
+func (c *setOrganizationMemberClient) SetOrganizationMember(ctx context.Context, in *SetOrganizationMemberRequest, opts ...grpc.CallOption) (*OrganizationMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationMember)
+	err := c.cc.Invoke(ctx, SetOrganizationMember_SetOrganizationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetOrganizationMemberServer is the server API for SetOrganizationMember service.
+// All implementations must embed UnimplementedSetOrganizationMemberServer
+// for forward compatibility.
+type SetOrganizationMemberServer interface {
+	// Add a user to an organization, or update their membership if they already belong to it. A user belongs to at most
+	// one organization. Adding a user takes a seat of the organization tier, and fails with RESOURCE_EXHAUSTED once every
+	// seat is taken.
+	SetOrganizationMember(context.Context, *SetOrganizationMemberRequest) (*OrganizationMember, error)
+	mustEmbedUnimplementedSetOrganizationMemberServer()
+}
+
+// UnimplementedSetOrganizationMemberServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSetOrganizationMemberServer struct{}
+
+func (UnimplementedSetOrganizationMemberServer) SetOrganizationMember(context.Context, *SetOrganizationMemberRequest) (*OrganizationMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationMember not implemented")
+}
+func (UnimplementedSetOrganizationMemberServer) mustEmbedUnimplementedSetOrganizationMemberServer() {}
+func (UnimplementedSetOrganizationMemberServer) testEmbeddedByValue()                               {}
+
+// UnsafeSetOrganizationMemberServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SetOrganizationMemberServer will
+// result in compilation errors.
+type UnsafeSetOrganizationMemberServer interface {
+	mustEmbedUnimplementedSetOrganizationMemberServer()
+}
+
+func RegisterSetOrganizationMemberServer(s grpc.ServiceRegistrar, srv SetOrganizationMemberServer) {
+	// If the following call pancis, it indicates UnimplementedSetOrganizationMemberServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SetOrganizationMember_ServiceDesc, srv)
+}
+
+func _SetOrganizationMember_SetOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetOrganizationMemberServer).SetOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SetOrganizationMember_SetOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetOrganizationMemberServer).SetOrganizationMember(ctx, req.(*SetOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SetOrganizationMember_ServiceDesc is the grpc.ServiceDesc for SetOrganizationMember service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SetOrganizationMember_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.SetOrganizationMember",
+	HandlerType: (*SetOrganizationMemberServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetOrganizationMember",
+			Handler:    _SetOrganizationMember_SetOrganizationMember_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/set_organization_member.proto",
+}
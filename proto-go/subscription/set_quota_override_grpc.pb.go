@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/set_quota_override.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SetQuotaOverride_SetQuotaOverride_FullMethodName = "/subscription.SetQuotaOverride/SetQuotaOverride"
+)
+
+// SetQuotaOverrideClient is the client API for SetQuotaOverride service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SetQuotaOverrideClient interface {
+	// Create or replace the quota override of an author. The override is applied on top of the author's tier when
+	// counting note edits.
+	SetQuotaOverride(ctx context.Context, in *SetQuotaOverrideRequest, opts ...grpc.CallOption) (*QuotaOverride, error)
+}
+
+type setQuotaOverrideClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSetQuotaOverrideClient(cc grpc.ClientConnInterface) SetQuotaOverrideClient {
+	return &setQuotaOverrideClient{cc}
+}
+
+func (c *setQuotaOverrideClient) SetQuotaOverride(ctx context.Context, in *SetQuotaOverrideRequest, opts ...grpc.CallOption) (*QuotaOverride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotaOverride)
+	err := c.cc.Invoke(ctx, SetQuotaOverride_SetQuotaOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetQuotaOverrideServer is the server API for SetQuotaOverride service.
+// All implementations must embed UnimplementedSetQuotaOverrideServer
+// for forward compatibility.
+type SetQuotaOverrideServer interface {
+	// Create or replace the quota override of an author. The override is applied on top of the author's tier when
+	// counting note edits.
+	SetQuotaOverride(context.Context, *SetQuotaOverrideRequest) (*QuotaOverride, error)
+	mustEmbedUnimplementedSetQuotaOverrideServer()
+}
+
+// UnimplementedSetQuotaOverrideServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSetQuotaOverrideServer struct{}
+
+func (UnimplementedSetQuotaOverrideServer) SetQuotaOverride(context.Context, *SetQuotaOverrideRequest) (*QuotaOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuotaOverride not implemented")
+}
+func (UnimplementedSetQuotaOverrideServer) mustEmbedUnimplementedSetQuotaOverrideServer() {}
+func (UnimplementedSetQuotaOverrideServer) testEmbeddedByValue()                          {}
+
+// UnsafeSetQuotaOverrideServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SetQuotaOverrideServer will
+// result in compilation errors.
+type UnsafeSetQuotaOverrideServer interface {
+	mustEmbedUnimplementedSetQuotaOverrideServer()
+}
+
+func RegisterSetQuotaOverrideServer(s grpc.ServiceRegistrar, srv SetQuotaOverrideServer) {
+	// If the following call pancis, it indicates UnimplementedSetQuotaOverrideServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SetQuotaOverride_ServiceDesc, srv)
+}
+
+func _SetQuotaOverride_SetQuotaOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetQuotaOverrideServer).SetQuotaOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SetQuotaOverride_SetQuotaOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetQuotaOverrideServer).SetQuotaOverride(ctx, req.(*SetQuotaOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SetQuotaOverride_ServiceDesc is the grpc.ServiceDesc for SetQuotaOverride service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SetQuotaOverride_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.SetQuotaOverride",
+	HandlerType: (*SetQuotaOverrideServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetQuotaOverride",
+			Handler:    _SetQuotaOverride_SetQuotaOverride_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/set_quota_override.proto",
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.4
+// source: proto/subscription/start_trial.proto
+
+package subscription_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StartTrial_StartTrial_FullMethodName = "/subscription.StartTrial/StartTrial"
+)
+
+// StartTrialClient is the client API for StartTrial service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StartTrialClient interface {
+	// Start the trial of a user. Each user can only have one trial, and only while they have no running subscription.
+	StartTrial(ctx context.Context, in *StartTrialRequest, opts ...grpc.CallOption) (*Subscription, error)
+}
+
+type startTrialClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStartTrialClient(cc grpc.ClientConnInterface) StartTrialClient {
+	return &startTrialClient{cc}
+}
+
+func (c *startTrialClient) StartTrial(ctx context.Context, in *StartTrialRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, StartTrial_StartTrial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StartTrialServer is the server API for StartTrial service.
+// All implementations must embed UnimplementedStartTrialServer
+// for forward compatibility.
+type StartTrialServer interface {
+	// Start the trial of a user. Each user can only have one trial, and only while they have no running subscription.
+	StartTrial(context.Context, *StartTrialRequest) (*Subscription, error)
+	mustEmbedUnimplementedStartTrialServer()
+}
+
+// UnimplementedStartTrialServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStartTrialServer struct{}
+
+func (UnimplementedStartTrialServer) StartTrial(context.Context, *StartTrialRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTrial not implemented")
+}
+func (UnimplementedStartTrialServer) mustEmbedUnimplementedStartTrialServer() {}
+func (UnimplementedStartTrialServer) testEmbeddedByValue()                    {}
+
+// UnsafeStartTrialServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StartTrialServer will
+// result in compilation errors.
+type UnsafeStartTrialServer interface {
+	mustEmbedUnimplementedStartTrialServer()
+}
+
+func RegisterStartTrialServer(s grpc.ServiceRegistrar, srv StartTrialServer) {
+	// If the following call pancis, it indicates UnimplementedStartTrialServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StartTrial_ServiceDesc, srv)
+}
+
+func _StartTrial_StartTrial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTrialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StartTrialServer).StartTrial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StartTrial_StartTrial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StartTrialServer).StartTrial(ctx, req.(*StartTrialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StartTrial_ServiceDesc is the grpc.ServiceDesc for StartTrial service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StartTrial_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "subscription.StartTrial",
+	HandlerType: (*StartTrialServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartTrial",
+			Handler:    _StartTrial_StartTrial_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/subscription/start_trial.proto",
+}